@@ -12,6 +12,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -24,13 +25,241 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// BucketPolicy selects a predefined bucket access policy
+type BucketPolicy int32
+
+const (
+	// Private, or public read when is_public is set
+	BucketPolicy_BUCKET_POLICY_UNSPECIFIED BucketPolicy = 0
+	// Anyone can download objects
+	BucketPolicy_BUCKET_POLICY_PUBLIC_READ BucketPolicy = 1
+	// Anyone can download, upload and delete objects
+	BucketPolicy_BUCKET_POLICY_READ_WRITE BucketPolicy = 2
+	// Anyone can download objects under policy_prefix
+	BucketPolicy_BUCKET_POLICY_READ_ONLY_PREFIX BucketPolicy = 3
+)
+
+// Enum value maps for BucketPolicy.
+var (
+	BucketPolicy_name = map[int32]string{
+		0: "BUCKET_POLICY_UNSPECIFIED",
+		1: "BUCKET_POLICY_PUBLIC_READ",
+		2: "BUCKET_POLICY_READ_WRITE",
+		3: "BUCKET_POLICY_READ_ONLY_PREFIX",
+	}
+	BucketPolicy_value = map[string]int32{
+		"BUCKET_POLICY_UNSPECIFIED":      0,
+		"BUCKET_POLICY_PUBLIC_READ":      1,
+		"BUCKET_POLICY_READ_WRITE":       2,
+		"BUCKET_POLICY_READ_ONLY_PREFIX": 3,
+	}
+)
+
+func (x BucketPolicy) Enum() *BucketPolicy {
+	p := new(BucketPolicy)
+	*p = x
+	return p
+}
+
+func (x BucketPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BucketPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_mediabase_v1_mediabase_proto_enumTypes[0].Descriptor()
+}
+
+func (BucketPolicy) Type() protoreflect.EnumType {
+	return &file_proto_mediabase_v1_mediabase_proto_enumTypes[0]
+}
+
+func (x BucketPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BucketPolicy.Descriptor instead.
+func (BucketPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{0}
+}
+
+// UploadMethod selects how a presigned upload is performed
+type UploadMethod int32
+
+const (
+	// Same as UPLOAD_METHOD_POST, or UPLOAD_METHOD_PUT on backends without POST uploads
+	UploadMethod_UPLOAD_METHOD_UNSPECIFIED UploadMethod = 0
+	// Multipart form POST with a policy
+	UploadMethod_UPLOAD_METHOD_POST UploadMethod = 1
+	// Single PUT request with signed headers
+	UploadMethod_UPLOAD_METHOD_PUT UploadMethod = 2
+)
+
+// Enum value maps for UploadMethod.
+var (
+	UploadMethod_name = map[int32]string{
+		0: "UPLOAD_METHOD_UNSPECIFIED",
+		1: "UPLOAD_METHOD_POST",
+		2: "UPLOAD_METHOD_PUT",
+	}
+	UploadMethod_value = map[string]int32{
+		"UPLOAD_METHOD_UNSPECIFIED": 0,
+		"UPLOAD_METHOD_POST":        1,
+		"UPLOAD_METHOD_PUT":         2,
+	}
+)
+
+func (x UploadMethod) Enum() *UploadMethod {
+	p := new(UploadMethod)
+	*p = x
+	return p
+}
+
+func (x UploadMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UploadMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_mediabase_v1_mediabase_proto_enumTypes[1].Descriptor()
+}
+
+func (UploadMethod) Type() protoreflect.EnumType {
+	return &file_proto_mediabase_v1_mediabase_proto_enumTypes[1]
+}
+
+func (x UploadMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UploadMethod.Descriptor instead.
+func (UploadMethod) EnumDescriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{1}
+}
+
+// ObjectACL selects the access level of a single object
+type ObjectACL int32
+
+const (
+	// Not set; rejected by SetObjectACL
+	ObjectACL_OBJECT_ACL_UNSPECIFIED ObjectACL = 0
+	// Only signed requests can read the object
+	ObjectACL_OBJECT_ACL_PRIVATE ObjectACL = 1
+	// Anyone can read the object
+	ObjectACL_OBJECT_ACL_PUBLIC_READ ObjectACL = 2
+)
+
+// Enum value maps for ObjectACL.
+var (
+	ObjectACL_name = map[int32]string{
+		0: "OBJECT_ACL_UNSPECIFIED",
+		1: "OBJECT_ACL_PRIVATE",
+		2: "OBJECT_ACL_PUBLIC_READ",
+	}
+	ObjectACL_value = map[string]int32{
+		"OBJECT_ACL_UNSPECIFIED": 0,
+		"OBJECT_ACL_PRIVATE":     1,
+		"OBJECT_ACL_PUBLIC_READ": 2,
+	}
+)
+
+func (x ObjectACL) Enum() *ObjectACL {
+	p := new(ObjectACL)
+	*p = x
+	return p
+}
+
+func (x ObjectACL) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ObjectACL) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_mediabase_v1_mediabase_proto_enumTypes[2].Descriptor()
+}
+
+func (ObjectACL) Type() protoreflect.EnumType {
+	return &file_proto_mediabase_v1_mediabase_proto_enumTypes[2]
+}
+
+func (x ObjectACL) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ObjectACL.Descriptor instead.
+func (ObjectACL) EnumDescriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{2}
+}
+
+// RetentionMode decides who may shorten or remove the retention of an object
+type RetentionMode int32
+
+const (
+	// Not set; rejected by SetObjectRetention
+	RetentionMode_RETENTION_MODE_UNSPECIFIED RetentionMode = 0
+	// Requests with bypass_governance may shorten or replace the retention
+	RetentionMode_RETENTION_MODE_GOVERNANCE RetentionMode = 1
+	// No one may shorten or remove the retention, only extend it
+	RetentionMode_RETENTION_MODE_COMPLIANCE RetentionMode = 2
+)
+
+// Enum value maps for RetentionMode.
+var (
+	RetentionMode_name = map[int32]string{
+		0: "RETENTION_MODE_UNSPECIFIED",
+		1: "RETENTION_MODE_GOVERNANCE",
+		2: "RETENTION_MODE_COMPLIANCE",
+	}
+	RetentionMode_value = map[string]int32{
+		"RETENTION_MODE_UNSPECIFIED": 0,
+		"RETENTION_MODE_GOVERNANCE":  1,
+		"RETENTION_MODE_COMPLIANCE":  2,
+	}
+)
+
+func (x RetentionMode) Enum() *RetentionMode {
+	p := new(RetentionMode)
+	*p = x
+	return p
+}
+
+func (x RetentionMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RetentionMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_mediabase_v1_mediabase_proto_enumTypes[3].Descriptor()
+}
+
+func (RetentionMode) Type() protoreflect.EnumType {
+	return &file_proto_mediabase_v1_mediabase_proto_enumTypes[3]
+}
+
+func (x RetentionMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RetentionMode.Descriptor instead.
+func (RetentionMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{3}
+}
+
 // CreateBucketRequest contains the bucket name and public access preference
 type CreateBucketRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BucketName    string                 `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
-	IsPublic      bool                   `protobuf:"varint,2,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	BucketName string                 `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	IsPublic   bool                   `protobuf:"varint,2,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+	// Optional: Enable object versioning on the bucket
+	EnableVersioning bool `protobuf:"varint,3,opt,name=enable_versioning,json=enableVersioning,proto3" json:"enable_versioning,omitempty"`
+	// Optional: Apply a CORS configuration so browsers can upload to presigned URLs.
+	// Empty lists fall back to the service's configured defaults.
+	Cors *CorsRule `protobuf:"bytes,4,opt,name=cors,proto3" json:"cors,omitempty"`
+	// Optional: Access policy template. Takes precedence over is_public when set.
+	Policy BucketPolicy `protobuf:"varint,5,opt,name=policy,proto3,enum=v1.BucketPolicy" json:"policy,omitempty"`
+	// Prefix granted by the READ_ONLY_PREFIX policy, e.g. "public/"
+	PolicyPrefix string `protobuf:"bytes,6,opt,name=policy_prefix,json=policyPrefix,proto3" json:"policy_prefix,omitempty"`
+	// Optional: Allow retention and legal holds on objects. Only possible when the bucket is
+	// created, and turns on versioning for good.
+	EnableObjectLocking bool `protobuf:"varint,7,opt,name=enable_object_locking,json=enableObjectLocking,proto3" json:"enable_object_locking,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *CreateBucketRequest) Reset() {
@@ -77,6 +306,105 @@ func (x *CreateBucketRequest) GetIsPublic() bool {
 	return false
 }
 
+func (x *CreateBucketRequest) GetEnableVersioning() bool {
+	if x != nil {
+		return x.EnableVersioning
+	}
+	return false
+}
+
+func (x *CreateBucketRequest) GetCors() *CorsRule {
+	if x != nil {
+		return x.Cors
+	}
+	return nil
+}
+
+func (x *CreateBucketRequest) GetPolicy() BucketPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return BucketPolicy_BUCKET_POLICY_UNSPECIFIED
+}
+
+func (x *CreateBucketRequest) GetPolicyPrefix() string {
+	if x != nil {
+		return x.PolicyPrefix
+	}
+	return ""
+}
+
+func (x *CreateBucketRequest) GetEnableObjectLocking() bool {
+	if x != nil {
+		return x.EnableObjectLocking
+	}
+	return false
+}
+
+// CorsRule allows cross-origin browser requests to a bucket
+type CorsRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Origins allowed to make requests, e.g. "https://app.example.com" or "*"
+	AllowedOrigins []string `protobuf:"bytes,1,rep,name=allowed_origins,json=allowedOrigins,proto3" json:"allowed_origins,omitempty"`
+	// HTTP methods allowed: GET, PUT, POST, DELETE, HEAD
+	AllowedMethods []string `protobuf:"bytes,2,rep,name=allowed_methods,json=allowedMethods,proto3" json:"allowed_methods,omitempty"`
+	// Request headers allowed in preflight requests
+	AllowedHeaders []string `protobuf:"bytes,3,rep,name=allowed_headers,json=allowedHeaders,proto3" json:"allowed_headers,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CorsRule) Reset() {
+	*x = CorsRule{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CorsRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CorsRule) ProtoMessage() {}
+
+func (x *CorsRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CorsRule.ProtoReflect.Descriptor instead.
+func (*CorsRule) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CorsRule) GetAllowedOrigins() []string {
+	if x != nil {
+		return x.AllowedOrigins
+	}
+	return nil
+}
+
+func (x *CorsRule) GetAllowedMethods() []string {
+	if x != nil {
+		return x.AllowedMethods
+	}
+	return nil
+}
+
+func (x *CorsRule) GetAllowedHeaders() []string {
+	if x != nil {
+		return x.AllowedHeaders
+	}
+	return nil
+}
+
 // CreateBucketResponse indicates successful creation
 type CreateBucketResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -87,7 +415,7 @@ type CreateBucketResponse struct {
 
 func (x *CreateBucketResponse) Reset() {
 	*x = CreateBucketResponse{}
-	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[1]
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -99,7 +427,7 @@ func (x *CreateBucketResponse) String() string {
 func (*CreateBucketResponse) ProtoMessage() {}
 
 func (x *CreateBucketResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[1]
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -112,7 +440,7 @@ func (x *CreateBucketResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBucketResponse.ProtoReflect.Descriptor instead.
 func (*CreateBucketResponse) Descriptor() ([]byte, []int) {
-	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{1}
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateBucketResponse) GetSuccess() bool {
@@ -122,26 +450,175 @@ func (x *CreateBucketResponse) GetSuccess() bool {
 	return false
 }
 
+// DeleteBucketRequest identifies the bucket to delete
+type DeleteBucketRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BucketName    string                 `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBucketRequest) Reset() {
+	*x = DeleteBucketRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBucketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBucketRequest) ProtoMessage() {}
+
+func (x *DeleteBucketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBucketRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBucketRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteBucketRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+// DeleteBucketResponse indicates the bucket was deleted
+type DeleteBucketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBucketResponse) Reset() {
+	*x = DeleteBucketResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBucketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBucketResponse) ProtoMessage() {}
+
+func (x *DeleteBucketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBucketResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBucketResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteBucketResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
 // PresignUploadRequest contains the parameters for generating a presigned upload URL
 type PresignUploadRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Bucket name where the file should be uploaded
+	// Bucket name where the file should be uploaded. Defaults to the configured default bucket when empty.
 	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
-	// Content type of the file (e.g., "image/jpeg", "image/png", "image/webp")
+	// Content type of the file (e.g., "image/jpeg", "image/png", "image/webp"). Defaults to the
+	// server's default content type when empty; required if none is configured.
 	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
-	// Maximum allowed size of the file in bytes (will be enforced by storage)
+	// Maximum allowed size of the file in bytes (will be enforced by storage). Defaults to the
+	// server maximum for the content type when zero, and may not exceed it.
 	MaxFileSize int64 `protobuf:"varint,3,opt,name=max_file_size,json=maxFileSize,proto3" json:"max_file_size,omitempty"`
 	// Optional: Path/Folder where the file should be uploaded (e.g., "users/avatars")
 	Path string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
 	// Optional: Exact filename to use. If not provided, a unique UUID will be generated.
-	FileName      string `protobuf:"bytes,5,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	FileName string `protobuf:"bytes,5,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// Optional: Cache-Control value to persist with the object (e.g., "public, max-age=31536000, immutable")
+	CacheControl string `protobuf:"bytes,6,opt,name=cache_control,json=cacheControl,proto3" json:"cache_control,omitempty"`
+	// Optional: Expected hex-encoded SHA-256 of the content, verified by ConfirmUpload
+	ChecksumSha256 string `protobuf:"bytes,7,opt,name=checksum_sha256,json=checksumSha256,proto3" json:"checksum_sha256,omitempty"`
+	// Optional: Tags to apply to the object when the upload is confirmed
+	Tags map[string]string `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Optional: Only validate the request and return the would-be object key, without generating a URL
+	DryRun bool `protobuf:"varint,9,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Optional: Name the object after checksum_sha256 and skip the upload if identical content
+	// already exists. Requires checksum_sha256; cannot be combined with file_name.
+	Deduplicate bool `protobuf:"varint,10,opt,name=deduplicate,proto3" json:"deduplicate,omitempty"`
+	// Optional: Upload method. POST (default) returns a policy with form fields and enforces
+	// max_file_size as an upper bound. PUT returns a URL and headers to send; since PUT URLs cannot
+	// express a size range, max_file_size is then the exact file size.
+	Method UploadMethod `protobuf:"varint,11,opt,name=method,proto3,enum=v1.UploadMethod" json:"method,omitempty"`
+	// Optional: Application metadata stored with the object (e.g., owner, album ID).
+	// Keys may contain lower-case letters, digits and hyphens; values must be printable ASCII.
+	// Keys and values, together with cache_control, checksum_sha256 and tags, may take at most 2 KB.
+	Metadata map[string]string `protobuf:"bytes,12,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Optional: URL storage redirects the browser to after a successful POST upload (303 See Other),
+	// with the bucket, key and ETag appended as query parameters. Its origin must be one of the
+	// configured CORS origins. Cannot be combined with success_action_status or PUT uploads.
+	SuccessActionRedirect string `protobuf:"bytes,13,opt,name=success_action_redirect,json=successActionRedirect,proto3" json:"success_action_redirect,omitempty"`
+	// Optional: HTTP status storage answers a successful POST upload with: 200 or 201 (with an XML
+	// body describing the object) or 204 (empty, the default). Not available for PUT uploads.
+	SuccessActionStatus int32 `protobuf:"varint,14,opt,name=success_action_status,json=successActionStatus,proto3" json:"success_action_status,omitempty"`
+	// Optional: Client-chosen key identifying this upload (e.g., "user-42/upload-7f3a"). Retries with
+	// the same key get the same object key, derived as a UUIDv5 in the configured namespace instead
+	// of a random UUID. Reusing a key for different content overwrites the earlier object, so keys
+	// must be unique per upload. Cannot be combined with file_name or deduplicate.
+	IdempotencyKey string `protobuf:"bytes,15,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Optional: Expiration of the presigned URL in seconds. Defaults to the server's upload expiry
+	// and may not exceed the configured maximum (7 days by default).
+	ExpiresIn int32 `protobuf:"varint,16,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	// Optional: Application metadata whose value the uploader fills in, locked to start with the
+	// given prefix (e.g., {"owner": "team-a/"}). The upload form must send x-amz-meta-<key> with a
+	// value starting with it. Keys may not also appear in metadata. POST only.
+	MetadataStartsWith map[string]string `protobuf:"bytes,17,rep,name=metadata_starts_with,json=metadataStartsWith,proto3" json:"metadata_starts_with,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Optional: Sign an If-None-Match: * precondition, so storage rejects the upload with
+	// 412 Precondition Failed if an object already exists under the key. PUT only.
+	IfNoneMatch bool `protobuf:"varint,18,opt,name=if_none_match,json=ifNoneMatch,proto3" json:"if_none_match,omitempty"`
+	// Optional: KMS key to encrypt the object with, overriding the configured SSE-KMS key
+	KmsKeyId string `protobuf:"bytes,19,opt,name=kms_key_id,json=kmsKeyId,proto3" json:"kms_key_id,omitempty"`
+	// Optional: Minimum allowed size of the file in bytes, enforced by storage for POST uploads.
+	// Defaults to the server minimum, which it may raise but not lower, and may not exceed
+	// max_file_size.
+	MinFileSize int64 `protobuf:"varint,20,opt,name=min_file_size,json=minFileSize,proto3" json:"min_file_size,omitempty"`
+	// Optional: Sign only path as a prefix of the key and let the uploader choose the rest. The
+	// returned object_key is then the prefix, and the upload form must set the key field to a key
+	// starting with it; storage rejects keys outside of it. POST only; requires path and cannot be
+	// combined with file_name, deduplicate or idempotency_key.
+	PrefixOnly bool `protobuf:"varint,21,opt,name=prefix_only,json=prefixOnly,proto3" json:"prefix_only,omitempty"`
+	// Optional: Allow any content type starting with this prefix, e.g. "image/", instead of an
+	// exact content_type, which must then be empty. The prefix must be covered by a wildcard
+	// entry of the allowed content types, such as "image/*". The upload form must set the
+	// Content-Type field to the actual type. POST only.
+	ContentTypePrefix string `protobuf:"bytes,22,opt,name=content_type_prefix,json=contentTypePrefix,proto3" json:"content_type_prefix,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *PresignUploadRequest) Reset() {
 	*x = PresignUploadRequest{}
-	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[2]
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -153,7 +630,7 @@ func (x *PresignUploadRequest) String() string {
 func (*PresignUploadRequest) ProtoMessage() {}
 
 func (x *PresignUploadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[2]
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -166,7 +643,7 @@ func (x *PresignUploadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PresignUploadRequest.ProtoReflect.Descriptor instead.
 func (*PresignUploadRequest) Descriptor() ([]byte, []int) {
-	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{2}
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *PresignUploadRequest) GetBucketName() string {
@@ -201,39 +678,4840 @@ func (x *PresignUploadRequest) GetFileName() string {
 	if x != nil {
 		return x.FileName
 	}
-	return ""
+	return ""
+}
+
+func (x *PresignUploadRequest) GetCacheControl() string {
+	if x != nil {
+		return x.CacheControl
+	}
+	return ""
+}
+
+func (x *PresignUploadRequest) GetChecksumSha256() string {
+	if x != nil {
+		return x.ChecksumSha256
+	}
+	return ""
+}
+
+func (x *PresignUploadRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PresignUploadRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *PresignUploadRequest) GetDeduplicate() bool {
+	if x != nil {
+		return x.Deduplicate
+	}
+	return false
+}
+
+func (x *PresignUploadRequest) GetMethod() UploadMethod {
+	if x != nil {
+		return x.Method
+	}
+	return UploadMethod_UPLOAD_METHOD_UNSPECIFIED
+}
+
+func (x *PresignUploadRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *PresignUploadRequest) GetSuccessActionRedirect() string {
+	if x != nil {
+		return x.SuccessActionRedirect
+	}
+	return ""
+}
+
+func (x *PresignUploadRequest) GetSuccessActionStatus() int32 {
+	if x != nil {
+		return x.SuccessActionStatus
+	}
+	return 0
+}
+
+func (x *PresignUploadRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *PresignUploadRequest) GetExpiresIn() int32 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *PresignUploadRequest) GetMetadataStartsWith() map[string]string {
+	if x != nil {
+		return x.MetadataStartsWith
+	}
+	return nil
+}
+
+func (x *PresignUploadRequest) GetIfNoneMatch() bool {
+	if x != nil {
+		return x.IfNoneMatch
+	}
+	return false
+}
+
+func (x *PresignUploadRequest) GetKmsKeyId() string {
+	if x != nil {
+		return x.KmsKeyId
+	}
+	return ""
+}
+
+func (x *PresignUploadRequest) GetMinFileSize() int64 {
+	if x != nil {
+		return x.MinFileSize
+	}
+	return 0
+}
+
+func (x *PresignUploadRequest) GetPrefixOnly() bool {
+	if x != nil {
+		return x.PrefixOnly
+	}
+	return false
+}
+
+func (x *PresignUploadRequest) GetContentTypePrefix() string {
+	if x != nil {
+		return x.ContentTypePrefix
+	}
+	return ""
+}
+
+// PresignUploadResponse contains the presigned URL and metadata
+type PresignUploadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Presigned URL for uploading the file
+	PresignedUrl string `protobuf:"bytes,1,opt,name=presigned_url,json=presignedUrl,proto3" json:"presigned_url,omitempty"`
+	// Object key/path in storage
+	ObjectKey string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// Expiration time in seconds
+	ExpiresIn int32 `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	// Optional: Form data fields for POST upload (required for size enforcement)
+	FormData map[string]string `protobuf:"bytes,4,rep,name=form_data,json=formData,proto3" json:"form_data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// True if the request was a dry run; presigned_url and form_data are empty
+	DryRun bool `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// True if identical content already exists at object_key; no upload is needed and
+	// presigned_url and form_data are empty
+	Deduplicated bool `protobuf:"varint,6,opt,name=deduplicated,proto3" json:"deduplicated,omitempty"`
+	// Headers that must be sent unchanged with a PUT upload
+	Headers map[string]string `protobuf:"bytes,7,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The requested file_name after the server's naming rules were applied, e.g. with
+	// disallowed characters replaced; empty when no file_name was requested
+	FileName string `protobuf:"bytes,8,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// The method to upload with: POST with form_data or PUT with headers. Unspecified for
+	// dry runs and deduplicated uploads.
+	Method        UploadMethod `protobuf:"varint,9,opt,name=method,proto3,enum=v1.UploadMethod" json:"method,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresignUploadResponse) Reset() {
+	*x = PresignUploadResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresignUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresignUploadResponse) ProtoMessage() {}
+
+func (x *PresignUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresignUploadResponse.ProtoReflect.Descriptor instead.
+func (*PresignUploadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PresignUploadResponse) GetPresignedUrl() string {
+	if x != nil {
+		return x.PresignedUrl
+	}
+	return ""
+}
+
+func (x *PresignUploadResponse) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *PresignUploadResponse) GetExpiresIn() int32 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *PresignUploadResponse) GetFormData() map[string]string {
+	if x != nil {
+		return x.FormData
+	}
+	return nil
+}
+
+func (x *PresignUploadResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *PresignUploadResponse) GetDeduplicated() bool {
+	if x != nil {
+		return x.Deduplicated
+	}
+	return false
+}
+
+func (x *PresignUploadResponse) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *PresignUploadResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *PresignUploadResponse) GetMethod() UploadMethod {
+	if x != nil {
+		return x.Method
+	}
+	return UploadMethod_UPLOAD_METHOD_UNSPECIFIED
+}
+
+// PresignUploadBatchRequest contains several uploads to presign
+type PresignUploadBatchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name used by uploads that leave their own bucket_name empty
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Uploads to presign, at most the configured batch size
+	Uploads       []*PresignUploadRequest `protobuf:"bytes,2,rep,name=uploads,proto3" json:"uploads,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresignUploadBatchRequest) Reset() {
+	*x = PresignUploadBatchRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresignUploadBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresignUploadBatchRequest) ProtoMessage() {}
+
+func (x *PresignUploadBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresignUploadBatchRequest.ProtoReflect.Descriptor instead.
+func (*PresignUploadBatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PresignUploadBatchRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *PresignUploadBatchRequest) GetUploads() []*PresignUploadRequest {
+	if x != nil {
+		return x.Uploads
+	}
+	return nil
+}
+
+// PresignUploadBatchResponse contains one result per requested upload, in request order
+type PresignUploadBatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*PresignUploadResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresignUploadBatchResponse) Reset() {
+	*x = PresignUploadBatchResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresignUploadBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresignUploadBatchResponse) ProtoMessage() {}
+
+func (x *PresignUploadBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresignUploadBatchResponse.ProtoReflect.Descriptor instead.
+func (*PresignUploadBatchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PresignUploadBatchResponse) GetResults() []*PresignUploadResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// PresignUploadResult holds the outcome of one upload of a batch
+type PresignUploadResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The presigned upload; empty if the upload failed
+	Upload *PresignUploadResponse `protobuf:"bytes,1,opt,name=upload,proto3" json:"upload,omitempty"`
+	// gRPC status code of the failure (e.g., 3 for INVALID_ARGUMENT); 0 on success
+	ErrorCode int32 `protobuf:"varint,2,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// Description of the failure; empty on success
+	ErrorMessage  string `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresignUploadResult) Reset() {
+	*x = PresignUploadResult{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresignUploadResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresignUploadResult) ProtoMessage() {}
+
+func (x *PresignUploadResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresignUploadResult.ProtoReflect.Descriptor instead.
+func (*PresignUploadResult) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PresignUploadResult) GetUpload() *PresignUploadResponse {
+	if x != nil {
+		return x.Upload
+	}
+	return nil
+}
+
+func (x *PresignUploadResult) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *PresignUploadResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// PreflightUploadRequest describes a planned presigned upload
+type PreflightUploadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The upload, as it would be sent to PresignUpload
+	Upload *PresignUploadRequest `protobuf:"bytes,1,opt,name=upload,proto3" json:"upload,omitempty"`
+	// Optional: Origin of the page that will upload (e.g., "https://app.example.com")
+	Origin        string `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreflightUploadRequest) Reset() {
+	*x = PreflightUploadRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreflightUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreflightUploadRequest) ProtoMessage() {}
+
+func (x *PreflightUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreflightUploadRequest.ProtoReflect.Descriptor instead.
+func (*PreflightUploadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PreflightUploadRequest) GetUpload() *PresignUploadRequest {
+	if x != nil {
+		return x.Upload
+	}
+	return nil
+}
+
+func (x *PreflightUploadRequest) GetOrigin() string {
+	if x != nil {
+		return x.Origin
+	}
+	return ""
+}
+
+// PreflightUploadResponse lists what the browser must send
+type PreflightUploadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// HTTP method of the upload: POST or PUT
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// Names of the form fields a POST upload must send with the values from PresignUpload,
+	// in any order before the file field
+	FormFields []string `protobuf:"bytes,2,rep,name=form_fields,json=formFields,proto3" json:"form_fields,omitempty"`
+	// Name of the form field carrying the file; it must be the last field of a POST upload
+	FileField string `protobuf:"bytes,3,opt,name=file_field,json=fileField,proto3" json:"file_field,omitempty"`
+	// Names of the headers a PUT upload must send with the values from PresignUpload
+	Headers []string `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty"`
+	// Largest file size storage accepts, in bytes; the exact size for PUT uploads
+	MaxFileSize int64 `protobuf:"varint,5,opt,name=max_file_size,json=maxFileSize,proto3" json:"max_file_size,omitempty"`
+	// HTTP status storage answers a successful upload with
+	SuccessActionStatus int32 `protobuf:"varint,6,opt,name=success_action_status,json=successActionStatus,proto3" json:"success_action_status,omitempty"`
+	// Whether the configured CORS rule allows origin; false when no origin was given
+	OriginAllowed bool `protobuf:"varint,7,opt,name=origin_allowed,json=originAllowed,proto3" json:"origin_allowed,omitempty"`
+	// HTTP methods allowed by the configured CORS rule
+	AllowedMethods []string `protobuf:"bytes,8,rep,name=allowed_methods,json=allowedMethods,proto3" json:"allowed_methods,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PreflightUploadResponse) Reset() {
+	*x = PreflightUploadResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreflightUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreflightUploadResponse) ProtoMessage() {}
+
+func (x *PreflightUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreflightUploadResponse.ProtoReflect.Descriptor instead.
+func (*PreflightUploadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PreflightUploadResponse) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *PreflightUploadResponse) GetFormFields() []string {
+	if x != nil {
+		return x.FormFields
+	}
+	return nil
+}
+
+func (x *PreflightUploadResponse) GetFileField() string {
+	if x != nil {
+		return x.FileField
+	}
+	return ""
+}
+
+func (x *PreflightUploadResponse) GetHeaders() []string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *PreflightUploadResponse) GetMaxFileSize() int64 {
+	if x != nil {
+		return x.MaxFileSize
+	}
+	return 0
+}
+
+func (x *PreflightUploadResponse) GetSuccessActionStatus() int32 {
+	if x != nil {
+		return x.SuccessActionStatus
+	}
+	return 0
+}
+
+func (x *PreflightUploadResponse) GetOriginAllowed() bool {
+	if x != nil {
+		return x.OriginAllowed
+	}
+	return false
+}
+
+func (x *PreflightUploadResponse) GetAllowedMethods() []string {
+	if x != nil {
+		return x.AllowedMethods
+	}
+	return nil
+}
+
+// GetUploadConstraintsRequest is empty
+type GetUploadConstraintsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUploadConstraintsRequest) Reset() {
+	*x = GetUploadConstraintsRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUploadConstraintsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUploadConstraintsRequest) ProtoMessage() {}
+
+func (x *GetUploadConstraintsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUploadConstraintsRequest.ProtoReflect.Descriptor instead.
+func (*GetUploadConstraintsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{12}
+}
+
+// GetUploadConstraintsResponse contains the server's upload limits
+type GetUploadConstraintsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Content types accepted for uploads
+	AllowedContentTypes []string `protobuf:"bytes,1,rep,name=allowed_content_types,json=allowedContentTypes,proto3" json:"allowed_content_types,omitempty"`
+	// Global maximum file size in bytes
+	MaxFileSize int64 `protobuf:"varint,2,opt,name=max_file_size,json=maxFileSize,proto3" json:"max_file_size,omitempty"`
+	// Effective maximum file size in bytes for content types with a lower limit
+	MaxFileSizeByContentType map[string]int64 `protobuf:"bytes,3,rep,name=max_file_size_by_content_type,json=maxFileSizeByContentType,proto3" json:"max_file_size_by_content_type,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Expiration of presigned upload URLs in seconds
+	UploadExpiresIn int32 `protobuf:"varint,4,opt,name=upload_expires_in,json=uploadExpiresIn,proto3" json:"upload_expires_in,omitempty"`
+	// Expiration of presigned download URLs in seconds
+	DownloadExpiresIn int32 `protobuf:"varint,5,opt,name=download_expires_in,json=downloadExpiresIn,proto3" json:"download_expires_in,omitempty"`
+	// Maximum number of uploads in one PresignUploadBatch request
+	MaxPresignBatchSize int32 `protobuf:"varint,6,opt,name=max_presign_batch_size,json=maxPresignBatchSize,proto3" json:"max_presign_batch_size,omitempty"`
+	// Maximum expiration of presigned URLs in seconds that requests may ask for
+	MaxExpiresIn int32 `protobuf:"varint,7,opt,name=max_expires_in,json=maxExpiresIn,proto3" json:"max_expires_in,omitempty"`
+	// Minimum file size in bytes of presigned uploads
+	MinFileSize   int64 `protobuf:"varint,8,opt,name=min_file_size,json=minFileSize,proto3" json:"min_file_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUploadConstraintsResponse) Reset() {
+	*x = GetUploadConstraintsResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUploadConstraintsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUploadConstraintsResponse) ProtoMessage() {}
+
+func (x *GetUploadConstraintsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUploadConstraintsResponse.ProtoReflect.Descriptor instead.
+func (*GetUploadConstraintsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetUploadConstraintsResponse) GetAllowedContentTypes() []string {
+	if x != nil {
+		return x.AllowedContentTypes
+	}
+	return nil
+}
+
+func (x *GetUploadConstraintsResponse) GetMaxFileSize() int64 {
+	if x != nil {
+		return x.MaxFileSize
+	}
+	return 0
+}
+
+func (x *GetUploadConstraintsResponse) GetMaxFileSizeByContentType() map[string]int64 {
+	if x != nil {
+		return x.MaxFileSizeByContentType
+	}
+	return nil
+}
+
+func (x *GetUploadConstraintsResponse) GetUploadExpiresIn() int32 {
+	if x != nil {
+		return x.UploadExpiresIn
+	}
+	return 0
+}
+
+func (x *GetUploadConstraintsResponse) GetDownloadExpiresIn() int32 {
+	if x != nil {
+		return x.DownloadExpiresIn
+	}
+	return 0
+}
+
+func (x *GetUploadConstraintsResponse) GetMaxPresignBatchSize() int32 {
+	if x != nil {
+		return x.MaxPresignBatchSize
+	}
+	return 0
+}
+
+func (x *GetUploadConstraintsResponse) GetMaxExpiresIn() int32 {
+	if x != nil {
+		return x.MaxExpiresIn
+	}
+	return 0
+}
+
+func (x *GetUploadConstraintsResponse) GetMinFileSize() int64 {
+	if x != nil {
+		return x.MinFileSize
+	}
+	return 0
+}
+
+// PresignDownloadRequest contains the object key for download
+type PresignDownloadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name where the file is stored. Defaults to the configured default bucket when empty.
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Object key/path in storage
+	ObjectKey string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// Optional: Specific version to download. Defaults to the latest version.
+	VersionId string `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// Optional: Cache-Control header of the download response. Defaults to the value recorded
+	// at upload time when downloading the latest version.
+	CacheControl string `protobuf:"bytes,4,opt,name=cache_control,json=cacheControl,proto3" json:"cache_control,omitempty"`
+	// Optional: Content-Type header of the download response (e.g., "application/pdf"), for objects
+	// stored with a generic content type. The stored object is not changed.
+	ContentType string `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Optional: Expiration of the presigned URL in seconds. Defaults to the server's download expiry
+	// and may not exceed the configured maximum (7 days by default).
+	ExpiresIn int32 `protobuf:"varint,6,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	// Optional: Client IP address (e.g., "203.0.113.7") the URL is restricted to. Storage refuses
+	// the URL from any other address. Backends that cannot enforce it fail with UNIMPLEMENTED.
+	AllowedClientIp string `protobuf:"bytes,7,opt,name=allowed_client_ip,json=allowedClientIp,proto3" json:"allowed_client_ip,omitempty"`
+	// Optional: Return a signed URL of the server's streaming download proxy instead of a storage
+	// URL, for clients that cannot reach storage. Needs ProxyDownload.Secret to be configured and
+	// cannot be combined with version_id or the response overrides.
+	Proxy         bool `protobuf:"varint,8,opt,name=proxy,proto3" json:"proxy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresignDownloadRequest) Reset() {
+	*x = PresignDownloadRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresignDownloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresignDownloadRequest) ProtoMessage() {}
+
+func (x *PresignDownloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresignDownloadRequest.ProtoReflect.Descriptor instead.
+func (*PresignDownloadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PresignDownloadRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *PresignDownloadRequest) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *PresignDownloadRequest) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+func (x *PresignDownloadRequest) GetCacheControl() string {
+	if x != nil {
+		return x.CacheControl
+	}
+	return ""
+}
+
+func (x *PresignDownloadRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *PresignDownloadRequest) GetExpiresIn() int32 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *PresignDownloadRequest) GetAllowedClientIp() string {
+	if x != nil {
+		return x.AllowedClientIp
+	}
+	return ""
+}
+
+func (x *PresignDownloadRequest) GetProxy() bool {
+	if x != nil {
+		return x.Proxy
+	}
+	return false
+}
+
+// PresignDownloadResponse contains the presigned download URL
+type PresignDownloadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Presigned URL for downloading the file
+	PresignedUrl string `protobuf:"bytes,1,opt,name=presigned_url,json=presignedUrl,proto3" json:"presigned_url,omitempty"`
+	// Expiration time in seconds
+	ExpiresIn     int32 `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresignDownloadResponse) Reset() {
+	*x = PresignDownloadResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresignDownloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresignDownloadResponse) ProtoMessage() {}
+
+func (x *PresignDownloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresignDownloadResponse.ProtoReflect.Descriptor instead.
+func (*PresignDownloadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PresignDownloadResponse) GetPresignedUrl() string {
+	if x != nil {
+		return x.PresignedUrl
+	}
+	return ""
+}
+
+func (x *PresignDownloadResponse) GetExpiresIn() int32 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+// PresignHeadRequest identifies the object to presign a HEAD request for
+type PresignHeadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name where the file is stored. Defaults to the configured default bucket when empty.
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Object key/path in storage
+	ObjectKey string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// Optional: Specific version to inspect. Defaults to the latest version.
+	VersionId string `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// Optional: Expiration of the presigned URL in seconds. Defaults to the server's download expiry
+	// and may not exceed the configured maximum (7 days by default).
+	ExpiresIn     int32 `protobuf:"varint,4,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresignHeadRequest) Reset() {
+	*x = PresignHeadRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresignHeadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresignHeadRequest) ProtoMessage() {}
+
+func (x *PresignHeadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresignHeadRequest.ProtoReflect.Descriptor instead.
+func (*PresignHeadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PresignHeadRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *PresignHeadRequest) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *PresignHeadRequest) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+func (x *PresignHeadRequest) GetExpiresIn() int32 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+// PresignHeadResponse contains the presigned HEAD URL
+type PresignHeadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Presigned URL that only accepts HEAD requests
+	PresignedUrl string `protobuf:"bytes,1,opt,name=presigned_url,json=presignedUrl,proto3" json:"presigned_url,omitempty"`
+	// Expiration time in seconds
+	ExpiresIn     int32 `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresignHeadResponse) Reset() {
+	*x = PresignHeadResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresignHeadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresignHeadResponse) ProtoMessage() {}
+
+func (x *PresignHeadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresignHeadResponse.ProtoReflect.Descriptor instead.
+func (*PresignHeadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PresignHeadResponse) GetPresignedUrl() string {
+	if x != nil {
+		return x.PresignedUrl
+	}
+	return ""
+}
+
+func (x *PresignHeadResponse) GetExpiresIn() int32 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+// PresignDeleteRequest identifies the object to delete
+type PresignDeleteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name where the file is stored. Defaults to the configured default bucket when empty.
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Object key/path in storage
+	ObjectKey string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// Optional: Expiration of the presigned URL in seconds. Defaults to 5 minutes
+	// and may not exceed the configured maximum (7 days by default).
+	ExpiresIn     int32 `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresignDeleteRequest) Reset() {
+	*x = PresignDeleteRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresignDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresignDeleteRequest) ProtoMessage() {}
+
+func (x *PresignDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresignDeleteRequest.ProtoReflect.Descriptor instead.
+func (*PresignDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PresignDeleteRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *PresignDeleteRequest) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *PresignDeleteRequest) GetExpiresIn() int32 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+// PresignDeleteResponse contains the presigned DELETE URL
+type PresignDeleteResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Presigned URL that only accepts DELETE requests
+	PresignedUrl string `protobuf:"bytes,1,opt,name=presigned_url,json=presignedUrl,proto3" json:"presigned_url,omitempty"`
+	// Expiration time in seconds
+	ExpiresIn     int32 `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresignDeleteResponse) Reset() {
+	*x = PresignDeleteResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresignDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresignDeleteResponse) ProtoMessage() {}
+
+func (x *PresignDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresignDeleteResponse.ProtoReflect.Descriptor instead.
+func (*PresignDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PresignDeleteResponse) GetPresignedUrl() string {
+	if x != nil {
+		return x.PresignedUrl
+	}
+	return ""
+}
+
+func (x *PresignDeleteResponse) GetExpiresIn() int32 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+// GetPublicURLRequest identifies an object in a public bucket
+type GetPublicURLRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name where the file is stored. Defaults to the configured default bucket when empty.
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Object key/path in storage
+	ObjectKey     string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicURLRequest) Reset() {
+	*x = GetPublicURLRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicURLRequest) ProtoMessage() {}
+
+func (x *GetPublicURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicURLRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicURLRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetPublicURLRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *GetPublicURLRequest) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+// GetPublicURLResponse contains the public URL
+type GetPublicURLResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unsigned URL of the object; it stays valid as long as the bucket remains public
+	Url           string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicURLResponse) Reset() {
+	*x = GetPublicURLResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicURLResponse) ProtoMessage() {}
+
+func (x *GetPublicURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicURLResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicURLResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetPublicURLResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// DeleteObjectRequest contains the object key to delete
+type DeleteObjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name where the file is stored. Defaults to the configured default bucket when empty.
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Object key/path in storage
+	ObjectKey string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// Optional: Specific version to delete permanently. Without it, versioned buckets keep the
+	// previous versions and record a delete marker. Versions are deleted permanently even
+	// when soft delete is enabled.
+	VersionId string `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// Optional: Client-chosen key identifying this delete. A retry with the same key within the
+	// configured window gets the response of the first request instead of deleting again.
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// Optional: Fail with NOT_FOUND when the object, or the given version, does not exist instead
+	// of reporting success. Always on when the server sets FailDeleteIfMissing.
+	FailIfMissing bool `protobuf:"varint,5,opt,name=fail_if_missing,json=failIfMissing,proto3" json:"fail_if_missing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteObjectRequest) Reset() {
+	*x = DeleteObjectRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteObjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteObjectRequest) ProtoMessage() {}
+
+func (x *DeleteObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteObjectRequest.ProtoReflect.Descriptor instead.
+func (*DeleteObjectRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteObjectRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *DeleteObjectRequest) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *DeleteObjectRequest) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+func (x *DeleteObjectRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *DeleteObjectRequest) GetFailIfMissing() bool {
+	if x != nil {
+		return x.FailIfMissing
+	}
+	return false
+}
+
+// DeleteObjectResponse indicates successful deletion
+type DeleteObjectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the deletion was successful
+	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteObjectResponse) Reset() {
+	*x = DeleteObjectResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteObjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteObjectResponse) ProtoMessage() {}
+
+func (x *DeleteObjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteObjectResponse.ProtoReflect.Descriptor instead.
+func (*DeleteObjectResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeleteObjectResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// PutObjectRequest contains the file content and parameters for a direct upload
+type PutObjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name where the file should be uploaded. Defaults to the configured default bucket when empty.
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Content type of the file (e.g., "image/jpeg", "image/png", "image/webp"). Defaults to the
+	// server's default content type when empty; required if none is configured.
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// File content
+	Content []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// Optional: Path/Folder where the file should be uploaded (e.g., "users/avatars")
+	Path string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	// Optional: Exact filename to use. If not provided, a unique UUID will be generated.
+	FileName string `protobuf:"bytes,5,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// Optional: Cache-Control value to persist with the object
+	CacheControl string `protobuf:"bytes,6,opt,name=cache_control,json=cacheControl,proto3" json:"cache_control,omitempty"`
+	// Optional: Expected hex-encoded MD5 of the content
+	ContentMd5 string `protobuf:"bytes,7,opt,name=content_md5,json=contentMd5,proto3" json:"content_md5,omitempty"`
+	// Optional: Expected hex-encoded SHA-256 of the content
+	ChecksumSha256 string `protobuf:"bytes,8,opt,name=checksum_sha256,json=checksumSha256,proto3" json:"checksum_sha256,omitempty"`
+	// Optional: Tags to apply to the object
+	Tags map[string]string `protobuf:"bytes,9,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Optional: KMS key to encrypt the object with, overriding the configured SSE-KMS key
+	KmsKeyId      string `protobuf:"bytes,10,opt,name=kms_key_id,json=kmsKeyId,proto3" json:"kms_key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutObjectRequest) Reset() {
+	*x = PutObjectRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutObjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutObjectRequest) ProtoMessage() {}
+
+func (x *PutObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutObjectRequest.ProtoReflect.Descriptor instead.
+func (*PutObjectRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PutObjectRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *PutObjectRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *PutObjectRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *PutObjectRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PutObjectRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *PutObjectRequest) GetCacheControl() string {
+	if x != nil {
+		return x.CacheControl
+	}
+	return ""
+}
+
+func (x *PutObjectRequest) GetContentMd5() string {
+	if x != nil {
+		return x.ContentMd5
+	}
+	return ""
+}
+
+func (x *PutObjectRequest) GetChecksumSha256() string {
+	if x != nil {
+		return x.ChecksumSha256
+	}
+	return ""
+}
+
+func (x *PutObjectRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PutObjectRequest) GetKmsKeyId() string {
+	if x != nil {
+		return x.KmsKeyId
+	}
+	return ""
+}
+
+// PutObjectResponse contains the stored object key and size
+type PutObjectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Object key/path in storage
+	ObjectKey string `protobuf:"bytes,1,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// Size of the stored object in bytes
+	Size int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// ETag of the stored object, without quotes
+	Etag          string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutObjectResponse) Reset() {
+	*x = PutObjectResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutObjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutObjectResponse) ProtoMessage() {}
+
+func (x *PutObjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutObjectResponse.ProtoReflect.Descriptor instead.
+func (*PutObjectResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PutObjectResponse) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *PutObjectResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *PutObjectResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// UploadObjectRequest is one message of a streaming upload
+type UploadObjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*UploadObjectRequest_Metadata
+	//	*UploadObjectRequest_Chunk
+	Data          isUploadObjectRequest_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadObjectRequest) Reset() {
+	*x = UploadObjectRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadObjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadObjectRequest) ProtoMessage() {}
+
+func (x *UploadObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadObjectRequest.ProtoReflect.Descriptor instead.
+func (*UploadObjectRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UploadObjectRequest) GetData() isUploadObjectRequest_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *UploadObjectRequest) GetMetadata() *UploadObjectMetadata {
+	if x != nil {
+		if x, ok := x.Data.(*UploadObjectRequest_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *UploadObjectRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*UploadObjectRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadObjectRequest_Data interface {
+	isUploadObjectRequest_Data()
+}
+
+type UploadObjectRequest_Metadata struct {
+	// Upload attributes; must be the first message of the stream
+	Metadata *UploadObjectMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type UploadObjectRequest_Chunk struct {
+	// Next chunk of file content
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadObjectRequest_Metadata) isUploadObjectRequest_Data() {}
+
+func (*UploadObjectRequest_Chunk) isUploadObjectRequest_Data() {}
+
+// UploadObjectMetadata describes a streaming upload
+type UploadObjectMetadata struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name where the file should be uploaded. Defaults to the configured default bucket when empty.
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Content type of the file (e.g., "image/jpeg", "image/png", "image/webp").
+	// May be omitted when detect_content_type is set.
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Optional: Path/Folder where the file should be uploaded (e.g., "users/avatars")
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// Optional: Exact filename to use. If not provided, a unique UUID will be generated.
+	FileName string `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// Optional: Total size in bytes if known in advance; lets storage upload in a single request
+	Size int64 `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	// Optional: Detect the content type from the first bytes of the content. If content_type
+	// is also set, the detected type must match it.
+	DetectContentType bool `protobuf:"varint,6,opt,name=detect_content_type,json=detectContentType,proto3" json:"detect_content_type,omitempty"`
+	// Optional: KMS key to encrypt the object with, overriding the configured SSE-KMS key
+	KmsKeyId      string `protobuf:"bytes,7,opt,name=kms_key_id,json=kmsKeyId,proto3" json:"kms_key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadObjectMetadata) Reset() {
+	*x = UploadObjectMetadata{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadObjectMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadObjectMetadata) ProtoMessage() {}
+
+func (x *UploadObjectMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadObjectMetadata.ProtoReflect.Descriptor instead.
+func (*UploadObjectMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UploadObjectMetadata) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *UploadObjectMetadata) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *UploadObjectMetadata) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *UploadObjectMetadata) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *UploadObjectMetadata) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *UploadObjectMetadata) GetDetectContentType() bool {
+	if x != nil {
+		return x.DetectContentType
+	}
+	return false
+}
+
+func (x *UploadObjectMetadata) GetKmsKeyId() string {
+	if x != nil {
+		return x.KmsKeyId
+	}
+	return ""
+}
+
+// UploadObjectResponse contains the stored object key and size
+type UploadObjectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Object key/path in storage
+	ObjectKey string `protobuf:"bytes,1,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// Size of the stored object in bytes
+	Size int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// ETag of the stored object, without quotes
+	Etag          string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadObjectResponse) Reset() {
+	*x = UploadObjectResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadObjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadObjectResponse) ProtoMessage() {}
+
+func (x *UploadObjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadObjectResponse.ProtoReflect.Descriptor instead.
+func (*UploadObjectResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UploadObjectResponse) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *UploadObjectResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *UploadObjectResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// GetObjectRequest identifies the object to stream
+type GetObjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name where the file is stored. Defaults to the configured default bucket when empty.
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Object key/path in storage
+	ObjectKey string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// Optional: Byte offset to start streaming at, e.g. to resume an interrupted download
+	Offset int64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// Optional: Number of bytes to stream. Defaults to the rest of the object.
+	Length        int64 `protobuf:"varint,4,opt,name=length,proto3" json:"length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetObjectRequest) Reset() {
+	*x = GetObjectRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectRequest) ProtoMessage() {}
+
+func (x *GetObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectRequest.ProtoReflect.Descriptor instead.
+func (*GetObjectRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetObjectRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *GetObjectRequest) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *GetObjectRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetObjectRequest) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+// GetObjectResponse is one message of a streaming download
+type GetObjectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Data:
+	//
+	//	*GetObjectResponse_Metadata
+	//	*GetObjectResponse_Chunk
+	Data          isGetObjectResponse_Data `protobuf_oneof:"data"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetObjectResponse) Reset() {
+	*x = GetObjectResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectResponse) ProtoMessage() {}
+
+func (x *GetObjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectResponse.ProtoReflect.Descriptor instead.
+func (*GetObjectResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetObjectResponse) GetData() isGetObjectResponse_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GetObjectResponse) GetMetadata() *GetObjectMetadata {
+	if x != nil {
+		if x, ok := x.Data.(*GetObjectResponse_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *GetObjectResponse) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Data.(*GetObjectResponse_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isGetObjectResponse_Data interface {
+	isGetObjectResponse_Data()
+}
+
+type GetObjectResponse_Metadata struct {
+	// Object attributes; always the first message of the stream
+	Metadata *GetObjectMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type GetObjectResponse_Chunk struct {
+	// Next chunk of file content
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*GetObjectResponse_Metadata) isGetObjectResponse_Data() {}
+
+func (*GetObjectResponse_Chunk) isGetObjectResponse_Data() {}
+
+// GetObjectMetadata describes a streaming download
+type GetObjectMetadata struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Content type of the object
+	ContentType string `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Total size of the object in bytes
+	Size int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// Number of bytes that will be streamed
+	Length int64 `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	// ETag of the object
+	Etag string `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"`
+	// Last modification time of the object
+	LastModified  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_modified,json=lastModified,proto3" json:"last_modified,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetObjectMetadata) Reset() {
+	*x = GetObjectMetadata{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObjectMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectMetadata) ProtoMessage() {}
+
+func (x *GetObjectMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectMetadata.ProtoReflect.Descriptor instead.
+func (*GetObjectMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetObjectMetadata) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetObjectMetadata) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetObjectMetadata) GetLength() int64 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *GetObjectMetadata) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *GetObjectMetadata) GetLastModified() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModified
+	}
+	return nil
+}
+
+// ConfirmUploadRequest identifies the uploaded object
+type ConfirmUploadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name where the file was uploaded. Defaults to the configured default bucket when empty.
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Object key/path returned by PresignUpload
+	ObjectKey     string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmUploadRequest) Reset() {
+	*x = ConfirmUploadRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmUploadRequest) ProtoMessage() {}
+
+func (x *ConfirmUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmUploadRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmUploadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ConfirmUploadRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *ConfirmUploadRequest) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+// ConfirmUploadResponse describes the confirmed object
+type ConfirmUploadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Object key/path in storage
+	ObjectKey string `protobuf:"bytes,1,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// Size of the object in bytes
+	Size int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// Content type of the object
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Whether a checksum supplied at presign time was verified
+	ChecksumVerified bool `protobuf:"varint,4,opt,name=checksum_verified,json=checksumVerified,proto3" json:"checksum_verified,omitempty"`
+	// Tags supplied at presign time that were applied to the object
+	Tags map[string]string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Whether the object was scanned for malware and found clean
+	MalwareScanned bool `protobuf:"varint,6,opt,name=malware_scanned,json=malwareScanned,proto3" json:"malware_scanned,omitempty"`
+	// Time a bucket lifecycle rule expires the object; unset when no rule applies
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// ETag of the object, without quotes
+	Etag          string `protobuf:"bytes,8,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmUploadResponse) Reset() {
+	*x = ConfirmUploadResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmUploadResponse) ProtoMessage() {}
+
+func (x *ConfirmUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmUploadResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmUploadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ConfirmUploadResponse) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *ConfirmUploadResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ConfirmUploadResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ConfirmUploadResponse) GetChecksumVerified() bool {
+	if x != nil {
+		return x.ChecksumVerified
+	}
+	return false
+}
+
+func (x *ConfirmUploadResponse) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ConfirmUploadResponse) GetMalwareScanned() bool {
+	if x != nil {
+		return x.MalwareScanned
+	}
+	return false
+}
+
+func (x *ConfirmUploadResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ConfirmUploadResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+// CopyObjectRequest identifies the object to copy and the attributes to override
+type CopyObjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name where the file is stored. Defaults to the configured default bucket when empty.
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Object key of the source
+	SourceKey string `protobuf:"bytes,2,opt,name=source_key,json=sourceKey,proto3" json:"source_key,omitempty"`
+	// Object key of the copy. May equal source_key when an override is set, to update the object in place.
+	DestinationKey string `protobuf:"bytes,3,opt,name=destination_key,json=destinationKey,proto3" json:"destination_key,omitempty"`
+	// Optional: Content type of the copy; must be an allowed content type. Defaults to the source content type.
+	ContentType string `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Optional: Cache-Control value of the copy. Defaults to the source value.
+	CacheControl string `protobuf:"bytes,5,opt,name=cache_control,json=cacheControl,proto3" json:"cache_control,omitempty"`
+	// Optional: Application metadata of the copy, replacing that of the source
+	Metadata map[string]string `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Optional: Client-chosen key identifying this copy. A retry with the same key within the
+	// configured window gets the response of the first request instead of copying again.
+	IdempotencyKey string `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CopyObjectRequest) Reset() {
+	*x = CopyObjectRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyObjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyObjectRequest) ProtoMessage() {}
+
+func (x *CopyObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyObjectRequest.ProtoReflect.Descriptor instead.
+func (*CopyObjectRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CopyObjectRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *CopyObjectRequest) GetSourceKey() string {
+	if x != nil {
+		return x.SourceKey
+	}
+	return ""
+}
+
+func (x *CopyObjectRequest) GetDestinationKey() string {
+	if x != nil {
+		return x.DestinationKey
+	}
+	return ""
+}
+
+func (x *CopyObjectRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *CopyObjectRequest) GetCacheControl() string {
+	if x != nil {
+		return x.CacheControl
+	}
+	return ""
+}
+
+func (x *CopyObjectRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CopyObjectRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+// CopyObjectResponse describes the copy
+type CopyObjectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Object key/path of the copy
+	ObjectKey string `protobuf:"bytes,1,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// Size of the copy in bytes
+	Size int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// Content type of the copy
+	ContentType   string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyObjectResponse) Reset() {
+	*x = CopyObjectResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyObjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyObjectResponse) ProtoMessage() {}
+
+func (x *CopyObjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyObjectResponse.ProtoReflect.Descriptor instead.
+func (*CopyObjectResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CopyObjectResponse) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *CopyObjectResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CopyObjectResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+// MoveObjectRequest contains the source and destination of a move
+type MoveObjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name where the file is stored. Defaults to the configured default bucket when empty.
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Object key/path of the object to move
+	SourceKey string `protobuf:"bytes,2,opt,name=source_key,json=sourceKey,proto3" json:"source_key,omitempty"`
+	// Optional: Bucket to move the object to. Defaults to bucket_name.
+	DestinationBucket string `protobuf:"bytes,3,opt,name=destination_bucket,json=destinationBucket,proto3" json:"destination_bucket,omitempty"`
+	// Optional: Object key/path of the moved object. Defaults to source_key.
+	DestinationKey string `protobuf:"bytes,4,opt,name=destination_key,json=destinationKey,proto3" json:"destination_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MoveObjectRequest) Reset() {
+	*x = MoveObjectRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveObjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveObjectRequest) ProtoMessage() {}
+
+func (x *MoveObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveObjectRequest.ProtoReflect.Descriptor instead.
+func (*MoveObjectRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MoveObjectRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *MoveObjectRequest) GetSourceKey() string {
+	if x != nil {
+		return x.SourceKey
+	}
+	return ""
+}
+
+func (x *MoveObjectRequest) GetDestinationBucket() string {
+	if x != nil {
+		return x.DestinationBucket
+	}
+	return ""
+}
+
+func (x *MoveObjectRequest) GetDestinationKey() string {
+	if x != nil {
+		return x.DestinationKey
+	}
+	return ""
+}
+
+// MoveObjectResponse describes the moved object
+type MoveObjectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name the object was moved to
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Object key/path in storage
+	ObjectKey string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// Size of the object in bytes
+	Size          int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveObjectResponse) Reset() {
+	*x = MoveObjectResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveObjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveObjectResponse) ProtoMessage() {}
+
+func (x *MoveObjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveObjectResponse.ProtoReflect.Descriptor instead.
+func (*MoveObjectResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MoveObjectResponse) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *MoveObjectResponse) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *MoveObjectResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// RestoreObjectRequest identifies a soft-deleted object
+type RestoreObjectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name where the file was stored. Defaults to the configured default bucket when empty.
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Object key the object had before it was deleted
+	ObjectKey     string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreObjectRequest) Reset() {
+	*x = RestoreObjectRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreObjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreObjectRequest) ProtoMessage() {}
+
+func (x *RestoreObjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreObjectRequest.ProtoReflect.Descriptor instead.
+func (*RestoreObjectRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RestoreObjectRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *RestoreObjectRequest) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+// RestoreObjectResponse describes the restored object
+type RestoreObjectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Object key/path in storage
+	ObjectKey string `protobuf:"bytes,1,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// Size of the object in bytes
+	Size          int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreObjectResponse) Reset() {
+	*x = RestoreObjectResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreObjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreObjectResponse) ProtoMessage() {}
+
+func (x *RestoreObjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreObjectResponse.ProtoReflect.Descriptor instead.
+func (*RestoreObjectResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RestoreObjectResponse) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *RestoreObjectResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// SetObjectTagsRequest contains the tags to set on an object
+type SetObjectTagsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name where the file is stored. Defaults to the configured default bucket when empty.
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Object key/path in storage
+	ObjectKey string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// Tags to set, replacing any existing tags
+	Tags          map[string]string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetObjectTagsRequest) Reset() {
+	*x = SetObjectTagsRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetObjectTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetObjectTagsRequest) ProtoMessage() {}
+
+func (x *SetObjectTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetObjectTagsRequest.ProtoReflect.Descriptor instead.
+func (*SetObjectTagsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SetObjectTagsRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *SetObjectTagsRequest) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *SetObjectTagsRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// SetObjectTagsResponse indicates the tags were set
+type SetObjectTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetObjectTagsResponse) Reset() {
+	*x = SetObjectTagsResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetObjectTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetObjectTagsResponse) ProtoMessage() {}
+
+func (x *SetObjectTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetObjectTagsResponse.ProtoReflect.Descriptor instead.
+func (*SetObjectTagsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SetObjectTagsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// GetObjectTagsRequest identifies the object
+type GetObjectTagsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name where the file is stored. Defaults to the configured default bucket when empty.
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Object key/path in storage
+	ObjectKey     string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetObjectTagsRequest) Reset() {
+	*x = GetObjectTagsRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObjectTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectTagsRequest) ProtoMessage() {}
+
+func (x *GetObjectTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectTagsRequest.ProtoReflect.Descriptor instead.
+func (*GetObjectTagsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetObjectTagsRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *GetObjectTagsRequest) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+// GetObjectTagsResponse contains the tags of an object
+type GetObjectTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          map[string]string      `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetObjectTagsResponse) Reset() {
+	*x = GetObjectTagsResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObjectTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectTagsResponse) ProtoMessage() {}
+
+func (x *GetObjectTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectTagsResponse.ProtoReflect.Descriptor instead.
+func (*GetObjectTagsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetObjectTagsResponse) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// SetObjectACLRequest identifies the object and its new access level
+type SetObjectACLRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name where the file is stored. Defaults to the configured default bucket when empty.
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Object key/path in storage
+	ObjectKey string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// Access level to set
+	Acl           ObjectACL `protobuf:"varint,3,opt,name=acl,proto3,enum=v1.ObjectACL" json:"acl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetObjectACLRequest) Reset() {
+	*x = SetObjectACLRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetObjectACLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetObjectACLRequest) ProtoMessage() {}
+
+func (x *SetObjectACLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetObjectACLRequest.ProtoReflect.Descriptor instead.
+func (*SetObjectACLRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SetObjectACLRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *SetObjectACLRequest) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *SetObjectACLRequest) GetAcl() ObjectACL {
+	if x != nil {
+		return x.Acl
+	}
+	return ObjectACL_OBJECT_ACL_UNSPECIFIED
+}
+
+// SetObjectACLResponse indicates the access level was set
+type SetObjectACLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetObjectACLResponse) Reset() {
+	*x = SetObjectACLResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetObjectACLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetObjectACLResponse) ProtoMessage() {}
+
+func (x *SetObjectACLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetObjectACLResponse.ProtoReflect.Descriptor instead.
+func (*SetObjectACLResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SetObjectACLResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// GetObjectACLRequest identifies the object
+type GetObjectACLRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name where the file is stored. Defaults to the configured default bucket when empty.
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Object key/path in storage
+	ObjectKey     string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetObjectACLRequest) Reset() {
+	*x = GetObjectACLRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObjectACLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectACLRequest) ProtoMessage() {}
+
+func (x *GetObjectACLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectACLRequest.ProtoReflect.Descriptor instead.
+func (*GetObjectACLRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetObjectACLRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *GetObjectACLRequest) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+// GetObjectACLResponse contains the effective access level of an object
+type GetObjectACLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Acl           ObjectACL              `protobuf:"varint,1,opt,name=acl,proto3,enum=v1.ObjectACL" json:"acl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetObjectACLResponse) Reset() {
+	*x = GetObjectACLResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObjectACLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectACLResponse) ProtoMessage() {}
+
+func (x *GetObjectACLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectACLResponse.ProtoReflect.Descriptor instead.
+func (*GetObjectACLResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetObjectACLResponse) GetAcl() ObjectACL {
+	if x != nil {
+		return x.Acl
+	}
+	return ObjectACL_OBJECT_ACL_UNSPECIFIED
+}
+
+// SetObjectRetentionRequest contains the retention to set on an object
+type SetObjectRetentionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name where the file is stored. Defaults to the configured default bucket when empty.
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Object key/path in storage
+	ObjectKey string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// Optional: Version to protect. Defaults to the latest version.
+	VersionId string `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// Retention mode to set
+	Mode RetentionMode `protobuf:"varint,4,opt,name=mode,proto3,enum=v1.RetentionMode" json:"mode,omitempty"`
+	// Date until which the object is protected. Must be in the future and within the
+	// server's maximum retention.
+	RetainUntil *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=retain_until,json=retainUntil,proto3" json:"retain_until,omitempty"`
+	// Optional: Shorten or replace a retention in governance mode. Requires admin access.
+	BypassGovernance bool `protobuf:"varint,6,opt,name=bypass_governance,json=bypassGovernance,proto3" json:"bypass_governance,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SetObjectRetentionRequest) Reset() {
+	*x = SetObjectRetentionRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetObjectRetentionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetObjectRetentionRequest) ProtoMessage() {}
+
+func (x *SetObjectRetentionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetObjectRetentionRequest.ProtoReflect.Descriptor instead.
+func (*SetObjectRetentionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SetObjectRetentionRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *SetObjectRetentionRequest) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *SetObjectRetentionRequest) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+func (x *SetObjectRetentionRequest) GetMode() RetentionMode {
+	if x != nil {
+		return x.Mode
+	}
+	return RetentionMode_RETENTION_MODE_UNSPECIFIED
+}
+
+func (x *SetObjectRetentionRequest) GetRetainUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RetainUntil
+	}
+	return nil
+}
+
+func (x *SetObjectRetentionRequest) GetBypassGovernance() bool {
+	if x != nil {
+		return x.BypassGovernance
+	}
+	return false
+}
+
+// SetObjectRetentionResponse indicates the retention was set
+type SetObjectRetentionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetObjectRetentionResponse) Reset() {
+	*x = SetObjectRetentionResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetObjectRetentionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetObjectRetentionResponse) ProtoMessage() {}
+
+func (x *SetObjectRetentionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetObjectRetentionResponse.ProtoReflect.Descriptor instead.
+func (*SetObjectRetentionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SetObjectRetentionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// SetObjectLegalHoldRequest contains the legal hold to place on or remove from an object
+type SetObjectLegalHoldRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name where the file is stored. Defaults to the configured default bucket when empty.
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Object key/path in storage
+	ObjectKey string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// Optional: Version to hold. Defaults to the latest version.
+	VersionId string `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// true places the hold, false removes it
+	Enabled       bool `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetObjectLegalHoldRequest) Reset() {
+	*x = SetObjectLegalHoldRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetObjectLegalHoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetObjectLegalHoldRequest) ProtoMessage() {}
+
+func (x *SetObjectLegalHoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetObjectLegalHoldRequest.ProtoReflect.Descriptor instead.
+func (*SetObjectLegalHoldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SetObjectLegalHoldRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *SetObjectLegalHoldRequest) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *SetObjectLegalHoldRequest) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+func (x *SetObjectLegalHoldRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// SetObjectLegalHoldResponse indicates the legal hold was set
+type SetObjectLegalHoldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetObjectLegalHoldResponse) Reset() {
+	*x = SetObjectLegalHoldResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetObjectLegalHoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetObjectLegalHoldResponse) ProtoMessage() {}
+
+func (x *SetObjectLegalHoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetObjectLegalHoldResponse.ProtoReflect.Descriptor instead.
+func (*SetObjectLegalHoldResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SetObjectLegalHoldResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// GetObjectMetadataRequest identifies the object to describe
+type GetObjectMetadataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name where the file is stored. Defaults to the configured default bucket when empty.
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Object key/path in storage
+	ObjectKey     string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetObjectMetadataRequest) Reset() {
+	*x = GetObjectMetadataRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObjectMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectMetadataRequest) ProtoMessage() {}
+
+func (x *GetObjectMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectMetadataRequest.ProtoReflect.Descriptor instead.
+func (*GetObjectMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetObjectMetadataRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *GetObjectMetadataRequest) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+// GetObjectMetadataResponse contains the attributes and application metadata of an object
+type GetObjectMetadataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Object key/path in storage
+	ObjectKey string `protobuf:"bytes,1,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// Size of the object in bytes
+	Size int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// Content type of the object
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// ETag of the object, without quotes
+	Etag string `protobuf:"bytes,4,opt,name=etag,proto3" json:"etag,omitempty"`
+	// Time the object was last modified
+	LastModified *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_modified,json=lastModified,proto3" json:"last_modified,omitempty"`
+	// Cache-Control value recorded at upload time, if any
+	CacheControl string `protobuf:"bytes,6,opt,name=cache_control,json=cacheControl,proto3" json:"cache_control,omitempty"`
+	// Application metadata set at upload time
+	Metadata map[string]string `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Time a bucket lifecycle rule expires the object; unset when no rule applies
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetObjectMetadataResponse) Reset() {
+	*x = GetObjectMetadataResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetObjectMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectMetadataResponse) ProtoMessage() {}
+
+func (x *GetObjectMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectMetadataResponse.ProtoReflect.Descriptor instead.
+func (*GetObjectMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetObjectMetadataResponse) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *GetObjectMetadataResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetObjectMetadataResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *GetObjectMetadataResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *GetObjectMetadataResponse) GetLastModified() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModified
+	}
+	return nil
+}
+
+func (x *GetObjectMetadataResponse) GetCacheControl() string {
+	if x != nil {
+		return x.CacheControl
+	}
+	return ""
+}
+
+func (x *GetObjectMetadataResponse) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *GetObjectMetadataResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// BatchObjectExistsRequest lists the objects to look up
+type BatchObjectExistsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name where the files are stored. Defaults to the configured default bucket when empty.
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Object keys to check, at most the configured batch size
+	ObjectKeys    []string `protobuf:"bytes,2,rep,name=object_keys,json=objectKeys,proto3" json:"object_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchObjectExistsRequest) Reset() {
+	*x = BatchObjectExistsRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchObjectExistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchObjectExistsRequest) ProtoMessage() {}
+
+func (x *BatchObjectExistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchObjectExistsRequest.ProtoReflect.Descriptor instead.
+func (*BatchObjectExistsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *BatchObjectExistsRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *BatchObjectExistsRequest) GetObjectKeys() []string {
+	if x != nil {
+		return x.ObjectKeys
+	}
+	return nil
+}
+
+// BatchObjectExistsResponse contains one result per requested key, in request order
+type BatchObjectExistsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*ObjectExistsResult  `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchObjectExistsResponse) Reset() {
+	*x = BatchObjectExistsResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchObjectExistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchObjectExistsResponse) ProtoMessage() {}
+
+func (x *BatchObjectExistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchObjectExistsResponse.ProtoReflect.Descriptor instead.
+func (*BatchObjectExistsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *BatchObjectExistsResponse) GetResults() []*ObjectExistsResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// ObjectExistsResult holds the outcome of one key of a batch
+type ObjectExistsResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Object key/path in storage
+	ObjectKey string `protobuf:"bytes,1,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// Whether the object exists; only meaningful when error_code is 0
+	Exists bool `protobuf:"varint,2,opt,name=exists,proto3" json:"exists,omitempty"`
+	// gRPC status code of the failed check (e.g., 14 for UNAVAILABLE); 0 on success
+	ErrorCode int32 `protobuf:"varint,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// Description of the failure; empty on success
+	ErrorMessage  string `protobuf:"bytes,4,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ObjectExistsResult) Reset() {
+	*x = ObjectExistsResult{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ObjectExistsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectExistsResult) ProtoMessage() {}
+
+func (x *ObjectExistsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectExistsResult.ProtoReflect.Descriptor instead.
+func (*ObjectExistsResult) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ObjectExistsResult) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *ObjectExistsResult) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+func (x *ObjectExistsResult) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *ObjectExistsResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// SetBucketVersioningRequest contains the desired versioning state
+type SetBucketVersioningRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// True to enable versioning, false to suspend it
+	Enabled       bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBucketVersioningRequest) Reset() {
+	*x = SetBucketVersioningRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBucketVersioningRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBucketVersioningRequest) ProtoMessage() {}
+
+func (x *SetBucketVersioningRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBucketVersioningRequest.ProtoReflect.Descriptor instead.
+func (*SetBucketVersioningRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SetBucketVersioningRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *SetBucketVersioningRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// SetBucketVersioningResponse indicates the versioning state was updated
+type SetBucketVersioningResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBucketVersioningResponse) Reset() {
+	*x = SetBucketVersioningResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBucketVersioningResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBucketVersioningResponse) ProtoMessage() {}
+
+func (x *SetBucketVersioningResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBucketVersioningResponse.ProtoReflect.Descriptor instead.
+func (*SetBucketVersioningResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SetBucketVersioningResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// SetBucketLifecycleRequest contains the expiration rule for a prefix
+type SetBucketLifecycleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Key prefix the rule applies to, e.g. "tmp/"
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Days after upload when objects under the prefix are deleted
+	ExpirationDays int32 `protobuf:"varint,3,opt,name=expiration_days,json=expirationDays,proto3" json:"expiration_days,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetBucketLifecycleRequest) Reset() {
+	*x = SetBucketLifecycleRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBucketLifecycleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBucketLifecycleRequest) ProtoMessage() {}
+
+func (x *SetBucketLifecycleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBucketLifecycleRequest.ProtoReflect.Descriptor instead.
+func (*SetBucketLifecycleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SetBucketLifecycleRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *SetBucketLifecycleRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *SetBucketLifecycleRequest) GetExpirationDays() int32 {
+	if x != nil {
+		return x.ExpirationDays
+	}
+	return 0
+}
+
+// SetBucketLifecycleResponse indicates the lifecycle rule was applied
+type SetBucketLifecycleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBucketLifecycleResponse) Reset() {
+	*x = SetBucketLifecycleResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBucketLifecycleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBucketLifecycleResponse) ProtoMessage() {}
+
+func (x *SetBucketLifecycleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBucketLifecycleResponse.ProtoReflect.Descriptor instead.
+func (*SetBucketLifecycleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SetBucketLifecycleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// GetBucketStatsRequest selects the objects to count
+type GetBucketStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name to scan
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Optional: Only count objects whose key starts with this prefix (e.g., "users/")
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Optional: Stop after this many objects. Defaults to the server's scan cap, which also bounds
+	// larger values.
+	MaxObjects    int64 `protobuf:"varint,3,opt,name=max_objects,json=maxObjects,proto3" json:"max_objects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBucketStatsRequest) Reset() {
+	*x = GetBucketStatsRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBucketStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBucketStatsRequest) ProtoMessage() {}
+
+func (x *GetBucketStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBucketStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetBucketStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetBucketStatsRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *GetBucketStatsRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *GetBucketStatsRequest) GetMaxObjects() int64 {
+	if x != nil {
+		return x.MaxObjects
+	}
+	return 0
+}
+
+// GetBucketStatsResponse contains the counted usage
+type GetBucketStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of objects counted
+	ObjectCount int64 `protobuf:"varint,1,opt,name=object_count,json=objectCount,proto3" json:"object_count,omitempty"`
+	// Total size of the counted objects in bytes
+	TotalBytes int64 `protobuf:"varint,2,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	// Whether the scan stopped early at max_objects, the scan cap or the scan timeout, so the totals
+	// are lower bounds
+	Truncated     bool `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBucketStatsResponse) Reset() {
+	*x = GetBucketStatsResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBucketStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBucketStatsResponse) ProtoMessage() {}
+
+func (x *GetBucketStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBucketStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetBucketStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetBucketStatsResponse) GetObjectCount() int64 {
+	if x != nil {
+		return x.ObjectCount
+	}
+	return 0
+}
+
+func (x *GetBucketStatsResponse) GetTotalBytes() int64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *GetBucketStatsResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// FindObjectsByTagRequest describes the objects to find
+type FindObjectsByTagRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name where the files are stored. Defaults to the configured default bucket when empty.
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Optional: Only objects whose key starts with this prefix are scanned (e.g., "gallery/")
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// Tags an object must carry, all with exactly these values (e.g., {"album": "2024"})
+	Tags map[string]string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Optional: Maximum number of objects to return. Defaults to the server maximum and may not exceed it.
+	MaxResults    int32 `protobuf:"varint,4,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindObjectsByTagRequest) Reset() {
+	*x = FindObjectsByTagRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindObjectsByTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindObjectsByTagRequest) ProtoMessage() {}
+
+func (x *FindObjectsByTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindObjectsByTagRequest.ProtoReflect.Descriptor instead.
+func (*FindObjectsByTagRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *FindObjectsByTagRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *FindObjectsByTagRequest) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *FindObjectsByTagRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *FindObjectsByTagRequest) GetMaxResults() int32 {
+	if x != nil {
+		return x.MaxResults
+	}
+	return 0
+}
+
+// FindObjectsByTagResponse lists the matching objects in key order
+type FindObjectsByTagResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Objects []*TaggedObject        `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	// Whether the scan stopped early at max_results, the scan cap or the scan timeout, so more
+	// objects may match
+	Truncated bool `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// Number of objects whose tags were read
+	ScannedObjects int64 `protobuf:"varint,3,opt,name=scanned_objects,json=scannedObjects,proto3" json:"scanned_objects,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FindObjectsByTagResponse) Reset() {
+	*x = FindObjectsByTagResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindObjectsByTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindObjectsByTagResponse) ProtoMessage() {}
+
+func (x *FindObjectsByTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindObjectsByTagResponse.ProtoReflect.Descriptor instead.
+func (*FindObjectsByTagResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *FindObjectsByTagResponse) GetObjects() []*TaggedObject {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+func (x *FindObjectsByTagResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *FindObjectsByTagResponse) GetScannedObjects() int64 {
+	if x != nil {
+		return x.ScannedObjects
+	}
+	return 0
+}
+
+// TaggedObject is an object found by its tags
+type TaggedObject struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Object key/path in storage
+	ObjectKey string `protobuf:"bytes,1,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// All tags of the object
+	Tags          map[string]string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaggedObject) Reset() {
+	*x = TaggedObject{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaggedObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaggedObject) ProtoMessage() {}
+
+func (x *TaggedObject) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaggedObject.ProtoReflect.Descriptor instead.
+func (*TaggedObject) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *TaggedObject) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *TaggedObject) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// ListObjectVersionsRequest identifies the object
+type ListObjectVersionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name where the file is stored. Defaults to the configured default bucket when empty.
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Object key/path in storage
+	ObjectKey     string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListObjectVersionsRequest) Reset() {
+	*x = ListObjectVersionsRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListObjectVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListObjectVersionsRequest) ProtoMessage() {}
+
+func (x *ListObjectVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListObjectVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListObjectVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListObjectVersionsRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *ListObjectVersionsRequest) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+// ObjectVersion describes one version of an object
+type ObjectVersion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Version identifier
+	VersionId string `protobuf:"bytes,1,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// Whether this is the current version
+	IsLatest bool `protobuf:"varint,2,opt,name=is_latest,json=isLatest,proto3" json:"is_latest,omitempty"`
+	// Whether this version is a delete marker
+	IsDeleteMarker bool `protobuf:"varint,3,opt,name=is_delete_marker,json=isDeleteMarker,proto3" json:"is_delete_marker,omitempty"`
+	// Size of the version in bytes
+	Size int64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// ETag of the version
+	Etag string `protobuf:"bytes,5,opt,name=etag,proto3" json:"etag,omitempty"`
+	// Time the version was created
+	LastModified  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_modified,json=lastModified,proto3" json:"last_modified,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ObjectVersion) Reset() {
+	*x = ObjectVersion{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ObjectVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectVersion) ProtoMessage() {}
+
+func (x *ObjectVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectVersion.ProtoReflect.Descriptor instead.
+func (*ObjectVersion) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ObjectVersion) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+func (x *ObjectVersion) GetIsLatest() bool {
+	if x != nil {
+		return x.IsLatest
+	}
+	return false
+}
+
+func (x *ObjectVersion) GetIsDeleteMarker() bool {
+	if x != nil {
+		return x.IsDeleteMarker
+	}
+	return false
+}
+
+func (x *ObjectVersion) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ObjectVersion) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *ObjectVersion) GetLastModified() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModified
+	}
+	return nil
+}
+
+// ListObjectVersionsResponse contains the versions of an object
+type ListObjectVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Versions      []*ObjectVersion       `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListObjectVersionsResponse) Reset() {
+	*x = ListObjectVersionsResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListObjectVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListObjectVersionsResponse) ProtoMessage() {}
+
+func (x *ListObjectVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListObjectVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListObjectVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListObjectVersionsResponse) GetVersions() []*ObjectVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+// ListUploadedPartsRequest identifies an in-progress multipart upload
+type ListUploadedPartsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name where the file is being uploaded. Defaults to the configured default bucket when empty.
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Object key/path the upload will be stored under
+	ObjectKey string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// ID of the multipart upload
+	UploadId      string `protobuf:"bytes,3,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUploadedPartsRequest) Reset() {
+	*x = ListUploadedPartsRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUploadedPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUploadedPartsRequest) ProtoMessage() {}
+
+func (x *ListUploadedPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUploadedPartsRequest.ProtoReflect.Descriptor instead.
+func (*ListUploadedPartsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListUploadedPartsRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *ListUploadedPartsRequest) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *ListUploadedPartsRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+// UploadedPart describes a part of a multipart upload
+type UploadedPart struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of the part
+	PartNumber int32 `protobuf:"varint,1,opt,name=part_number,json=partNumber,proto3" json:"part_number,omitempty"`
+	// Size of the part in bytes
+	Size int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// ETag of the part, needed to complete the upload
+	Etag string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	// Time the part was uploaded
+	LastModified  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_modified,json=lastModified,proto3" json:"last_modified,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadedPart) Reset() {
+	*x = UploadedPart{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadedPart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadedPart) ProtoMessage() {}
+
+func (x *UploadedPart) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadedPart.ProtoReflect.Descriptor instead.
+func (*UploadedPart) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *UploadedPart) GetPartNumber() int32 {
+	if x != nil {
+		return x.PartNumber
+	}
+	return 0
+}
+
+func (x *UploadedPart) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *UploadedPart) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *UploadedPart) GetLastModified() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModified
+	}
+	return nil
+}
+
+// ListUploadedPartsResponse contains the uploaded parts, ordered by part number
+type ListUploadedPartsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Parts         []*UploadedPart        `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUploadedPartsResponse) Reset() {
+	*x = ListUploadedPartsResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUploadedPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUploadedPartsResponse) ProtoMessage() {}
+
+func (x *ListUploadedPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUploadedPartsResponse.ProtoReflect.Descriptor instead.
+func (*ListUploadedPartsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListUploadedPartsResponse) GetParts() []*UploadedPart {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+// ConvertImageRequest identifies the source image and the requested output
+type ConvertImageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name where the source image is stored (the result is stored in the same bucket). Defaults to the configured default bucket when empty.
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Object key of the source image
+	ObjectKey string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// Target format: "jpeg", "png" or "webp"
+	TargetFormat string `protobuf:"bytes,3,opt,name=target_format,json=targetFormat,proto3" json:"target_format,omitempty"`
+	// Optional: Encoding quality for lossy formats (1-100). Defaults to 85 when not provided.
+	Quality int32 `protobuf:"varint,4,opt,name=quality,proto3" json:"quality,omitempty"`
+	// Optional: Object key for the converted image. Defaults to the source key with the target extension.
+	DestinationKey string `protobuf:"bytes,5,opt,name=destination_key,json=destinationKey,proto3" json:"destination_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConvertImageRequest) Reset() {
+	*x = ConvertImageRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertImageRequest) ProtoMessage() {}
+
+func (x *ConvertImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertImageRequest.ProtoReflect.Descriptor instead.
+func (*ConvertImageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ConvertImageRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *ConvertImageRequest) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *ConvertImageRequest) GetTargetFormat() string {
+	if x != nil {
+		return x.TargetFormat
+	}
+	return ""
+}
+
+func (x *ConvertImageRequest) GetQuality() int32 {
+	if x != nil {
+		return x.Quality
+	}
+	return 0
+}
+
+func (x *ConvertImageRequest) GetDestinationKey() string {
+	if x != nil {
+		return x.DestinationKey
+	}
+	return ""
+}
+
+// ConvertImageResponse describes the converted object
+type ConvertImageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Object key of the converted image
+	ObjectKey string `protobuf:"bytes,1,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// Content type of the converted image
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Size of the converted image in bytes
+	Size          int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertImageResponse) Reset() {
+	*x = ConvertImageResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertImageResponse) ProtoMessage() {}
+
+func (x *ConvertImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertImageResponse.ProtoReflect.Descriptor instead.
+func (*ConvertImageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ConvertImageResponse) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+func (x *ConvertImageResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ConvertImageResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// SanitizeImageRequest identifies the image to strip metadata from
+type SanitizeImageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bucket name where the image is stored. Defaults to the configured default bucket when empty.
+	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// Object key of the image (overwritten in place)
+	ObjectKey     string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SanitizeImageRequest) Reset() {
+	*x = SanitizeImageRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SanitizeImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SanitizeImageRequest) ProtoMessage() {}
+
+func (x *SanitizeImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SanitizeImageRequest.ProtoReflect.Descriptor instead.
+func (*SanitizeImageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SanitizeImageRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *SanitizeImageRequest) GetObjectKey() string {
+	if x != nil {
+		return x.ObjectKey
+	}
+	return ""
+}
+
+// SanitizeImageResponse describes the sanitized object
+type SanitizeImageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Content type of the image
+	ContentType string `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Size of the image before sanitizing, in bytes
+	OriginalSize int64 `protobuf:"varint,2,opt,name=original_size,json=originalSize,proto3" json:"original_size,omitempty"`
+	// Size of the sanitized image, in bytes
+	Size          int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SanitizeImageResponse) Reset() {
+	*x = SanitizeImageResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SanitizeImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SanitizeImageResponse) ProtoMessage() {}
+
+func (x *SanitizeImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SanitizeImageResponse.ProtoReflect.Descriptor instead.
+func (*SanitizeImageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SanitizeImageResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *SanitizeImageResponse) GetOriginalSize() int64 {
+	if x != nil {
+		return x.OriginalSize
+	}
+	return 0
+}
+
+func (x *SanitizeImageResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
 }
 
-// PresignUploadResponse contains the presigned URL and metadata
-type PresignUploadResponse struct {
+// UpdateCredentialsRequest carries the new storage access keys
+type UpdateCredentialsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Presigned URL for uploading the file
-	PresignedUrl string `protobuf:"bytes,1,opt,name=presigned_url,json=presignedUrl,proto3" json:"presigned_url,omitempty"`
-	// Object key/path in storage
-	ObjectKey string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
-	// Expiration time in seconds
-	ExpiresIn int32 `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
-	// Optional: Form data fields for POST upload (required for size enforcement)
-	FormData      map[string]string `protobuf:"bytes,4,rep,name=form_data,json=formData,proto3" json:"form_data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// New access key ID
+	AccessKeyId string `protobuf:"bytes,1,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
+	// New secret access key
+	SecretAccessKey string `protobuf:"bytes,2,opt,name=secret_access_key,json=secretAccessKey,proto3" json:"secret_access_key,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *PresignUploadResponse) Reset() {
-	*x = PresignUploadResponse{}
-	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[3]
+func (x *UpdateCredentialsRequest) Reset() {
+	*x = UpdateCredentialsRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PresignUploadResponse) String() string {
+func (x *UpdateCredentialsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PresignUploadResponse) ProtoMessage() {}
+func (*UpdateCredentialsRequest) ProtoMessage() {}
 
-func (x *PresignUploadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[3]
+func (x *UpdateCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -244,65 +5522,102 @@ func (x *PresignUploadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PresignUploadResponse.ProtoReflect.Descriptor instead.
-func (*PresignUploadResponse) Descriptor() ([]byte, []int) {
-	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use UpdateCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *PresignUploadResponse) GetPresignedUrl() string {
+func (x *UpdateCredentialsRequest) GetAccessKeyId() string {
 	if x != nil {
-		return x.PresignedUrl
+		return x.AccessKeyId
 	}
 	return ""
 }
 
-func (x *PresignUploadResponse) GetObjectKey() string {
+func (x *UpdateCredentialsRequest) GetSecretAccessKey() string {
 	if x != nil {
-		return x.ObjectKey
+		return x.SecretAccessKey
 	}
 	return ""
 }
 
-func (x *PresignUploadResponse) GetExpiresIn() int32 {
+// UpdateCredentialsResponse reports a successful credential swap
+type UpdateCredentialsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the new credentials are in use
+	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCredentialsResponse) Reset() {
+	*x = UpdateCredentialsResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCredentialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCredentialsResponse) ProtoMessage() {}
+
+func (x *UpdateCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[77]
 	if x != nil {
-		return x.ExpiresIn
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *PresignUploadResponse) GetFormData() map[string]string {
+// Deprecated: Use UpdateCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *UpdateCredentialsResponse) GetSuccess() bool {
 	if x != nil {
-		return x.FormData
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-// PresignDownloadRequest contains the object key for download
-type PresignDownloadRequest struct {
+// CreateOneTimeDownloadRequest identifies the object a one-time token grants access to
+type CreateOneTimeDownloadRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Bucket name where the file is stored
+	// Bucket name where the file is stored. Defaults to the configured default bucket when empty.
 	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
 	// Object key/path in storage
-	ObjectKey     string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	ObjectKey string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// Optional: Specific version to download. Defaults to the latest version at redemption time.
+	VersionId string `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	// Optional: How long the token can be redeemed, in seconds. Defaults to and may not exceed
+	// the configured token TTL.
+	ExpiresIn     int32 `protobuf:"varint,4,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PresignDownloadRequest) Reset() {
-	*x = PresignDownloadRequest{}
-	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[4]
+func (x *CreateOneTimeDownloadRequest) Reset() {
+	*x = CreateOneTimeDownloadRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PresignDownloadRequest) String() string {
+func (x *CreateOneTimeDownloadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PresignDownloadRequest) ProtoMessage() {}
+func (*CreateOneTimeDownloadRequest) ProtoMessage() {}
 
-func (x *PresignDownloadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[4]
+func (x *CreateOneTimeDownloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -313,51 +5628,65 @@ func (x *PresignDownloadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PresignDownloadRequest.ProtoReflect.Descriptor instead.
-func (*PresignDownloadRequest) Descriptor() ([]byte, []int) {
-	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use CreateOneTimeDownloadRequest.ProtoReflect.Descriptor instead.
+func (*CreateOneTimeDownloadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *PresignDownloadRequest) GetBucketName() string {
+func (x *CreateOneTimeDownloadRequest) GetBucketName() string {
 	if x != nil {
 		return x.BucketName
 	}
 	return ""
 }
 
-func (x *PresignDownloadRequest) GetObjectKey() string {
+func (x *CreateOneTimeDownloadRequest) GetObjectKey() string {
 	if x != nil {
 		return x.ObjectKey
 	}
 	return ""
 }
 
-// PresignDownloadResponse contains the presigned download URL
-type PresignDownloadResponse struct {
+func (x *CreateOneTimeDownloadRequest) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+func (x *CreateOneTimeDownloadRequest) GetExpiresIn() int32 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+// CreateOneTimeDownloadResponse carries the one-time token
+type CreateOneTimeDownloadResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Presigned URL for downloading the file
-	PresignedUrl string `protobuf:"bytes,1,opt,name=presigned_url,json=presignedUrl,proto3" json:"presigned_url,omitempty"`
-	// Expiration time in seconds
+	// Opaque token to pass to RedeemDownload
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// How long the token can be redeemed, in seconds
 	ExpiresIn     int32 `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PresignDownloadResponse) Reset() {
-	*x = PresignDownloadResponse{}
-	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[5]
+func (x *CreateOneTimeDownloadResponse) Reset() {
+	*x = CreateOneTimeDownloadResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PresignDownloadResponse) String() string {
+func (x *CreateOneTimeDownloadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PresignDownloadResponse) ProtoMessage() {}
+func (*CreateOneTimeDownloadResponse) ProtoMessage() {}
 
-func (x *PresignDownloadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[5]
+func (x *CreateOneTimeDownloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -368,51 +5697,49 @@ func (x *PresignDownloadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PresignDownloadResponse.ProtoReflect.Descriptor instead.
-func (*PresignDownloadResponse) Descriptor() ([]byte, []int) {
-	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use CreateOneTimeDownloadResponse.ProtoReflect.Descriptor instead.
+func (*CreateOneTimeDownloadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *PresignDownloadResponse) GetPresignedUrl() string {
+func (x *CreateOneTimeDownloadResponse) GetToken() string {
 	if x != nil {
-		return x.PresignedUrl
+		return x.Token
 	}
 	return ""
 }
 
-func (x *PresignDownloadResponse) GetExpiresIn() int32 {
+func (x *CreateOneTimeDownloadResponse) GetExpiresIn() int32 {
 	if x != nil {
 		return x.ExpiresIn
 	}
 	return 0
 }
 
-// DeleteObjectRequest contains the object key to delete
-type DeleteObjectRequest struct {
+// RedeemDownloadRequest carries the token to redeem
+type RedeemDownloadRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Bucket name where the file is stored
-	BucketName string `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
-	// Object key/path in storage
-	ObjectKey     string `protobuf:"bytes,2,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	// Token issued by CreateOneTimeDownload
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteObjectRequest) Reset() {
-	*x = DeleteObjectRequest{}
-	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[6]
+func (x *RedeemDownloadRequest) Reset() {
+	*x = RedeemDownloadRequest{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteObjectRequest) String() string {
+func (x *RedeemDownloadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteObjectRequest) ProtoMessage() {}
+func (*RedeemDownloadRequest) ProtoMessage() {}
 
-func (x *DeleteObjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[6]
+func (x *RedeemDownloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -423,49 +5750,44 @@ func (x *DeleteObjectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteObjectRequest.ProtoReflect.Descriptor instead.
-func (*DeleteObjectRequest) Descriptor() ([]byte, []int) {
-	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *DeleteObjectRequest) GetBucketName() string {
-	if x != nil {
-		return x.BucketName
-	}
-	return ""
+// Deprecated: Use RedeemDownloadRequest.ProtoReflect.Descriptor instead.
+func (*RedeemDownloadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *DeleteObjectRequest) GetObjectKey() string {
+func (x *RedeemDownloadRequest) GetToken() string {
 	if x != nil {
-		return x.ObjectKey
+		return x.Token
 	}
 	return ""
 }
 
-// DeleteObjectResponse indicates successful deletion
-type DeleteObjectResponse struct {
+// RedeemDownloadResponse carries the presigned download URL of the redeemed token
+type RedeemDownloadResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Whether the deletion was successful
-	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Presigned URL for downloading the file
+	PresignedUrl string `protobuf:"bytes,1,opt,name=presigned_url,json=presignedUrl,proto3" json:"presigned_url,omitempty"`
+	// Expiration time of the URL in seconds
+	ExpiresIn     int32 `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteObjectResponse) Reset() {
-	*x = DeleteObjectResponse{}
-	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[7]
+func (x *RedeemDownloadResponse) Reset() {
+	*x = RedeemDownloadResponse{}
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteObjectResponse) String() string {
+func (x *RedeemDownloadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteObjectResponse) ProtoMessage() {}
+func (*RedeemDownloadResponse) ProtoMessage() {}
 
-func (x *DeleteObjectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[7]
+func (x *RedeemDownloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_mediabase_v1_mediabase_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -476,76 +5798,634 @@ func (x *DeleteObjectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteObjectResponse.ProtoReflect.Descriptor instead.
-func (*DeleteObjectResponse) Descriptor() ([]byte, []int) {
-	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use RedeemDownloadResponse.ProtoReflect.Descriptor instead.
+func (*RedeemDownloadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_mediabase_v1_mediabase_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *DeleteObjectResponse) GetSuccess() bool {
+func (x *RedeemDownloadResponse) GetPresignedUrl() string {
 	if x != nil {
-		return x.Success
+		return x.PresignedUrl
 	}
-	return false
+	return ""
+}
+
+func (x *RedeemDownloadResponse) GetExpiresIn() int32 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
 }
 
 var File_proto_mediabase_v1_mediabase_proto protoreflect.FileDescriptor
 
 const file_proto_mediabase_v1_mediabase_proto_rawDesc = "" +
 	"\n" +
-	"\"proto/mediabase/v1/mediabase.proto\x12\x02v1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1dproto/mediabase/v1/ping.proto\x1a\x17validate/validate.proto\"\\\n" +
+	"\"proto/mediabase/v1/mediabase.proto\x12\x02v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x1dproto/mediabase/v1/ping.proto\x1a\x17validate/validate.proto\"\xb8\x02\n" +
 	"\x13CreateBucketRequest\x12(\n" +
 	"\vbucket_name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
 	"bucketName\x12\x1b\n" +
-	"\tis_public\x18\x02 \x01(\bR\bisPublic\"0\n" +
+	"\tis_public\x18\x02 \x01(\bR\bisPublic\x12+\n" +
+	"\x11enable_versioning\x18\x03 \x01(\bR\x10enableVersioning\x12 \n" +
+	"\x04cors\x18\x04 \x01(\v2\f.v1.CorsRuleR\x04cors\x122\n" +
+	"\x06policy\x18\x05 \x01(\x0e2\x10.v1.BucketPolicyB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06policy\x12#\n" +
+	"\rpolicy_prefix\x18\x06 \x01(\tR\fpolicyPrefix\x122\n" +
+	"\x15enable_object_locking\x18\a \x01(\bR\x13enableObjectLocking\"\x85\x01\n" +
+	"\bCorsRule\x12'\n" +
+	"\x0fallowed_origins\x18\x01 \x03(\tR\x0eallowedOrigins\x12'\n" +
+	"\x0fallowed_methods\x18\x02 \x03(\tR\x0eallowedMethods\x12'\n" +
+	"\x0fallowed_headers\x18\x03 \x03(\tR\x0eallowedHeaders\"0\n" +
 	"\x14CreateBucketResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xca\x01\n" +
-	"\x14PresignUploadRequest\x12(\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"?\n" +
+	"\x13DeleteBucketRequest\x12(\n" +
 	"\vbucket_name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
-	"bucketName\x12*\n" +
-	"\fcontent_type\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vcontentType\x12+\n" +
-	"\rmax_file_size\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\vmaxFileSize\x12\x12\n" +
+	"bucketName\"0\n" +
+	"\x14DeleteBucketResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xd4\t\n" +
+	"\x14PresignUploadRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12+\n" +
+	"\rmax_file_size\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\vmaxFileSize\x12\x12\n" +
 	"\x04path\x18\x04 \x01(\tR\x04path\x12\x1b\n" +
-	"\tfile_name\x18\x05 \x01(\tR\bfileName\"\xfd\x01\n" +
+	"\tfile_name\x18\x05 \x01(\tR\bfileName\x12-\n" +
+	"\rcache_control\x18\x06 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\fcacheControl\x12D\n" +
+	"\x0fchecksum_sha256\x18\a \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{64}$\xd0\x01\x01R\x0echecksumSha256\x12@\n" +
+	"\x04tags\x18\b \x03(\v2\".v1.PresignUploadRequest.TagsEntryB\b\xfaB\x05\x9a\x01\x02\x10\n" +
+	"R\x04tags\x12\x17\n" +
+	"\adry_run\x18\t \x01(\bR\x06dryRun\x12 \n" +
+	"\vdeduplicate\x18\n" +
+	" \x01(\bR\vdeduplicate\x122\n" +
+	"\x06method\x18\v \x01(\x0e2\x10.v1.UploadMethodB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06method\x12B\n" +
+	"\bmetadata\x18\f \x03(\v2&.v1.PresignUploadRequest.MetadataEntryR\bmetadata\x12@\n" +
+	"\x17success_action_redirect\x18\r \x01(\tB\b\xfaB\x05r\x03\x18\x80\x10R\x15successActionRedirect\x122\n" +
+	"\x15success_action_status\x18\x0e \x01(\x05R\x13successActionStatus\x121\n" +
+	"\x0fidempotency_key\x18\x0f \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x0eidempotencyKey\x12&\n" +
+	"\n" +
+	"expires_in\x18\x10 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\texpiresIn\x12b\n" +
+	"\x14metadata_starts_with\x18\x11 \x03(\v20.v1.PresignUploadRequest.MetadataStartsWithEntryR\x12metadataStartsWith\x12\"\n" +
+	"\rif_none_match\x18\x12 \x01(\bR\vifNoneMatch\x12\x1c\n" +
+	"\n" +
+	"kms_key_id\x18\x13 \x01(\tR\bkmsKeyId\x12+\n" +
+	"\rmin_file_size\x18\x14 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\vminFileSize\x12\x1f\n" +
+	"\vprefix_only\x18\x15 \x01(\bR\n" +
+	"prefixOnly\x12.\n" +
+	"\x13content_type_prefix\x18\x16 \x01(\tR\x11contentTypePrefix\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aE\n" +
+	"\x17MetadataStartsWithEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xff\x03\n" +
 	"\x15PresignUploadResponse\x12#\n" +
 	"\rpresigned_url\x18\x01 \x01(\tR\fpresignedUrl\x12\x1d\n" +
 	"\n" +
 	"object_key\x18\x02 \x01(\tR\tobjectKey\x12\x1d\n" +
 	"\n" +
 	"expires_in\x18\x03 \x01(\x05R\texpiresIn\x12D\n" +
-	"\tform_data\x18\x04 \x03(\v2'.v1.PresignUploadResponse.FormDataEntryR\bformData\x1a;\n" +
+	"\tform_data\x18\x04 \x03(\v2'.v1.PresignUploadResponse.FormDataEntryR\bformData\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\x12\"\n" +
+	"\fdeduplicated\x18\x06 \x01(\bR\fdeduplicated\x12@\n" +
+	"\aheaders\x18\a \x03(\v2&.v1.PresignUploadResponse.HeadersEntryR\aheaders\x12\x1b\n" +
+	"\tfile_name\x18\b \x01(\tR\bfileName\x12(\n" +
+	"\x06method\x18\t \x01(\x0e2\x10.v1.UploadMethodR\x06method\x1a;\n" +
 	"\rFormDataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"j\n" +
-	"\x16PresignDownloadRequest\x12(\n" +
-	"\vbucket_name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"z\n" +
+	"\x19PresignUploadBatchRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12<\n" +
+	"\auploads\x18\x02 \x03(\v2\x18.v1.PresignUploadRequestB\b\xfaB\x05\x92\x01\x02\b\x01R\auploads\"O\n" +
+	"\x1aPresignUploadBatchResponse\x121\n" +
+	"\aresults\x18\x01 \x03(\v2\x17.v1.PresignUploadResultR\aresults\"\x8c\x01\n" +
+	"\x13PresignUploadResult\x121\n" +
+	"\x06upload\x18\x01 \x01(\v2\x19.v1.PresignUploadResponseR\x06upload\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x02 \x01(\x05R\terrorCode\x12#\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"l\n" +
+	"\x16PreflightUploadRequest\x12:\n" +
+	"\x06upload\x18\x01 \x01(\v2\x18.v1.PresignUploadRequestB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x06upload\x12\x16\n" +
+	"\x06origin\x18\x02 \x01(\tR\x06origin\"\xb3\x02\n" +
+	"\x17PreflightUploadResponse\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12\x1f\n" +
+	"\vform_fields\x18\x02 \x03(\tR\n" +
+	"formFields\x12\x1d\n" +
+	"\n" +
+	"file_field\x18\x03 \x01(\tR\tfileField\x12\x18\n" +
+	"\aheaders\x18\x04 \x03(\tR\aheaders\x12\"\n" +
+	"\rmax_file_size\x18\x05 \x01(\x03R\vmaxFileSize\x122\n" +
+	"\x15success_action_status\x18\x06 \x01(\x05R\x13successActionStatus\x12%\n" +
+	"\x0eorigin_allowed\x18\a \x01(\bR\roriginAllowed\x12'\n" +
+	"\x0fallowed_methods\x18\b \x03(\tR\x0eallowedMethods\"\x1d\n" +
+	"\x1bGetUploadConstraintsRequest\"\x9f\x04\n" +
+	"\x1cGetUploadConstraintsResponse\x122\n" +
+	"\x15allowed_content_types\x18\x01 \x03(\tR\x13allowedContentTypes\x12\"\n" +
+	"\rmax_file_size\x18\x02 \x01(\x03R\vmaxFileSize\x12\x7f\n" +
+	"\x1dmax_file_size_by_content_type\x18\x03 \x03(\v2>.v1.GetUploadConstraintsResponse.MaxFileSizeByContentTypeEntryR\x18maxFileSizeByContentType\x12*\n" +
+	"\x11upload_expires_in\x18\x04 \x01(\x05R\x0fuploadExpiresIn\x12.\n" +
+	"\x13download_expires_in\x18\x05 \x01(\x05R\x11downloadExpiresIn\x123\n" +
+	"\x16max_presign_batch_size\x18\x06 \x01(\x05R\x13maxPresignBatchSize\x12$\n" +
+	"\x0emax_expires_in\x18\a \x01(\x05R\fmaxExpiresIn\x12\"\n" +
+	"\rmin_file_size\x18\b \x01(\x03R\vminFileSize\x1aK\n" +
+	"\x1dMaxFileSizeByContentTypeEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xcf\x02\n" +
+	"\x16PresignDownloadRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
 	"bucketName\x12&\n" +
 	"\n" +
-	"object_key\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tobjectKey\"]\n" +
+	"object_key\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tobjectKey\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x03 \x01(\tR\tversionId\x12-\n" +
+	"\rcache_control\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\fcacheControl\x12+\n" +
+	"\fcontent_type\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\vcontentType\x12&\n" +
+	"\n" +
+	"expires_in\x18\x06 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\texpiresIn\x123\n" +
+	"\x11allowed_client_ip\x18\a \x01(\tB\a\xfaB\x04r\x02\x18-R\x0fallowedClientIp\x12\x14\n" +
+	"\x05proxy\x18\b \x01(\bR\x05proxy\"]\n" +
 	"\x17PresignDownloadResponse\x12#\n" +
 	"\rpresigned_url\x18\x01 \x01(\tR\fpresignedUrl\x12\x1d\n" +
 	"\n" +
-	"expires_in\x18\x02 \x01(\x05R\texpiresIn\"g\n" +
-	"\x13DeleteObjectRequest\x12(\n" +
-	"\vbucket_name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"expires_in\x18\x02 \x01(\x05R\texpiresIn\"\xa4\x01\n" +
+	"\x12PresignHeadRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12&\n" +
+	"\n" +
+	"object_key\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tobjectKey\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x03 \x01(\tR\tversionId\x12&\n" +
+	"\n" +
+	"expires_in\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\texpiresIn\"Y\n" +
+	"\x13PresignHeadResponse\x12#\n" +
+	"\rpresigned_url\x18\x01 \x01(\tR\fpresignedUrl\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x02 \x01(\x05R\texpiresIn\"\x87\x01\n" +
+	"\x14PresignDeleteRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12&\n" +
+	"\n" +
+	"object_key\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tobjectKey\x12&\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\texpiresIn\"[\n" +
+	"\x15PresignDeleteResponse\x12#\n" +
+	"\rpresigned_url\x18\x01 \x01(\tR\fpresignedUrl\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x02 \x01(\x05R\texpiresIn\"^\n" +
+	"\x13GetPublicURLRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12&\n" +
+	"\n" +
+	"object_key\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tobjectKey\"(\n" +
+	"\x14GetPublicURLResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"\xd8\x01\n" +
+	"\x13DeleteObjectRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
 	"bucketName\x12&\n" +
 	"\n" +
-	"object_key\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tobjectKey\"0\n" +
+	"object_key\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tobjectKey\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x03 \x01(\tR\tversionId\x121\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x0eidempotencyKey\x12&\n" +
+	"\x0ffail_if_missing\x18\x05 \x01(\bR\rfailIfMissing\"0\n" +
 	"\x14DeleteObjectResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xf4\a\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xf2\x03\n" +
+	"\x10PutObjectRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12!\n" +
+	"\acontent\x18\x03 \x01(\fB\a\xfaB\x04z\x02\x10\x01R\acontent\x12\x12\n" +
+	"\x04path\x18\x04 \x01(\tR\x04path\x12\x1b\n" +
+	"\tfile_name\x18\x05 \x01(\tR\bfileName\x12-\n" +
+	"\rcache_control\x18\x06 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\fcacheControl\x12<\n" +
+	"\vcontent_md5\x18\a \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{32}$\xd0\x01\x01R\n" +
+	"contentMd5\x12D\n" +
+	"\x0fchecksum_sha256\x18\b \x01(\tB\x1b\xfaB\x18r\x162\x11^[a-fA-F0-9]{64}$\xd0\x01\x01R\x0echecksumSha256\x12<\n" +
+	"\x04tags\x18\t \x03(\v2\x1e.v1.PutObjectRequest.TagsEntryB\b\xfaB\x05\x9a\x01\x02\x10\n" +
+	"R\x04tags\x12\x1c\n" +
+	"\n" +
+	"kms_key_id\x18\n" +
+	" \x01(\tR\bkmsKeyId\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"Z\n" +
+	"\x11PutObjectResponse\x12\x1d\n" +
+	"\n" +
+	"object_key\x18\x01 \x01(\tR\tobjectKey\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\"m\n" +
+	"\x13UploadObjectRequest\x126\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x18.v1.UploadObjectMetadataH\x00R\bmetadata\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04data\"\xf6\x01\n" +
+	"\x14UploadObjectMetadata\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12\x1b\n" +
+	"\tfile_name\x18\x04 \x01(\tR\bfileName\x12\x1b\n" +
+	"\x04size\x18\x05 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x04size\x12.\n" +
+	"\x13detect_content_type\x18\x06 \x01(\bR\x11detectContentType\x12\x1c\n" +
+	"\n" +
+	"kms_key_id\x18\a \x01(\tR\bkmsKeyId\"]\n" +
+	"\x14UploadObjectResponse\x12\x1d\n" +
+	"\n" +
+	"object_key\x18\x01 \x01(\tR\tobjectKey\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\"\x9d\x01\n" +
+	"\x10GetObjectRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12&\n" +
+	"\n" +
+	"object_key\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tobjectKey\x12\x1f\n" +
+	"\x06offset\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x06offset\x12\x1f\n" +
+	"\x06length\x18\x04 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\x06length\"h\n" +
+	"\x11GetObjectResponse\x123\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x15.v1.GetObjectMetadataH\x00R\bmetadata\x12\x16\n" +
+	"\x05chunk\x18\x02 \x01(\fH\x00R\x05chunkB\x06\n" +
+	"\x04data\"\xb7\x01\n" +
+	"\x11GetObjectMetadata\x12!\n" +
+	"\fcontent_type\x18\x01 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x16\n" +
+	"\x06length\x18\x03 \x01(\x03R\x06length\x12\x12\n" +
+	"\x04etag\x18\x04 \x01(\tR\x04etag\x12?\n" +
+	"\rlast_modified\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\flastModified\"_\n" +
+	"\x14ConfirmUploadRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12&\n" +
+	"\n" +
+	"object_key\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tobjectKey\"\x84\x03\n" +
+	"\x15ConfirmUploadResponse\x12\x1d\n" +
+	"\n" +
+	"object_key\x18\x01 \x01(\tR\tobjectKey\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12+\n" +
+	"\x11checksum_verified\x18\x04 \x01(\bR\x10checksumVerified\x127\n" +
+	"\x04tags\x18\x05 \x03(\v2#.v1.ConfirmUploadResponse.TagsEntryR\x04tags\x12'\n" +
+	"\x0fmalware_scanned\x18\x06 \x01(\bR\x0emalwareScanned\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x12\n" +
+	"\x04etag\x18\b \x01(\tR\x04etag\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x91\x03\n" +
+	"\x11CopyObjectRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12&\n" +
+	"\n" +
+	"source_key\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tsourceKey\x120\n" +
+	"\x0fdestination_key\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x0edestinationKey\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12-\n" +
+	"\rcache_control\x18\x05 \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\fcacheControl\x12?\n" +
+	"\bmetadata\x18\x06 \x03(\v2#.v1.CopyObjectRequest.MetadataEntryR\bmetadata\x121\n" +
+	"\x0fidempotency_key\x18\a \x01(\tB\b\xfaB\x05r\x03\x18\x80\x02R\x0eidempotencyKey\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"j\n" +
+	"\x12CopyObjectResponse\x12\x1d\n" +
+	"\n" +
+	"object_key\x18\x01 \x01(\tR\tobjectKey\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\"\xb4\x01\n" +
+	"\x11MoveObjectRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12&\n" +
+	"\n" +
+	"source_key\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tsourceKey\x12-\n" +
+	"\x12destination_bucket\x18\x03 \x01(\tR\x11destinationBucket\x12'\n" +
+	"\x0fdestination_key\x18\x04 \x01(\tR\x0edestinationKey\"h\n" +
+	"\x12MoveObjectResponse\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12\x1d\n" +
+	"\n" +
+	"object_key\x18\x02 \x01(\tR\tobjectKey\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\"_\n" +
+	"\x14RestoreObjectRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12&\n" +
+	"\n" +
+	"object_key\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tobjectKey\"J\n" +
+	"\x15RestoreObjectResponse\x12\x1d\n" +
+	"\n" +
+	"object_key\x18\x01 \x01(\tR\tobjectKey\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\"\xda\x01\n" +
+	"\x14SetObjectTagsRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12&\n" +
+	"\n" +
+	"object_key\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tobjectKey\x12@\n" +
+	"\x04tags\x18\x03 \x03(\v2\".v1.SetObjectTagsRequest.TagsEntryB\b\xfaB\x05\x9a\x01\x02\x10\n" +
+	"R\x04tags\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"1\n" +
+	"\x15SetObjectTagsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"_\n" +
+	"\x14GetObjectTagsRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12&\n" +
+	"\n" +
+	"object_key\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tobjectKey\"\x89\x01\n" +
+	"\x15GetObjectTagsResponse\x127\n" +
+	"\x04tags\x18\x01 \x03(\v2#.v1.GetObjectTagsResponse.TagsEntryR\x04tags\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8b\x01\n" +
+	"\x13SetObjectACLRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12&\n" +
+	"\n" +
+	"object_key\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tobjectKey\x12+\n" +
+	"\x03acl\x18\x03 \x01(\x0e2\r.v1.ObjectACLB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x03acl\"0\n" +
+	"\x14SetObjectACLResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"^\n" +
+	"\x13GetObjectACLRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12&\n" +
+	"\n" +
+	"object_key\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tobjectKey\"7\n" +
+	"\x14GetObjectACLResponse\x12\x1f\n" +
+	"\x03acl\x18\x01 \x01(\x0e2\r.v1.ObjectACLR\x03acl\"\xac\x02\n" +
+	"\x19SetObjectRetentionRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12&\n" +
+	"\n" +
+	"object_key\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tobjectKey\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x03 \x01(\tR\tversionId\x121\n" +
+	"\x04mode\x18\x04 \x01(\x0e2\x11.v1.RetentionModeB\n" +
+	"\xfaB\a\x82\x01\x04\x10\x01 \x00R\x04mode\x12G\n" +
+	"\fretain_until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\b\xfaB\x05\xb2\x01\x02\b\x01R\vretainUntil\x12+\n" +
+	"\x11bypass_governance\x18\x06 \x01(\bR\x10bypassGovernance\"6\n" +
+	"\x1aSetObjectRetentionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x9d\x01\n" +
+	"\x19SetObjectLegalHoldRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12&\n" +
+	"\n" +
+	"object_key\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tobjectKey\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x03 \x01(\tR\tversionId\x12\x18\n" +
+	"\aenabled\x18\x04 \x01(\bR\aenabled\"6\n" +
+	"\x1aSetObjectLegalHoldResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"c\n" +
+	"\x18GetObjectMetadataRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12&\n" +
+	"\n" +
+	"object_key\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tobjectKey\"\xac\x03\n" +
+	"\x19GetObjectMetadataResponse\x12\x1d\n" +
+	"\n" +
+	"object_key\x18\x01 \x01(\tR\tobjectKey\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04etag\x18\x04 \x01(\tR\x04etag\x12?\n" +
+	"\rlast_modified\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\flastModified\x12#\n" +
+	"\rcache_control\x18\x06 \x01(\tR\fcacheControl\x12G\n" +
+	"\bmetadata\x18\a \x03(\v2+.v1.GetObjectMetadataResponse.MetadataEntryR\bmetadata\x129\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"f\n" +
+	"\x18BatchObjectExistsRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12)\n" +
+	"\vobject_keys\x18\x02 \x03(\tB\b\xfaB\x05\x92\x01\x02\b\x01R\n" +
+	"objectKeys\"M\n" +
+	"\x19BatchObjectExistsResponse\x120\n" +
+	"\aresults\x18\x01 \x03(\v2\x16.v1.ObjectExistsResultR\aresults\"\x8f\x01\n" +
+	"\x12ObjectExistsResult\x12\x1d\n" +
+	"\n" +
+	"object_key\x18\x01 \x01(\tR\tobjectKey\x12\x16\n" +
+	"\x06exists\x18\x02 \x01(\bR\x06exists\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x03 \x01(\x05R\terrorCode\x12#\n" +
+	"\rerror_message\x18\x04 \x01(\tR\ferrorMessage\"`\n" +
+	"\x1aSetBucketVersioningRequest\x12(\n" +
+	"\vbucket_name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"bucketName\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\"7\n" +
+	"\x1bSetBucketVersioningResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x9b\x01\n" +
+	"\x19SetBucketLifecycleRequest\x12(\n" +
+	"\vbucket_name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"bucketName\x12\"\n" +
+	"\x06prefix\x18\x02 \x01(\tB\n" +
+	"\xfaB\ar\x05\x10\x01\x18\xc8\x01R\x06prefix\x120\n" +
+	"\x0fexpiration_days\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\x0eexpirationDays\"6\n" +
+	"\x1aSetBucketLifecycleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x83\x01\n" +
+	"\x15GetBucketStatsRequest\x12(\n" +
+	"\vbucket_name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"bucketName\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\tR\x06prefix\x12(\n" +
+	"\vmax_objects\x18\x03 \x01(\x03B\a\xfaB\x04\"\x02(\x00R\n" +
+	"maxObjects\"z\n" +
+	"\x16GetBucketStatsResponse\x12!\n" +
+	"\fobject_count\x18\x01 \x01(\x03R\vobjectCount\x12\x1f\n" +
+	"\vtotal_bytes\x18\x02 \x01(\x03R\n" +
+	"totalBytes\x12\x1c\n" +
+	"\ttruncated\x18\x03 \x01(\bR\ttruncated\"\xfa\x01\n" +
+	"\x17FindObjectsByTagRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\tR\x06prefix\x12C\n" +
+	"\x04tags\x18\x03 \x03(\v2%.v1.FindObjectsByTagRequest.TagsEntryB\b\xfaB\x05\x9a\x01\x02\b\x01R\x04tags\x12(\n" +
+	"\vmax_results\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\n" +
+	"maxResults\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8d\x01\n" +
+	"\x18FindObjectsByTagResponse\x12*\n" +
+	"\aobjects\x18\x01 \x03(\v2\x10.v1.TaggedObjectR\aobjects\x12\x1c\n" +
+	"\ttruncated\x18\x02 \x01(\bR\ttruncated\x12'\n" +
+	"\x0fscanned_objects\x18\x03 \x01(\x03R\x0escannedObjects\"\x96\x01\n" +
+	"\fTaggedObject\x12\x1d\n" +
+	"\n" +
+	"object_key\x18\x01 \x01(\tR\tobjectKey\x12.\n" +
+	"\x04tags\x18\x02 \x03(\v2\x1a.v1.TaggedObject.TagsEntryR\x04tags\x1a7\n" +
+	"\tTagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"d\n" +
+	"\x19ListObjectVersionsRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12&\n" +
+	"\n" +
+	"object_key\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tobjectKey\"\xde\x01\n" +
+	"\rObjectVersion\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x01 \x01(\tR\tversionId\x12\x1b\n" +
+	"\tis_latest\x18\x02 \x01(\bR\bisLatest\x12(\n" +
+	"\x10is_delete_marker\x18\x03 \x01(\bR\x0eisDeleteMarker\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\x12\x12\n" +
+	"\x04etag\x18\x05 \x01(\tR\x04etag\x12?\n" +
+	"\rlast_modified\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\flastModified\"K\n" +
+	"\x1aListObjectVersionsResponse\x12-\n" +
+	"\bversions\x18\x01 \x03(\v2\x11.v1.ObjectVersionR\bversions\"\x89\x01\n" +
+	"\x18ListUploadedPartsRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12&\n" +
+	"\n" +
+	"object_key\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tobjectKey\x12$\n" +
+	"\tupload_id\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\buploadId\"\x98\x01\n" +
+	"\fUploadedPart\x12\x1f\n" +
+	"\vpart_number\x18\x01 \x01(\x05R\n" +
+	"partNumber\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\x12?\n" +
+	"\rlast_modified\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\flastModified\"C\n" +
+	"\x19ListUploadedPartsResponse\x12&\n" +
+	"\x05parts\x18\x01 \x03(\v2\x10.v1.UploadedPartR\x05parts\"\xee\x01\n" +
+	"\x13ConvertImageRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12&\n" +
+	"\n" +
+	"object_key\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tobjectKey\x12@\n" +
+	"\rtarget_format\x18\x03 \x01(\tB\x1b\xfaB\x18r\x16R\x04jpegR\x03jpgR\x03pngR\x04webpR\ftargetFormat\x12#\n" +
+	"\aquality\x18\x04 \x01(\x05B\t\xfaB\x06\x1a\x04\x18d(\x00R\aquality\x12'\n" +
+	"\x0fdestination_key\x18\x05 \x01(\tR\x0edestinationKey\"l\n" +
+	"\x14ConvertImageResponse\x12\x1d\n" +
+	"\n" +
+	"object_key\x18\x01 \x01(\tR\tobjectKey\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\"_\n" +
+	"\x14SanitizeImageRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12&\n" +
+	"\n" +
+	"object_key\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tobjectKey\"s\n" +
+	"\x15SanitizeImageResponse\x12!\n" +
+	"\fcontent_type\x18\x01 \x01(\tR\vcontentType\x12#\n" +
+	"\roriginal_size\x18\x02 \x01(\x03R\foriginalSize\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\"|\n" +
+	"\x18UpdateCredentialsRequest\x12+\n" +
+	"\raccess_key_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\vaccessKeyId\x123\n" +
+	"\x11secret_access_key\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x0fsecretAccessKey\"5\n" +
+	"\x19UpdateCredentialsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xae\x01\n" +
+	"\x1cCreateOneTimeDownloadRequest\x12\x1f\n" +
+	"\vbucket_name\x18\x01 \x01(\tR\n" +
+	"bucketName\x12&\n" +
+	"\n" +
+	"object_key\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tobjectKey\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x03 \x01(\tR\tversionId\x12&\n" +
+	"\n" +
+	"expires_in\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\texpiresIn\"T\n" +
+	"\x1dCreateOneTimeDownloadResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x02 \x01(\x05R\texpiresIn\"6\n" +
+	"\x15RedeemDownloadRequest\x12\x1d\n" +
+	"\x05token\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05token\"\\\n" +
+	"\x16RedeemDownloadResponse\x12#\n" +
+	"\rpresigned_url\x18\x01 \x01(\tR\fpresignedUrl\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x02 \x01(\x05R\texpiresIn*\x8e\x01\n" +
+	"\fBucketPolicy\x12\x1d\n" +
+	"\x19BUCKET_POLICY_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19BUCKET_POLICY_PUBLIC_READ\x10\x01\x12\x1c\n" +
+	"\x18BUCKET_POLICY_READ_WRITE\x10\x02\x12\"\n" +
+	"\x1eBUCKET_POLICY_READ_ONLY_PREFIX\x10\x03*\\\n" +
+	"\fUploadMethod\x12\x1d\n" +
+	"\x19UPLOAD_METHOD_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12UPLOAD_METHOD_POST\x10\x01\x12\x15\n" +
+	"\x11UPLOAD_METHOD_PUT\x10\x02*[\n" +
+	"\tObjectACL\x12\x1a\n" +
+	"\x16OBJECT_ACL_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12OBJECT_ACL_PRIVATE\x10\x01\x12\x1a\n" +
+	"\x16OBJECT_ACL_PUBLIC_READ\x10\x02*m\n" +
+	"\rRetentionMode\x12\x1e\n" +
+	"\x1aRETENTION_MODE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19RETENTION_MODE_GOVERNANCE\x10\x01\x12\x1d\n" +
+	"\x19RETENTION_MODE_COMPLIANCE\x10\x022\x8dU\n" +
 	"\x10MediabaseService\x12~\n" +
 	"\x04Ping\x12\x0f.v1.PingRequest\x1a\x10.v1.PingResponse\"S\x92A6\n" +
 	"\x04Ping\x12\x0fPing the server\x1a\x1dCheck if the server is alive.\x82\xd3\xe4\x93\x02\x14\x12\x12/mediabase/v1/ping\x12\xd9\x01\n" +
 	"\rPresignUpload\x12\x18.v1.PresignUploadRequest\x1a\x19.v1.PresignUploadResponse\"\x92\x01\x92Aj\n" +
-	"\x06Upload\x12\x1dGenerate presigned upload URL\x1aAReturns a presigned URL for uploading a file directly to storage.\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/upload/presign/upload\x12\xde\x01\n" +
+	"\x06Upload\x12\x1dGenerate presigned upload URL\x1aAReturns a presigned URL for uploading a file directly to storage.\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/upload/presign/upload\x12\xcd\x02\n" +
+	"\x12PresignUploadBatch\x12\x1d.v1.PresignUploadBatchRequest\x1a\x1e.v1.PresignUploadBatchResponse\"\xf7\x01\x92A\xc8\x01\n" +
+	"\x06Upload\x12&Generate presigned upload URLs in bulk\x1a\x95\x01Presigns every upload of the batch independently. An upload that fails validation or presigning is reported in its result without failing the others.\x82\xd3\xe4\x93\x02%:\x01*\" /api/upload/presign/upload/batch\x12\xc1\x03\n" +
+	"\x0fPreflightUpload\x12\x1a.v1.PreflightUploadRequest\x1a\x1b.v1.PreflightUploadResponse\"\xf4\x02\x92A\xc1\x02\n" +
+	"\x06Upload\x12\x1aPreflight presigned upload\x1a\x9a\x02Validates an upload like PresignUpload and returns the form fields, headers, size limit and success status of the resulting presigned request, and whether the given browser origin is allowed by the configured CORS rule. Nothing is uploaded and the returned names carry no signature.\x82\xd3\xe4\x93\x02):\x01*\"$/api/upload/presign/upload/preflight\x12\xde\x01\n" +
 	"\x0fPresignDownload\x12\x1a.v1.PresignDownloadRequest\x1a\x1b.v1.PresignDownloadResponse\"\x91\x01\x92Ag\n" +
-	"\x06Upload\x12\x1fGenerate presigned download URL\x1a<Returns a presigned URL for downloading a file from storage.\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/upload/presign/download\x12\xa2\x01\n" +
+	"\x06Upload\x12\x1fGenerate presigned download URL\x1a<Returns a presigned URL for downloading a file from storage.\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/upload/presign/download\x12\xeb\x02\n" +
+	"\x15CreateOneTimeDownload\x12 .v1.CreateOneTimeDownloadRequest\x1a!.v1.CreateOneTimeDownloadResponse\"\x8c\x02\x92A\xe7\x01\n" +
+	"\bDownload\x12\x1eCreate one-time download token\x1a\xba\x01Returns a token that RedeemDownload exchanges for a short-lived presigned download URL exactly once. Storage cannot limit how often a URL is used, so the server tracks the token instead.\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/download/one-time\x12\xbd\x02\n" +
+	"\x0eRedeemDownload\x12\x19.v1.RedeemDownloadRequest\x1a\x1a.v1.RedeemDownloadResponse\"\xf3\x01\x92A\xd0\x01\n" +
+	"\bDownload\x12\x1eRedeem one-time download token\x1a\xa3\x01Invalidates the token and returns a presigned download URL for its object. A token that was already redeemed, has expired or is unknown is rejected with NOT_FOUND.\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/download/redeem\x12\xce\x02\n" +
+	"\vPresignHead\x12\x16.v1.PresignHeadRequest\x1a\x17.v1.PresignHeadResponse\"\x8d\x02\x92A\xe6\x01\n" +
+	"\x06Upload\x12\x1bGenerate presigned HEAD URL\x1a\xbe\x01Returns a presigned URL the client can send a HEAD request to, reading the object's Content-Length, Content-Type, ETag and Last-Modified headers straight from storage without downloading it.\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/upload/presign/head\x12\x86\x03\n" +
+	"\rPresignDelete\x12\x18.v1.PresignDeleteRequest\x1a\x19.v1.PresignDeleteResponse\"\xbf\x02\x92A\x96\x02\n" +
+	"\x06Upload\x12\x1dGenerate presigned DELETE URL\x1a\xec\x01Returns a presigned URL the client can send a DELETE request to, removing the object straight from storage. Disabled unless AllowPresignedDelete is set, since the delete bypasses the server's soft delete, idempotency and access logging.\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/upload/presign/delete\x12\xfa\x02\n" +
+	"\fGetPublicURL\x12\x17.v1.GetPublicURLRequest\x1a\x18.v1.GetPublicURLResponse\"\xb6\x02\x92A\x80\x02\n" +
+	"\x06Upload\x12\x15Get public object URL\x1a\xde\x01Returns a direct, unsigned URL for an object whose bucket policy allows anonymous reads. Unlike presigned URLs it does not expire, so it can be cached and shared. Fails if the bucket policy does not make the object public.\x82\xd3\xe4\x93\x02,\x12*/api/upload/object/{object_key}/public-url\x12\xa0\x02\n" +
+	"\x14GetUploadConstraints\x12\x1f.v1.GetUploadConstraintsRequest\x1a .v1.GetUploadConstraintsResponse\"\xc4\x01\x92A\xa1\x01\n" +
+	"\x06Upload\x12\x16Get upload constraints\x1a\x7fReturns the allowed content types, size limits and URL expiries so clients can configure upload widgets from the server config.\x82\xd3\xe4\x93\x02\x19\x12\x17/api/upload/constraints\x12\xa2\x01\n" +
 	"\fDeleteObject\x12\x17.v1.DeleteObjectRequest\x1a\x18.v1.DeleteObjectResponse\"_\x92A5\n" +
 	"\x06Upload\x12\rDelete object\x1a\x1cDeletes a file from storage.\x82\xd3\xe4\x93\x02!*\x1f/api/upload/object/{object_key}\x12\xfd\x01\n" +
 	"\fCreateBucket\x12\x17.v1.CreateBucketRequest\x1a\x18.v1.CreateBucketResponse\"\xb9\x01\x92A\x98\x01\n" +
-	"\x06Upload\x12\rCreate bucket\x1a\x7fCreates a bucket and optionally sets its policy to allow public read access while keeping uploads private (via presigned URLs).\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/upload/bucketB\xd8\x01\x92A\xc4\x01\x12q\n" +
+	"\x06Upload\x12\rCreate bucket\x1a\x7fCreates a bucket and optionally sets its policy to allow public read access while keeping uploads private (via presigned URLs).\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/upload/bucket\x12\xd6\x01\n" +
+	"\fDeleteBucket\x12\x17.v1.DeleteBucketRequest\x1a\x18.v1.DeleteBucketResponse\"\x92\x01\x92Ag\n" +
+	"\x06Upload\x12\rDelete bucket\x1aNRemoves a bucket. Fails with FAILED_PRECONDITION while it still holds objects.\x82\xd3\xe4\x93\x02\"* /api/upload/bucket/{bucket_name}\x12\xfb\x01\n" +
+	"\tPutObject\x12\x14.v1.PutObjectRequest\x1a\x15.v1.PutObjectResponse\"\xc0\x01\x92A\x9f\x01\n" +
+	"\x06Upload\x12\x16Upload object directly\x1a}Uploads file content through the server. Optional MD5 and SHA-256 checksums are verified and mismatching content is rejected.\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/upload/object\x12C\n" +
+	"\fUploadObject\x12\x17.v1.UploadObjectRequest\x1a\x18.v1.UploadObjectResponse(\x01\x12:\n" +
+	"\tGetObject\x12\x14.v1.GetObjectRequest\x1a\x15.v1.GetObjectResponse0\x01\x12\xc2\x02\n" +
+	"\rConfirmUpload\x12\x18.v1.ConfirmUploadRequest\x1a\x19.v1.ConfirmUploadResponse\"\xfb\x01\x92A\xd9\x01\n" +
+	"\x06Upload\x12\x18Confirm presigned upload\x1a\xb4\x01Checks that an object uploaded via a presigned policy exists and, if a checksum was supplied at presign time, verifies the stored content against it. Corrupted objects are deleted.\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/upload/confirm\x12\xdb\x02\n" +
+	"\n" +
+	"CopyObject\x12\x15.v1.CopyObjectRequest\x1a\x16.v1.CopyObjectResponse\"\x9d\x02\x92A\xf7\x01\n" +
+	"\x06Upload\x12\vCopy object\x1a\xdf\x01Copies an object to another key in the same bucket without re-uploading it. The content type, cache control and application metadata of the copy can be overridden, which also allows fixing the headers of an object in place.\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/upload/object/copy\x12\xa8\x03\n" +
+	"\n" +
+	"MoveObject\x12\x15.v1.MoveObjectRequest\x1a\x16.v1.MoveObjectResponse\"\xea\x02\x92A\xc4\x02\n" +
+	"\x06Upload\x12\vMove object\x1a\xac\x02Moves an object to another key, in the same bucket or another one, e.g. from a hot bucket to a cold one when archiving. The object is copied on the storage side with its attributes and metadata and the source is deleted. Fails if the destination bucket does not exist or the destination key is taken.\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/upload/object/move\x12\x9f\x02\n" +
+	"\rRestoreObject\x12\x18.v1.RestoreObjectRequest\x1a\x19.v1.RestoreObjectResponse\"\xd8\x01\x92A\xaf\x01\n" +
+	"\x06Upload\x12\x16Restore deleted object\x1a\x8c\x01Moves an object deleted while soft delete is enabled back to its original key. Fails if another object has been stored under that key since.\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/upload/object/restore\x12\xcc\x02\n" +
+	"\fSetObjectACL\x12\x17.v1.SetObjectACLRequest\x1a\x18.v1.SetObjectACLResponse\"\x88\x02\x92A\xd6\x01\n" +
+	"\x06Upload\x12\x0eSet object ACL\x1a\xbb\x01Makes one object readable by anyone, or only through presigned URLs, independently of the rest of the bucket. Fails with UNIMPLEMENTED on backends that only support bucket-level policies.\x82\xd3\xe4\x93\x02(:\x01*\x1a#/api/upload/object/{object_key}/acl\x12\xe9\x01\n" +
+	"\fGetObjectACL\x12\x17.v1.GetObjectACLRequest\x1a\x18.v1.GetObjectACLResponse\"\xa5\x01\x92Aw\n" +
+	"\x06Upload\x12\x0eGet object ACL\x1a]Returns whether anyone can read the object, whether through its own ACL or the bucket policy.\x82\xd3\xe4\x93\x02%\x12#/api/upload/object/{object_key}/acl\x12\xfe\x02\n" +
+	"\x12SetObjectRetention\x12\x1d.v1.SetObjectRetentionRequest\x1a\x1e.v1.SetObjectRetentionResponse\"\xa8\x02\x92A\xf0\x01\n" +
+	"\x06Upload\x12\x14Set object retention\x1a\xcf\x01Protects an object version until retain_until in a bucket created with object locking. In compliance mode no one can shorten or remove the retention. Fails with UNIMPLEMENTED on backends without object lock.\x82\xd3\xe4\x93\x02.:\x01*\x1a)/api/upload/object/{object_key}/retention\x12\xd5\x02\n" +
+	"\x12SetObjectLegalHold\x12\x1d.v1.SetObjectLegalHoldRequest\x1a\x1e.v1.SetObjectLegalHoldResponse\"\xff\x01\x92A\xc6\x01\n" +
+	"\x06Upload\x12\x15Set object legal hold\x1a\xa4\x01Protects an object version from deletion and overwrites until the hold is removed, whatever its retention. Fails with UNIMPLEMENTED on backends without object lock.\x82\xd3\xe4\x93\x02/:\x01*\x1a*/api/upload/object/{object_key}/legal-hold\x12\x90\x02\n" +
+	"\rSetObjectTags\x12\x18.v1.SetObjectTagsRequest\x1a\x19.v1.SetObjectTagsResponse\"\xc9\x01\x92A\x96\x01\n" +
+	"\x06Upload\x12\x0fSet object tags\x1a{Replaces the key/value tags of an object. At most 10 tags are allowed, with keys up to 128 and values up to 256 characters.\x82\xd3\xe4\x93\x02):\x01*\x1a$/api/upload/object/{object_key}/tags\x12\xb8\x01\n" +
+	"\rGetObjectTags\x12\x18.v1.GetObjectTagsRequest\x1a\x19.v1.GetObjectTagsResponse\"r\x92AC\n" +
+	"\x06Upload\x12\x0fGet object tags\x1a(Returns the key/value tags of an object.\x82\xd3\xe4\x93\x02&\x12$/api/upload/object/{object_key}/tags\x12\x82\x02\n" +
+	"\x13SetBucketVersioning\x12\x1e.v1.SetBucketVersioningRequest\x1a\x1f.v1.SetBucketVersioningResponse\"\xa9\x01\x92Ap\n" +
+	"\x06Upload\x12\x15Set bucket versioning\x1aOEnables or suspends versioning on a bucket. Suspending keeps existing versions.\x82\xd3\xe4\x93\x020:\x01*\x1a+/api/upload/bucket/{bucket_name}/versioning\x12\xa5\x02\n" +
+	"\x12SetBucketLifecycle\x12\x1d.v1.SetBucketLifecycleRequest\x1a\x1e.v1.SetBucketLifecycleResponse\"\xcf\x01\x92A\x96\x01\n" +
+	"\x06Upload\x12\x14Set bucket lifecycle\x1avExpires objects under a prefix after the given number of days. Calling it again for the same prefix replaces the rule.\x82\xd3\xe4\x93\x02/:\x01*\x1a*/api/upload/bucket/{bucket_name}/lifecycle\x12\xa9\x03\n" +
+	"\x0eGetBucketStats\x12\x19.v1.GetBucketStatsRequest\x1a\x1a.v1.GetBucketStatsResponse\"\xdf\x02\x92A\xad\x02\n" +
+	"\x06Upload\x12\x10Get bucket stats\x1a\x90\x02Returns the number of objects and their total size in a bucket, optionally under a prefix. Storage has no counters, so every call lists the objects; the scan stops at max_objects or the server's scan timeout and reports a truncated result. Cache the result for dashboards.\x82\xd3\xe4\x93\x02(\x12&/api/upload/bucket/{bucket_name}/stats\x12\x98\x02\n" +
+	"\x11GetObjectMetadata\x12\x1c.v1.GetObjectMetadataRequest\x1a\x1d.v1.GetObjectMetadataResponse\"\xc5\x01\x92A\x91\x01\n" +
+	"\x06Upload\x12\x13Get object metadata\x1arReturns the size, content type, ETag, last modification time, cache control and application metadata of an object.\x82\xd3\xe4\x93\x02*\x12(/api/upload/object/{object_key}/metadata\x12\xb7\x02\n" +
+	"\x11BatchObjectExists\x12\x1c.v1.BatchObjectExistsRequest\x1a\x1d.v1.BatchObjectExistsResponse\"\xe4\x01\x92A\xbc\x01\n" +
+	"\x06Upload\x12\x1eCheck object existence in bulk\x1a\x91\x01Checks every key of the batch concurrently. A key whose check fails is reported with its error instead of as missing, without failing the others.\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/upload/object/exists\x12\xb6\x03\n" +
+	"\x10FindObjectsByTag\x12\x1b.v1.FindObjectsByTagRequest\x1a\x1c.v1.FindObjectsByTagResponse\"\xe6\x02\x92A\xb9\x02\n" +
+	"\x06Upload\x12\x13Find objects by tag\x1a\x99\x02Returns the objects under a prefix whose tags include all requested tags. Storage cannot search tags, so every call lists the objects and reads their tags; the scan stops at max_results, the server's scan cap or its scan timeout and reports a truncated result. Use a narrow prefix.\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/upload/object/find-by-tag\x12\x80\x02\n" +
+	"\x12ListObjectVersions\x12\x1d.v1.ListObjectVersionsRequest\x1a\x1e.v1.ListObjectVersionsResponse\"\xaa\x01\x92Aw\n" +
+	"\x06Upload\x12\x14List object versions\x1aWLists all versions and delete markers of an object in a versioned bucket, newest first.\x82\xd3\xe4\x93\x02*\x12(/api/upload/object/{object_key}/versions\x12\xae\x02\n" +
+	"\x11ListUploadedParts\x12\x1c.v1.ListUploadedPartsRequest\x1a\x1d.v1.ListUploadedPartsResponse\"\xdb\x01\x92A\xaa\x01\n" +
+	"\x06Upload\x12\x13List uploaded parts\x1a\x8a\x01Lists the parts of an in-progress multipart upload that have already landed, so a client can resume the upload without sending them again.\x82\xd3\xe4\x93\x02'\x12%/api/upload/object/{object_key}/parts\x12\x86\x02\n" +
+	"\fConvertImage\x12\x17.v1.ConvertImageRequest\x1a\x18.v1.ConvertImageResponse\"\xc2\x01\x92A\xa1\x01\n" +
+	"\x05Image\x12\x14Convert image format\x1a\x81\x01Downloads a source image, transcodes it to the requested format (jpeg, png or webp) and stores the result under a new object key.\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/image/convert\x12\xba\x02\n" +
+	"\rSanitizeImage\x12\x18.v1.SanitizeImageRequest\x1a\x19.v1.SanitizeImageResponse\"\xf3\x01\x92A\xd1\x01\n" +
+	"\x05Image\x12\x14Strip image metadata\x1a\xb1\x01Removes EXIF, GPS, XMP and IPTC metadata from a stored JPEG or TIFF image while preserving pixel data and orientation, and overwrites the object. Other image types are rejected.\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/api/image/sanitize\x12P\n" +
+	"\x11UpdateCredentials\x12\x1c.v1.UpdateCredentialsRequest\x1a\x1d.v1.UpdateCredentialsResponseB\xfd\x01\x92A\xe9\x01\x12q\n" +
 	"\rmediabase API\x12Xmediabase is a generic media storage service supporting presigned uploads and downloads.2\x06v1.0.0j\x1e\n" +
 	"\x04Ping\x12\x16Health check endpointsj/\n" +
-	"\x06Upload\x12%Media upload and management endpointsZ\x0e./mediabase_v1b\x06proto3"
+	"\x06Upload\x12%Media upload and management endpointsj#\n" +
+	"\x05Image\x12\x1aImage processing endpointsZ\x0e./mediabase_v1b\x06proto3"
 
 var (
 	file_proto_mediabase_v1_mediabase_proto_rawDescOnce sync.Once
@@ -559,37 +6439,233 @@ func file_proto_mediabase_v1_mediabase_proto_rawDescGZIP() []byte {
 	return file_proto_mediabase_v1_mediabase_proto_rawDescData
 }
 
-var file_proto_mediabase_v1_mediabase_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_proto_mediabase_v1_mediabase_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_proto_mediabase_v1_mediabase_proto_msgTypes = make([]protoimpl.MessageInfo, 96)
 var file_proto_mediabase_v1_mediabase_proto_goTypes = []any{
-	(*CreateBucketRequest)(nil),     // 0: v1.CreateBucketRequest
-	(*CreateBucketResponse)(nil),    // 1: v1.CreateBucketResponse
-	(*PresignUploadRequest)(nil),    // 2: v1.PresignUploadRequest
-	(*PresignUploadResponse)(nil),   // 3: v1.PresignUploadResponse
-	(*PresignDownloadRequest)(nil),  // 4: v1.PresignDownloadRequest
-	(*PresignDownloadResponse)(nil), // 5: v1.PresignDownloadResponse
-	(*DeleteObjectRequest)(nil),     // 6: v1.DeleteObjectRequest
-	(*DeleteObjectResponse)(nil),    // 7: v1.DeleteObjectResponse
-	nil,                             // 8: v1.PresignUploadResponse.FormDataEntry
-	(*PingRequest)(nil),             // 9: v1.PingRequest
-	(*PingResponse)(nil),            // 10: v1.PingResponse
+	(BucketPolicy)(0),                     // 0: v1.BucketPolicy
+	(UploadMethod)(0),                     // 1: v1.UploadMethod
+	(ObjectACL)(0),                        // 2: v1.ObjectACL
+	(RetentionMode)(0),                    // 3: v1.RetentionMode
+	(*CreateBucketRequest)(nil),           // 4: v1.CreateBucketRequest
+	(*CorsRule)(nil),                      // 5: v1.CorsRule
+	(*CreateBucketResponse)(nil),          // 6: v1.CreateBucketResponse
+	(*DeleteBucketRequest)(nil),           // 7: v1.DeleteBucketRequest
+	(*DeleteBucketResponse)(nil),          // 8: v1.DeleteBucketResponse
+	(*PresignUploadRequest)(nil),          // 9: v1.PresignUploadRequest
+	(*PresignUploadResponse)(nil),         // 10: v1.PresignUploadResponse
+	(*PresignUploadBatchRequest)(nil),     // 11: v1.PresignUploadBatchRequest
+	(*PresignUploadBatchResponse)(nil),    // 12: v1.PresignUploadBatchResponse
+	(*PresignUploadResult)(nil),           // 13: v1.PresignUploadResult
+	(*PreflightUploadRequest)(nil),        // 14: v1.PreflightUploadRequest
+	(*PreflightUploadResponse)(nil),       // 15: v1.PreflightUploadResponse
+	(*GetUploadConstraintsRequest)(nil),   // 16: v1.GetUploadConstraintsRequest
+	(*GetUploadConstraintsResponse)(nil),  // 17: v1.GetUploadConstraintsResponse
+	(*PresignDownloadRequest)(nil),        // 18: v1.PresignDownloadRequest
+	(*PresignDownloadResponse)(nil),       // 19: v1.PresignDownloadResponse
+	(*PresignHeadRequest)(nil),            // 20: v1.PresignHeadRequest
+	(*PresignHeadResponse)(nil),           // 21: v1.PresignHeadResponse
+	(*PresignDeleteRequest)(nil),          // 22: v1.PresignDeleteRequest
+	(*PresignDeleteResponse)(nil),         // 23: v1.PresignDeleteResponse
+	(*GetPublicURLRequest)(nil),           // 24: v1.GetPublicURLRequest
+	(*GetPublicURLResponse)(nil),          // 25: v1.GetPublicURLResponse
+	(*DeleteObjectRequest)(nil),           // 26: v1.DeleteObjectRequest
+	(*DeleteObjectResponse)(nil),          // 27: v1.DeleteObjectResponse
+	(*PutObjectRequest)(nil),              // 28: v1.PutObjectRequest
+	(*PutObjectResponse)(nil),             // 29: v1.PutObjectResponse
+	(*UploadObjectRequest)(nil),           // 30: v1.UploadObjectRequest
+	(*UploadObjectMetadata)(nil),          // 31: v1.UploadObjectMetadata
+	(*UploadObjectResponse)(nil),          // 32: v1.UploadObjectResponse
+	(*GetObjectRequest)(nil),              // 33: v1.GetObjectRequest
+	(*GetObjectResponse)(nil),             // 34: v1.GetObjectResponse
+	(*GetObjectMetadata)(nil),             // 35: v1.GetObjectMetadata
+	(*ConfirmUploadRequest)(nil),          // 36: v1.ConfirmUploadRequest
+	(*ConfirmUploadResponse)(nil),         // 37: v1.ConfirmUploadResponse
+	(*CopyObjectRequest)(nil),             // 38: v1.CopyObjectRequest
+	(*CopyObjectResponse)(nil),            // 39: v1.CopyObjectResponse
+	(*MoveObjectRequest)(nil),             // 40: v1.MoveObjectRequest
+	(*MoveObjectResponse)(nil),            // 41: v1.MoveObjectResponse
+	(*RestoreObjectRequest)(nil),          // 42: v1.RestoreObjectRequest
+	(*RestoreObjectResponse)(nil),         // 43: v1.RestoreObjectResponse
+	(*SetObjectTagsRequest)(nil),          // 44: v1.SetObjectTagsRequest
+	(*SetObjectTagsResponse)(nil),         // 45: v1.SetObjectTagsResponse
+	(*GetObjectTagsRequest)(nil),          // 46: v1.GetObjectTagsRequest
+	(*GetObjectTagsResponse)(nil),         // 47: v1.GetObjectTagsResponse
+	(*SetObjectACLRequest)(nil),           // 48: v1.SetObjectACLRequest
+	(*SetObjectACLResponse)(nil),          // 49: v1.SetObjectACLResponse
+	(*GetObjectACLRequest)(nil),           // 50: v1.GetObjectACLRequest
+	(*GetObjectACLResponse)(nil),          // 51: v1.GetObjectACLResponse
+	(*SetObjectRetentionRequest)(nil),     // 52: v1.SetObjectRetentionRequest
+	(*SetObjectRetentionResponse)(nil),    // 53: v1.SetObjectRetentionResponse
+	(*SetObjectLegalHoldRequest)(nil),     // 54: v1.SetObjectLegalHoldRequest
+	(*SetObjectLegalHoldResponse)(nil),    // 55: v1.SetObjectLegalHoldResponse
+	(*GetObjectMetadataRequest)(nil),      // 56: v1.GetObjectMetadataRequest
+	(*GetObjectMetadataResponse)(nil),     // 57: v1.GetObjectMetadataResponse
+	(*BatchObjectExistsRequest)(nil),      // 58: v1.BatchObjectExistsRequest
+	(*BatchObjectExistsResponse)(nil),     // 59: v1.BatchObjectExistsResponse
+	(*ObjectExistsResult)(nil),            // 60: v1.ObjectExistsResult
+	(*SetBucketVersioningRequest)(nil),    // 61: v1.SetBucketVersioningRequest
+	(*SetBucketVersioningResponse)(nil),   // 62: v1.SetBucketVersioningResponse
+	(*SetBucketLifecycleRequest)(nil),     // 63: v1.SetBucketLifecycleRequest
+	(*SetBucketLifecycleResponse)(nil),    // 64: v1.SetBucketLifecycleResponse
+	(*GetBucketStatsRequest)(nil),         // 65: v1.GetBucketStatsRequest
+	(*GetBucketStatsResponse)(nil),        // 66: v1.GetBucketStatsResponse
+	(*FindObjectsByTagRequest)(nil),       // 67: v1.FindObjectsByTagRequest
+	(*FindObjectsByTagResponse)(nil),      // 68: v1.FindObjectsByTagResponse
+	(*TaggedObject)(nil),                  // 69: v1.TaggedObject
+	(*ListObjectVersionsRequest)(nil),     // 70: v1.ListObjectVersionsRequest
+	(*ObjectVersion)(nil),                 // 71: v1.ObjectVersion
+	(*ListObjectVersionsResponse)(nil),    // 72: v1.ListObjectVersionsResponse
+	(*ListUploadedPartsRequest)(nil),      // 73: v1.ListUploadedPartsRequest
+	(*UploadedPart)(nil),                  // 74: v1.UploadedPart
+	(*ListUploadedPartsResponse)(nil),     // 75: v1.ListUploadedPartsResponse
+	(*ConvertImageRequest)(nil),           // 76: v1.ConvertImageRequest
+	(*ConvertImageResponse)(nil),          // 77: v1.ConvertImageResponse
+	(*SanitizeImageRequest)(nil),          // 78: v1.SanitizeImageRequest
+	(*SanitizeImageResponse)(nil),         // 79: v1.SanitizeImageResponse
+	(*UpdateCredentialsRequest)(nil),      // 80: v1.UpdateCredentialsRequest
+	(*UpdateCredentialsResponse)(nil),     // 81: v1.UpdateCredentialsResponse
+	(*CreateOneTimeDownloadRequest)(nil),  // 82: v1.CreateOneTimeDownloadRequest
+	(*CreateOneTimeDownloadResponse)(nil), // 83: v1.CreateOneTimeDownloadResponse
+	(*RedeemDownloadRequest)(nil),         // 84: v1.RedeemDownloadRequest
+	(*RedeemDownloadResponse)(nil),        // 85: v1.RedeemDownloadResponse
+	nil,                                   // 86: v1.PresignUploadRequest.TagsEntry
+	nil,                                   // 87: v1.PresignUploadRequest.MetadataEntry
+	nil,                                   // 88: v1.PresignUploadRequest.MetadataStartsWithEntry
+	nil,                                   // 89: v1.PresignUploadResponse.FormDataEntry
+	nil,                                   // 90: v1.PresignUploadResponse.HeadersEntry
+	nil,                                   // 91: v1.GetUploadConstraintsResponse.MaxFileSizeByContentTypeEntry
+	nil,                                   // 92: v1.PutObjectRequest.TagsEntry
+	nil,                                   // 93: v1.ConfirmUploadResponse.TagsEntry
+	nil,                                   // 94: v1.CopyObjectRequest.MetadataEntry
+	nil,                                   // 95: v1.SetObjectTagsRequest.TagsEntry
+	nil,                                   // 96: v1.GetObjectTagsResponse.TagsEntry
+	nil,                                   // 97: v1.GetObjectMetadataResponse.MetadataEntry
+	nil,                                   // 98: v1.FindObjectsByTagRequest.TagsEntry
+	nil,                                   // 99: v1.TaggedObject.TagsEntry
+	(*timestamppb.Timestamp)(nil),         // 100: google.protobuf.Timestamp
+	(*PingRequest)(nil),                   // 101: v1.PingRequest
+	(*PingResponse)(nil),                  // 102: v1.PingResponse
 }
 var file_proto_mediabase_v1_mediabase_proto_depIdxs = []int32{
-	8,  // 0: v1.PresignUploadResponse.form_data:type_name -> v1.PresignUploadResponse.FormDataEntry
-	9,  // 1: v1.MediabaseService.Ping:input_type -> v1.PingRequest
-	2,  // 2: v1.MediabaseService.PresignUpload:input_type -> v1.PresignUploadRequest
-	4,  // 3: v1.MediabaseService.PresignDownload:input_type -> v1.PresignDownloadRequest
-	6,  // 4: v1.MediabaseService.DeleteObject:input_type -> v1.DeleteObjectRequest
-	0,  // 5: v1.MediabaseService.CreateBucket:input_type -> v1.CreateBucketRequest
-	10, // 6: v1.MediabaseService.Ping:output_type -> v1.PingResponse
-	3,  // 7: v1.MediabaseService.PresignUpload:output_type -> v1.PresignUploadResponse
-	5,  // 8: v1.MediabaseService.PresignDownload:output_type -> v1.PresignDownloadResponse
-	7,  // 9: v1.MediabaseService.DeleteObject:output_type -> v1.DeleteObjectResponse
-	1,  // 10: v1.MediabaseService.CreateBucket:output_type -> v1.CreateBucketResponse
-	6,  // [6:11] is the sub-list for method output_type
-	1,  // [1:6] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	5,   // 0: v1.CreateBucketRequest.cors:type_name -> v1.CorsRule
+	0,   // 1: v1.CreateBucketRequest.policy:type_name -> v1.BucketPolicy
+	86,  // 2: v1.PresignUploadRequest.tags:type_name -> v1.PresignUploadRequest.TagsEntry
+	1,   // 3: v1.PresignUploadRequest.method:type_name -> v1.UploadMethod
+	87,  // 4: v1.PresignUploadRequest.metadata:type_name -> v1.PresignUploadRequest.MetadataEntry
+	88,  // 5: v1.PresignUploadRequest.metadata_starts_with:type_name -> v1.PresignUploadRequest.MetadataStartsWithEntry
+	89,  // 6: v1.PresignUploadResponse.form_data:type_name -> v1.PresignUploadResponse.FormDataEntry
+	90,  // 7: v1.PresignUploadResponse.headers:type_name -> v1.PresignUploadResponse.HeadersEntry
+	1,   // 8: v1.PresignUploadResponse.method:type_name -> v1.UploadMethod
+	9,   // 9: v1.PresignUploadBatchRequest.uploads:type_name -> v1.PresignUploadRequest
+	13,  // 10: v1.PresignUploadBatchResponse.results:type_name -> v1.PresignUploadResult
+	10,  // 11: v1.PresignUploadResult.upload:type_name -> v1.PresignUploadResponse
+	9,   // 12: v1.PreflightUploadRequest.upload:type_name -> v1.PresignUploadRequest
+	91,  // 13: v1.GetUploadConstraintsResponse.max_file_size_by_content_type:type_name -> v1.GetUploadConstraintsResponse.MaxFileSizeByContentTypeEntry
+	92,  // 14: v1.PutObjectRequest.tags:type_name -> v1.PutObjectRequest.TagsEntry
+	31,  // 15: v1.UploadObjectRequest.metadata:type_name -> v1.UploadObjectMetadata
+	35,  // 16: v1.GetObjectResponse.metadata:type_name -> v1.GetObjectMetadata
+	100, // 17: v1.GetObjectMetadata.last_modified:type_name -> google.protobuf.Timestamp
+	93,  // 18: v1.ConfirmUploadResponse.tags:type_name -> v1.ConfirmUploadResponse.TagsEntry
+	100, // 19: v1.ConfirmUploadResponse.expires_at:type_name -> google.protobuf.Timestamp
+	94,  // 20: v1.CopyObjectRequest.metadata:type_name -> v1.CopyObjectRequest.MetadataEntry
+	95,  // 21: v1.SetObjectTagsRequest.tags:type_name -> v1.SetObjectTagsRequest.TagsEntry
+	96,  // 22: v1.GetObjectTagsResponse.tags:type_name -> v1.GetObjectTagsResponse.TagsEntry
+	2,   // 23: v1.SetObjectACLRequest.acl:type_name -> v1.ObjectACL
+	2,   // 24: v1.GetObjectACLResponse.acl:type_name -> v1.ObjectACL
+	3,   // 25: v1.SetObjectRetentionRequest.mode:type_name -> v1.RetentionMode
+	100, // 26: v1.SetObjectRetentionRequest.retain_until:type_name -> google.protobuf.Timestamp
+	100, // 27: v1.GetObjectMetadataResponse.last_modified:type_name -> google.protobuf.Timestamp
+	97,  // 28: v1.GetObjectMetadataResponse.metadata:type_name -> v1.GetObjectMetadataResponse.MetadataEntry
+	100, // 29: v1.GetObjectMetadataResponse.expires_at:type_name -> google.protobuf.Timestamp
+	60,  // 30: v1.BatchObjectExistsResponse.results:type_name -> v1.ObjectExistsResult
+	98,  // 31: v1.FindObjectsByTagRequest.tags:type_name -> v1.FindObjectsByTagRequest.TagsEntry
+	69,  // 32: v1.FindObjectsByTagResponse.objects:type_name -> v1.TaggedObject
+	99,  // 33: v1.TaggedObject.tags:type_name -> v1.TaggedObject.TagsEntry
+	100, // 34: v1.ObjectVersion.last_modified:type_name -> google.protobuf.Timestamp
+	71,  // 35: v1.ListObjectVersionsResponse.versions:type_name -> v1.ObjectVersion
+	100, // 36: v1.UploadedPart.last_modified:type_name -> google.protobuf.Timestamp
+	74,  // 37: v1.ListUploadedPartsResponse.parts:type_name -> v1.UploadedPart
+	101, // 38: v1.MediabaseService.Ping:input_type -> v1.PingRequest
+	9,   // 39: v1.MediabaseService.PresignUpload:input_type -> v1.PresignUploadRequest
+	11,  // 40: v1.MediabaseService.PresignUploadBatch:input_type -> v1.PresignUploadBatchRequest
+	14,  // 41: v1.MediabaseService.PreflightUpload:input_type -> v1.PreflightUploadRequest
+	18,  // 42: v1.MediabaseService.PresignDownload:input_type -> v1.PresignDownloadRequest
+	82,  // 43: v1.MediabaseService.CreateOneTimeDownload:input_type -> v1.CreateOneTimeDownloadRequest
+	84,  // 44: v1.MediabaseService.RedeemDownload:input_type -> v1.RedeemDownloadRequest
+	20,  // 45: v1.MediabaseService.PresignHead:input_type -> v1.PresignHeadRequest
+	22,  // 46: v1.MediabaseService.PresignDelete:input_type -> v1.PresignDeleteRequest
+	24,  // 47: v1.MediabaseService.GetPublicURL:input_type -> v1.GetPublicURLRequest
+	16,  // 48: v1.MediabaseService.GetUploadConstraints:input_type -> v1.GetUploadConstraintsRequest
+	26,  // 49: v1.MediabaseService.DeleteObject:input_type -> v1.DeleteObjectRequest
+	4,   // 50: v1.MediabaseService.CreateBucket:input_type -> v1.CreateBucketRequest
+	7,   // 51: v1.MediabaseService.DeleteBucket:input_type -> v1.DeleteBucketRequest
+	28,  // 52: v1.MediabaseService.PutObject:input_type -> v1.PutObjectRequest
+	30,  // 53: v1.MediabaseService.UploadObject:input_type -> v1.UploadObjectRequest
+	33,  // 54: v1.MediabaseService.GetObject:input_type -> v1.GetObjectRequest
+	36,  // 55: v1.MediabaseService.ConfirmUpload:input_type -> v1.ConfirmUploadRequest
+	38,  // 56: v1.MediabaseService.CopyObject:input_type -> v1.CopyObjectRequest
+	40,  // 57: v1.MediabaseService.MoveObject:input_type -> v1.MoveObjectRequest
+	42,  // 58: v1.MediabaseService.RestoreObject:input_type -> v1.RestoreObjectRequest
+	48,  // 59: v1.MediabaseService.SetObjectACL:input_type -> v1.SetObjectACLRequest
+	50,  // 60: v1.MediabaseService.GetObjectACL:input_type -> v1.GetObjectACLRequest
+	52,  // 61: v1.MediabaseService.SetObjectRetention:input_type -> v1.SetObjectRetentionRequest
+	54,  // 62: v1.MediabaseService.SetObjectLegalHold:input_type -> v1.SetObjectLegalHoldRequest
+	44,  // 63: v1.MediabaseService.SetObjectTags:input_type -> v1.SetObjectTagsRequest
+	46,  // 64: v1.MediabaseService.GetObjectTags:input_type -> v1.GetObjectTagsRequest
+	61,  // 65: v1.MediabaseService.SetBucketVersioning:input_type -> v1.SetBucketVersioningRequest
+	63,  // 66: v1.MediabaseService.SetBucketLifecycle:input_type -> v1.SetBucketLifecycleRequest
+	65,  // 67: v1.MediabaseService.GetBucketStats:input_type -> v1.GetBucketStatsRequest
+	56,  // 68: v1.MediabaseService.GetObjectMetadata:input_type -> v1.GetObjectMetadataRequest
+	58,  // 69: v1.MediabaseService.BatchObjectExists:input_type -> v1.BatchObjectExistsRequest
+	67,  // 70: v1.MediabaseService.FindObjectsByTag:input_type -> v1.FindObjectsByTagRequest
+	70,  // 71: v1.MediabaseService.ListObjectVersions:input_type -> v1.ListObjectVersionsRequest
+	73,  // 72: v1.MediabaseService.ListUploadedParts:input_type -> v1.ListUploadedPartsRequest
+	76,  // 73: v1.MediabaseService.ConvertImage:input_type -> v1.ConvertImageRequest
+	78,  // 74: v1.MediabaseService.SanitizeImage:input_type -> v1.SanitizeImageRequest
+	80,  // 75: v1.MediabaseService.UpdateCredentials:input_type -> v1.UpdateCredentialsRequest
+	102, // 76: v1.MediabaseService.Ping:output_type -> v1.PingResponse
+	10,  // 77: v1.MediabaseService.PresignUpload:output_type -> v1.PresignUploadResponse
+	12,  // 78: v1.MediabaseService.PresignUploadBatch:output_type -> v1.PresignUploadBatchResponse
+	15,  // 79: v1.MediabaseService.PreflightUpload:output_type -> v1.PreflightUploadResponse
+	19,  // 80: v1.MediabaseService.PresignDownload:output_type -> v1.PresignDownloadResponse
+	83,  // 81: v1.MediabaseService.CreateOneTimeDownload:output_type -> v1.CreateOneTimeDownloadResponse
+	85,  // 82: v1.MediabaseService.RedeemDownload:output_type -> v1.RedeemDownloadResponse
+	21,  // 83: v1.MediabaseService.PresignHead:output_type -> v1.PresignHeadResponse
+	23,  // 84: v1.MediabaseService.PresignDelete:output_type -> v1.PresignDeleteResponse
+	25,  // 85: v1.MediabaseService.GetPublicURL:output_type -> v1.GetPublicURLResponse
+	17,  // 86: v1.MediabaseService.GetUploadConstraints:output_type -> v1.GetUploadConstraintsResponse
+	27,  // 87: v1.MediabaseService.DeleteObject:output_type -> v1.DeleteObjectResponse
+	6,   // 88: v1.MediabaseService.CreateBucket:output_type -> v1.CreateBucketResponse
+	8,   // 89: v1.MediabaseService.DeleteBucket:output_type -> v1.DeleteBucketResponse
+	29,  // 90: v1.MediabaseService.PutObject:output_type -> v1.PutObjectResponse
+	32,  // 91: v1.MediabaseService.UploadObject:output_type -> v1.UploadObjectResponse
+	34,  // 92: v1.MediabaseService.GetObject:output_type -> v1.GetObjectResponse
+	37,  // 93: v1.MediabaseService.ConfirmUpload:output_type -> v1.ConfirmUploadResponse
+	39,  // 94: v1.MediabaseService.CopyObject:output_type -> v1.CopyObjectResponse
+	41,  // 95: v1.MediabaseService.MoveObject:output_type -> v1.MoveObjectResponse
+	43,  // 96: v1.MediabaseService.RestoreObject:output_type -> v1.RestoreObjectResponse
+	49,  // 97: v1.MediabaseService.SetObjectACL:output_type -> v1.SetObjectACLResponse
+	51,  // 98: v1.MediabaseService.GetObjectACL:output_type -> v1.GetObjectACLResponse
+	53,  // 99: v1.MediabaseService.SetObjectRetention:output_type -> v1.SetObjectRetentionResponse
+	55,  // 100: v1.MediabaseService.SetObjectLegalHold:output_type -> v1.SetObjectLegalHoldResponse
+	45,  // 101: v1.MediabaseService.SetObjectTags:output_type -> v1.SetObjectTagsResponse
+	47,  // 102: v1.MediabaseService.GetObjectTags:output_type -> v1.GetObjectTagsResponse
+	62,  // 103: v1.MediabaseService.SetBucketVersioning:output_type -> v1.SetBucketVersioningResponse
+	64,  // 104: v1.MediabaseService.SetBucketLifecycle:output_type -> v1.SetBucketLifecycleResponse
+	66,  // 105: v1.MediabaseService.GetBucketStats:output_type -> v1.GetBucketStatsResponse
+	57,  // 106: v1.MediabaseService.GetObjectMetadata:output_type -> v1.GetObjectMetadataResponse
+	59,  // 107: v1.MediabaseService.BatchObjectExists:output_type -> v1.BatchObjectExistsResponse
+	68,  // 108: v1.MediabaseService.FindObjectsByTag:output_type -> v1.FindObjectsByTagResponse
+	72,  // 109: v1.MediabaseService.ListObjectVersions:output_type -> v1.ListObjectVersionsResponse
+	75,  // 110: v1.MediabaseService.ListUploadedParts:output_type -> v1.ListUploadedPartsResponse
+	77,  // 111: v1.MediabaseService.ConvertImage:output_type -> v1.ConvertImageResponse
+	79,  // 112: v1.MediabaseService.SanitizeImage:output_type -> v1.SanitizeImageResponse
+	81,  // 113: v1.MediabaseService.UpdateCredentials:output_type -> v1.UpdateCredentialsResponse
+	76,  // [76:114] is the sub-list for method output_type
+	38,  // [38:76] is the sub-list for method input_type
+	38,  // [38:38] is the sub-list for extension type_name
+	38,  // [38:38] is the sub-list for extension extendee
+	0,   // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_proto_mediabase_v1_mediabase_proto_init() }
@@ -598,18 +6674,27 @@ func file_proto_mediabase_v1_mediabase_proto_init() {
 		return
 	}
 	file_proto_mediabase_v1_ping_proto_init()
+	file_proto_mediabase_v1_mediabase_proto_msgTypes[26].OneofWrappers = []any{
+		(*UploadObjectRequest_Metadata)(nil),
+		(*UploadObjectRequest_Chunk)(nil),
+	}
+	file_proto_mediabase_v1_mediabase_proto_msgTypes[30].OneofWrappers = []any{
+		(*GetObjectResponse_Metadata)(nil),
+		(*GetObjectResponse_Chunk)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_mediabase_v1_mediabase_proto_rawDesc), len(file_proto_mediabase_v1_mediabase_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      4,
+			NumMessages:   96,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_mediabase_v1_mediabase_proto_goTypes,
 		DependencyIndexes: file_proto_mediabase_v1_mediabase_proto_depIdxs,
+		EnumInfos:         file_proto_mediabase_v1_mediabase_proto_enumTypes,
 		MessageInfos:      file_proto_mediabase_v1_mediabase_proto_msgTypes,
 	}.Build()
 	File_proto_mediabase_v1_mediabase_proto = out.File
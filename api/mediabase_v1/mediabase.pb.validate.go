@@ -70,6 +70,52 @@ func (m *CreateBucketRequest) validate(all bool) error {
 
 	// no validation rules for IsPublic
 
+	// no validation rules for EnableVersioning
+
+	if all {
+		switch v := interface{}(m.GetCors()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateBucketRequestValidationError{
+					field:  "Cors",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateBucketRequestValidationError{
+					field:  "Cors",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCors()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateBucketRequestValidationError{
+				field:  "Cors",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := BucketPolicy_name[int32(m.GetPolicy())]; !ok {
+		err := CreateBucketRequestValidationError{
+			field:  "Policy",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PolicyPrefix
+
+	// no validation rules for EnableObjectLocking
+
 	if len(errors) > 0 {
 		return CreateBucketRequestMultiError(errors)
 	}
@@ -121,7 +167,9207 @@ func (e CreateBucketRequestValidationError) ErrorName() string {
 }
 
 // Error satisfies the builtin error interface
-func (e CreateBucketRequestValidationError) Error() string {
+func (e CreateBucketRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBucketRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBucketRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBucketRequestValidationError{}
+
+// Validate checks the field values on CorsRule with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CorsRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CorsRule with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CorsRuleMultiError, or nil
+// if none found.
+func (m *CorsRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CorsRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CorsRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// CorsRuleMultiError is an error wrapping multiple validation errors returned
+// by CorsRule.ValidateAll() if the designated constraints aren't met.
+type CorsRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CorsRuleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CorsRuleMultiError) AllErrors() []error { return m }
+
+// CorsRuleValidationError is the validation error returned by
+// CorsRule.Validate if the designated constraints aren't met.
+type CorsRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CorsRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CorsRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CorsRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CorsRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CorsRuleValidationError) ErrorName() string { return "CorsRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CorsRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCorsRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CorsRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CorsRuleValidationError{}
+
+// Validate checks the field values on CreateBucketResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateBucketResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBucketResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBucketResponseMultiError, or nil if none found.
+func (m *CreateBucketResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBucketResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return CreateBucketResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBucketResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateBucketResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateBucketResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBucketResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBucketResponseMultiError) AllErrors() []error { return m }
+
+// CreateBucketResponseValidationError is the validation error returned by
+// CreateBucketResponse.Validate if the designated constraints aren't met.
+type CreateBucketResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBucketResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBucketResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBucketResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBucketResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBucketResponseValidationError) ErrorName() string {
+	return "CreateBucketResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateBucketResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBucketResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBucketResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBucketResponseValidationError{}
+
+// Validate checks the field values on DeleteBucketRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBucketRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBucketRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBucketRequestMultiError, or nil if none found.
+func (m *DeleteBucketRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBucketRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBucketName()) < 1 {
+		err := DeleteBucketRequestValidationError{
+			field:  "BucketName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteBucketRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBucketRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteBucketRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteBucketRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBucketRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBucketRequestMultiError) AllErrors() []error { return m }
+
+// DeleteBucketRequestValidationError is the validation error returned by
+// DeleteBucketRequest.Validate if the designated constraints aren't met.
+type DeleteBucketRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBucketRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBucketRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBucketRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBucketRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBucketRequestValidationError) ErrorName() string {
+	return "DeleteBucketRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBucketRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBucketRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBucketRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBucketRequestValidationError{}
+
+// Validate checks the field values on DeleteBucketResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBucketResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBucketResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBucketResponseMultiError, or nil if none found.
+func (m *DeleteBucketResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBucketResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return DeleteBucketResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBucketResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteBucketResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteBucketResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBucketResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBucketResponseMultiError) AllErrors() []error { return m }
+
+// DeleteBucketResponseValidationError is the validation error returned by
+// DeleteBucketResponse.Validate if the designated constraints aren't met.
+type DeleteBucketResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBucketResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBucketResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBucketResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBucketResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBucketResponseValidationError) ErrorName() string {
+	return "DeleteBucketResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBucketResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBucketResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBucketResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBucketResponseValidationError{}
+
+// Validate checks the field values on PresignUploadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PresignUploadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PresignUploadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PresignUploadRequestMultiError, or nil if none found.
+func (m *PresignUploadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PresignUploadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	// no validation rules for ContentType
+
+	if m.GetMaxFileSize() < 0 {
+		err := PresignUploadRequestValidationError{
+			field:  "MaxFileSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Path
+
+	// no validation rules for FileName
+
+	if utf8.RuneCountInString(m.GetCacheControl()) > 256 {
+		err := PresignUploadRequestValidationError{
+			field:  "CacheControl",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetChecksumSha256() != "" {
+
+		if !_PresignUploadRequest_ChecksumSha256_Pattern.MatchString(m.GetChecksumSha256()) {
+			err := PresignUploadRequestValidationError{
+				field:  "ChecksumSha256",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{64}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetTags()) > 10 {
+		err := PresignUploadRequestValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 10 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DryRun
+
+	// no validation rules for Deduplicate
+
+	if _, ok := UploadMethod_name[int32(m.GetMethod())]; !ok {
+		err := PresignUploadRequestValidationError{
+			field:  "Method",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Metadata
+
+	if utf8.RuneCountInString(m.GetSuccessActionRedirect()) > 2048 {
+		err := PresignUploadRequestValidationError{
+			field:  "SuccessActionRedirect",
+			reason: "value length must be at most 2048 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SuccessActionStatus
+
+	if utf8.RuneCountInString(m.GetIdempotencyKey()) > 256 {
+		err := PresignUploadRequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetExpiresIn() < 0 {
+		err := PresignUploadRequestValidationError{
+			field:  "ExpiresIn",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for MetadataStartsWith
+
+	// no validation rules for IfNoneMatch
+
+	// no validation rules for KmsKeyId
+
+	if m.GetMinFileSize() < 0 {
+		err := PresignUploadRequestValidationError{
+			field:  "MinFileSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PrefixOnly
+
+	// no validation rules for ContentTypePrefix
+
+	if len(errors) > 0 {
+		return PresignUploadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PresignUploadRequestMultiError is an error wrapping multiple validation
+// errors returned by PresignUploadRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PresignUploadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PresignUploadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PresignUploadRequestMultiError) AllErrors() []error { return m }
+
+// PresignUploadRequestValidationError is the validation error returned by
+// PresignUploadRequest.Validate if the designated constraints aren't met.
+type PresignUploadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PresignUploadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PresignUploadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PresignUploadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PresignUploadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PresignUploadRequestValidationError) ErrorName() string {
+	return "PresignUploadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PresignUploadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPresignUploadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PresignUploadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PresignUploadRequestValidationError{}
+
+var _PresignUploadRequest_ChecksumSha256_Pattern = regexp.MustCompile("^[a-fA-F0-9]{64}$")
+
+// Validate checks the field values on PresignUploadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PresignUploadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PresignUploadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PresignUploadResponseMultiError, or nil if none found.
+func (m *PresignUploadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PresignUploadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PresignedUrl
+
+	// no validation rules for ObjectKey
+
+	// no validation rules for ExpiresIn
+
+	// no validation rules for FormData
+
+	// no validation rules for DryRun
+
+	// no validation rules for Deduplicated
+
+	// no validation rules for Headers
+
+	// no validation rules for FileName
+
+	// no validation rules for Method
+
+	if len(errors) > 0 {
+		return PresignUploadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PresignUploadResponseMultiError is an error wrapping multiple validation
+// errors returned by PresignUploadResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PresignUploadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PresignUploadResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PresignUploadResponseMultiError) AllErrors() []error { return m }
+
+// PresignUploadResponseValidationError is the validation error returned by
+// PresignUploadResponse.Validate if the designated constraints aren't met.
+type PresignUploadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PresignUploadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PresignUploadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PresignUploadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PresignUploadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PresignUploadResponseValidationError) ErrorName() string {
+	return "PresignUploadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PresignUploadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPresignUploadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PresignUploadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PresignUploadResponseValidationError{}
+
+// Validate checks the field values on PresignUploadBatchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PresignUploadBatchRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PresignUploadBatchRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PresignUploadBatchRequestMultiError, or nil if none found.
+func (m *PresignUploadBatchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PresignUploadBatchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	if len(m.GetUploads()) < 1 {
+		err := PresignUploadBatchRequestValidationError{
+			field:  "Uploads",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetUploads() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PresignUploadBatchRequestValidationError{
+						field:  fmt.Sprintf("Uploads[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PresignUploadBatchRequestValidationError{
+						field:  fmt.Sprintf("Uploads[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PresignUploadBatchRequestValidationError{
+					field:  fmt.Sprintf("Uploads[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PresignUploadBatchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PresignUploadBatchRequestMultiError is an error wrapping multiple validation
+// errors returned by PresignUploadBatchRequest.ValidateAll() if the
+// designated constraints aren't met.
+type PresignUploadBatchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PresignUploadBatchRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PresignUploadBatchRequestMultiError) AllErrors() []error { return m }
+
+// PresignUploadBatchRequestValidationError is the validation error returned by
+// PresignUploadBatchRequest.Validate if the designated constraints aren't met.
+type PresignUploadBatchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PresignUploadBatchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PresignUploadBatchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PresignUploadBatchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PresignUploadBatchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PresignUploadBatchRequestValidationError) ErrorName() string {
+	return "PresignUploadBatchRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PresignUploadBatchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPresignUploadBatchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PresignUploadBatchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PresignUploadBatchRequestValidationError{}
+
+// Validate checks the field values on PresignUploadBatchResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PresignUploadBatchResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PresignUploadBatchResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PresignUploadBatchResponseMultiError, or nil if none found.
+func (m *PresignUploadBatchResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PresignUploadBatchResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PresignUploadBatchResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PresignUploadBatchResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PresignUploadBatchResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PresignUploadBatchResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PresignUploadBatchResponseMultiError is an error wrapping multiple
+// validation errors returned by PresignUploadBatchResponse.ValidateAll() if
+// the designated constraints aren't met.
+type PresignUploadBatchResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PresignUploadBatchResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PresignUploadBatchResponseMultiError) AllErrors() []error { return m }
+
+// PresignUploadBatchResponseValidationError is the validation error returned
+// by PresignUploadBatchResponse.Validate if the designated constraints aren't met.
+type PresignUploadBatchResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PresignUploadBatchResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PresignUploadBatchResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PresignUploadBatchResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PresignUploadBatchResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PresignUploadBatchResponseValidationError) ErrorName() string {
+	return "PresignUploadBatchResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PresignUploadBatchResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPresignUploadBatchResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PresignUploadBatchResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PresignUploadBatchResponseValidationError{}
+
+// Validate checks the field values on PresignUploadResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PresignUploadResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PresignUploadResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PresignUploadResultMultiError, or nil if none found.
+func (m *PresignUploadResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PresignUploadResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUpload()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PresignUploadResultValidationError{
+					field:  "Upload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PresignUploadResultValidationError{
+					field:  "Upload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpload()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PresignUploadResultValidationError{
+				field:  "Upload",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ErrorCode
+
+	// no validation rules for ErrorMessage
+
+	if len(errors) > 0 {
+		return PresignUploadResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// PresignUploadResultMultiError is an error wrapping multiple validation
+// errors returned by PresignUploadResult.ValidateAll() if the designated
+// constraints aren't met.
+type PresignUploadResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PresignUploadResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PresignUploadResultMultiError) AllErrors() []error { return m }
+
+// PresignUploadResultValidationError is the validation error returned by
+// PresignUploadResult.Validate if the designated constraints aren't met.
+type PresignUploadResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PresignUploadResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PresignUploadResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PresignUploadResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PresignUploadResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PresignUploadResultValidationError) ErrorName() string {
+	return "PresignUploadResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PresignUploadResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPresignUploadResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PresignUploadResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PresignUploadResultValidationError{}
+
+// Validate checks the field values on PreflightUploadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreflightUploadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PreflightUploadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PreflightUploadRequestMultiError, or nil if none found.
+func (m *PreflightUploadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PreflightUploadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUpload() == nil {
+		err := PreflightUploadRequestValidationError{
+			field:  "Upload",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpload()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PreflightUploadRequestValidationError{
+					field:  "Upload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PreflightUploadRequestValidationError{
+					field:  "Upload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpload()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PreflightUploadRequestValidationError{
+				field:  "Upload",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Origin
+
+	if len(errors) > 0 {
+		return PreflightUploadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreflightUploadRequestMultiError is an error wrapping multiple validation
+// errors returned by PreflightUploadRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PreflightUploadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreflightUploadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreflightUploadRequestMultiError) AllErrors() []error { return m }
+
+// PreflightUploadRequestValidationError is the validation error returned by
+// PreflightUploadRequest.Validate if the designated constraints aren't met.
+type PreflightUploadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreflightUploadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreflightUploadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreflightUploadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreflightUploadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreflightUploadRequestValidationError) ErrorName() string {
+	return "PreflightUploadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreflightUploadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreflightUploadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreflightUploadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreflightUploadRequestValidationError{}
+
+// Validate checks the field values on PreflightUploadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreflightUploadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PreflightUploadResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PreflightUploadResponseMultiError, or nil if none found.
+func (m *PreflightUploadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PreflightUploadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Method
+
+	// no validation rules for FileField
+
+	// no validation rules for MaxFileSize
+
+	// no validation rules for SuccessActionStatus
+
+	// no validation rules for OriginAllowed
+
+	if len(errors) > 0 {
+		return PreflightUploadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreflightUploadResponseMultiError is an error wrapping multiple validation
+// errors returned by PreflightUploadResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PreflightUploadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreflightUploadResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreflightUploadResponseMultiError) AllErrors() []error { return m }
+
+// PreflightUploadResponseValidationError is the validation error returned by
+// PreflightUploadResponse.Validate if the designated constraints aren't met.
+type PreflightUploadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreflightUploadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreflightUploadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreflightUploadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreflightUploadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreflightUploadResponseValidationError) ErrorName() string {
+	return "PreflightUploadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreflightUploadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreflightUploadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreflightUploadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreflightUploadResponseValidationError{}
+
+// Validate checks the field values on GetUploadConstraintsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUploadConstraintsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUploadConstraintsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUploadConstraintsRequestMultiError, or nil if none found.
+func (m *GetUploadConstraintsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUploadConstraintsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetUploadConstraintsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUploadConstraintsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetUploadConstraintsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetUploadConstraintsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUploadConstraintsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUploadConstraintsRequestMultiError) AllErrors() []error { return m }
+
+// GetUploadConstraintsRequestValidationError is the validation error returned
+// by GetUploadConstraintsRequest.Validate if the designated constraints
+// aren't met.
+type GetUploadConstraintsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUploadConstraintsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUploadConstraintsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUploadConstraintsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUploadConstraintsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUploadConstraintsRequestValidationError) ErrorName() string {
+	return "GetUploadConstraintsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUploadConstraintsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUploadConstraintsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUploadConstraintsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUploadConstraintsRequestValidationError{}
+
+// Validate checks the field values on GetUploadConstraintsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUploadConstraintsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUploadConstraintsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUploadConstraintsResponseMultiError, or nil if none found.
+func (m *GetUploadConstraintsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUploadConstraintsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MaxFileSize
+
+	// no validation rules for MaxFileSizeByContentType
+
+	// no validation rules for UploadExpiresIn
+
+	// no validation rules for DownloadExpiresIn
+
+	// no validation rules for MaxPresignBatchSize
+
+	// no validation rules for MaxExpiresIn
+
+	// no validation rules for MinFileSize
+
+	if len(errors) > 0 {
+		return GetUploadConstraintsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUploadConstraintsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetUploadConstraintsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetUploadConstraintsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUploadConstraintsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUploadConstraintsResponseMultiError) AllErrors() []error { return m }
+
+// GetUploadConstraintsResponseValidationError is the validation error returned
+// by GetUploadConstraintsResponse.Validate if the designated constraints
+// aren't met.
+type GetUploadConstraintsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUploadConstraintsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUploadConstraintsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUploadConstraintsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUploadConstraintsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUploadConstraintsResponseValidationError) ErrorName() string {
+	return "GetUploadConstraintsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUploadConstraintsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUploadConstraintsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUploadConstraintsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUploadConstraintsResponseValidationError{}
+
+// Validate checks the field values on PresignDownloadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PresignDownloadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PresignDownloadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PresignDownloadRequestMultiError, or nil if none found.
+func (m *PresignDownloadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PresignDownloadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	if utf8.RuneCountInString(m.GetObjectKey()) < 1 {
+		err := PresignDownloadRequestValidationError{
+			field:  "ObjectKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for VersionId
+
+	if utf8.RuneCountInString(m.GetCacheControl()) > 256 {
+		err := PresignDownloadRequestValidationError{
+			field:  "CacheControl",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetContentType()) > 256 {
+		err := PresignDownloadRequestValidationError{
+			field:  "ContentType",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetExpiresIn() < 0 {
+		err := PresignDownloadRequestValidationError{
+			field:  "ExpiresIn",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAllowedClientIp()) > 45 {
+		err := PresignDownloadRequestValidationError{
+			field:  "AllowedClientIp",
+			reason: "value length must be at most 45 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Proxy
+
+	if len(errors) > 0 {
+		return PresignDownloadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PresignDownloadRequestMultiError is an error wrapping multiple validation
+// errors returned by PresignDownloadRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PresignDownloadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PresignDownloadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PresignDownloadRequestMultiError) AllErrors() []error { return m }
+
+// PresignDownloadRequestValidationError is the validation error returned by
+// PresignDownloadRequest.Validate if the designated constraints aren't met.
+type PresignDownloadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PresignDownloadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PresignDownloadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PresignDownloadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PresignDownloadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PresignDownloadRequestValidationError) ErrorName() string {
+	return "PresignDownloadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PresignDownloadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPresignDownloadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PresignDownloadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PresignDownloadRequestValidationError{}
+
+// Validate checks the field values on PresignDownloadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PresignDownloadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PresignDownloadResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PresignDownloadResponseMultiError, or nil if none found.
+func (m *PresignDownloadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PresignDownloadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PresignedUrl
+
+	// no validation rules for ExpiresIn
+
+	if len(errors) > 0 {
+		return PresignDownloadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PresignDownloadResponseMultiError is an error wrapping multiple validation
+// errors returned by PresignDownloadResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PresignDownloadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PresignDownloadResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PresignDownloadResponseMultiError) AllErrors() []error { return m }
+
+// PresignDownloadResponseValidationError is the validation error returned by
+// PresignDownloadResponse.Validate if the designated constraints aren't met.
+type PresignDownloadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PresignDownloadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PresignDownloadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PresignDownloadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PresignDownloadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PresignDownloadResponseValidationError) ErrorName() string {
+	return "PresignDownloadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PresignDownloadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPresignDownloadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PresignDownloadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PresignDownloadResponseValidationError{}
+
+// Validate checks the field values on PresignHeadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PresignHeadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PresignHeadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PresignHeadRequestMultiError, or nil if none found.
+func (m *PresignHeadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PresignHeadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	if utf8.RuneCountInString(m.GetObjectKey()) < 1 {
+		err := PresignHeadRequestValidationError{
+			field:  "ObjectKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for VersionId
+
+	if m.GetExpiresIn() < 0 {
+		err := PresignHeadRequestValidationError{
+			field:  "ExpiresIn",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PresignHeadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PresignHeadRequestMultiError is an error wrapping multiple validation errors
+// returned by PresignHeadRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PresignHeadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PresignHeadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PresignHeadRequestMultiError) AllErrors() []error { return m }
+
+// PresignHeadRequestValidationError is the validation error returned by
+// PresignHeadRequest.Validate if the designated constraints aren't met.
+type PresignHeadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PresignHeadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PresignHeadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PresignHeadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PresignHeadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PresignHeadRequestValidationError) ErrorName() string {
+	return "PresignHeadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PresignHeadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPresignHeadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PresignHeadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PresignHeadRequestValidationError{}
+
+// Validate checks the field values on PresignHeadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PresignHeadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PresignHeadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PresignHeadResponseMultiError, or nil if none found.
+func (m *PresignHeadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PresignHeadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PresignedUrl
+
+	// no validation rules for ExpiresIn
+
+	if len(errors) > 0 {
+		return PresignHeadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PresignHeadResponseMultiError is an error wrapping multiple validation
+// errors returned by PresignHeadResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PresignHeadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PresignHeadResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PresignHeadResponseMultiError) AllErrors() []error { return m }
+
+// PresignHeadResponseValidationError is the validation error returned by
+// PresignHeadResponse.Validate if the designated constraints aren't met.
+type PresignHeadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PresignHeadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PresignHeadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PresignHeadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PresignHeadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PresignHeadResponseValidationError) ErrorName() string {
+	return "PresignHeadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PresignHeadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPresignHeadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PresignHeadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PresignHeadResponseValidationError{}
+
+// Validate checks the field values on PresignDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PresignDeleteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PresignDeleteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PresignDeleteRequestMultiError, or nil if none found.
+func (m *PresignDeleteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PresignDeleteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	if utf8.RuneCountInString(m.GetObjectKey()) < 1 {
+		err := PresignDeleteRequestValidationError{
+			field:  "ObjectKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetExpiresIn() < 0 {
+		err := PresignDeleteRequestValidationError{
+			field:  "ExpiresIn",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PresignDeleteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PresignDeleteRequestMultiError is an error wrapping multiple validation
+// errors returned by PresignDeleteRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PresignDeleteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PresignDeleteRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PresignDeleteRequestMultiError) AllErrors() []error { return m }
+
+// PresignDeleteRequestValidationError is the validation error returned by
+// PresignDeleteRequest.Validate if the designated constraints aren't met.
+type PresignDeleteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PresignDeleteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PresignDeleteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PresignDeleteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PresignDeleteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PresignDeleteRequestValidationError) ErrorName() string {
+	return "PresignDeleteRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PresignDeleteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPresignDeleteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PresignDeleteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PresignDeleteRequestValidationError{}
+
+// Validate checks the field values on PresignDeleteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PresignDeleteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PresignDeleteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PresignDeleteResponseMultiError, or nil if none found.
+func (m *PresignDeleteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PresignDeleteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PresignedUrl
+
+	// no validation rules for ExpiresIn
+
+	if len(errors) > 0 {
+		return PresignDeleteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PresignDeleteResponseMultiError is an error wrapping multiple validation
+// errors returned by PresignDeleteResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PresignDeleteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PresignDeleteResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PresignDeleteResponseMultiError) AllErrors() []error { return m }
+
+// PresignDeleteResponseValidationError is the validation error returned by
+// PresignDeleteResponse.Validate if the designated constraints aren't met.
+type PresignDeleteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PresignDeleteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PresignDeleteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PresignDeleteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PresignDeleteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PresignDeleteResponseValidationError) ErrorName() string {
+	return "PresignDeleteResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PresignDeleteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPresignDeleteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PresignDeleteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PresignDeleteResponseValidationError{}
+
+// Validate checks the field values on GetPublicURLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPublicURLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPublicURLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPublicURLRequestMultiError, or nil if none found.
+func (m *GetPublicURLRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPublicURLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	if utf8.RuneCountInString(m.GetObjectKey()) < 1 {
+		err := GetPublicURLRequestValidationError{
+			field:  "ObjectKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetPublicURLRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPublicURLRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPublicURLRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPublicURLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPublicURLRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPublicURLRequestMultiError) AllErrors() []error { return m }
+
+// GetPublicURLRequestValidationError is the validation error returned by
+// GetPublicURLRequest.Validate if the designated constraints aren't met.
+type GetPublicURLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPublicURLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPublicURLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPublicURLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPublicURLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPublicURLRequestValidationError) ErrorName() string {
+	return "GetPublicURLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPublicURLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPublicURLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPublicURLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPublicURLRequestValidationError{}
+
+// Validate checks the field values on GetPublicURLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPublicURLResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPublicURLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPublicURLResponseMultiError, or nil if none found.
+func (m *GetPublicURLResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPublicURLResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Url
+
+	if len(errors) > 0 {
+		return GetPublicURLResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPublicURLResponseMultiError is an error wrapping multiple validation
+// errors returned by GetPublicURLResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetPublicURLResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPublicURLResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPublicURLResponseMultiError) AllErrors() []error { return m }
+
+// GetPublicURLResponseValidationError is the validation error returned by
+// GetPublicURLResponse.Validate if the designated constraints aren't met.
+type GetPublicURLResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPublicURLResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPublicURLResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPublicURLResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPublicURLResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPublicURLResponseValidationError) ErrorName() string {
+	return "GetPublicURLResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPublicURLResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPublicURLResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPublicURLResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPublicURLResponseValidationError{}
+
+// Validate checks the field values on DeleteObjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteObjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteObjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteObjectRequestMultiError, or nil if none found.
+func (m *DeleteObjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteObjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	if utf8.RuneCountInString(m.GetObjectKey()) < 1 {
+		err := DeleteObjectRequestValidationError{
+			field:  "ObjectKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for VersionId
+
+	if utf8.RuneCountInString(m.GetIdempotencyKey()) > 256 {
+		err := DeleteObjectRequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for FailIfMissing
+
+	if len(errors) > 0 {
+		return DeleteObjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteObjectRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteObjectRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteObjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteObjectRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteObjectRequestMultiError) AllErrors() []error { return m }
+
+// DeleteObjectRequestValidationError is the validation error returned by
+// DeleteObjectRequest.Validate if the designated constraints aren't met.
+type DeleteObjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteObjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteObjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteObjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteObjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteObjectRequestValidationError) ErrorName() string {
+	return "DeleteObjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteObjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteObjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteObjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteObjectRequestValidationError{}
+
+// Validate checks the field values on DeleteObjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteObjectResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteObjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteObjectResponseMultiError, or nil if none found.
+func (m *DeleteObjectResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteObjectResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return DeleteObjectResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteObjectResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteObjectResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteObjectResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteObjectResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteObjectResponseMultiError) AllErrors() []error { return m }
+
+// DeleteObjectResponseValidationError is the validation error returned by
+// DeleteObjectResponse.Validate if the designated constraints aren't met.
+type DeleteObjectResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteObjectResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteObjectResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteObjectResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteObjectResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteObjectResponseValidationError) ErrorName() string {
+	return "DeleteObjectResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteObjectResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteObjectResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteObjectResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteObjectResponseValidationError{}
+
+// Validate checks the field values on PutObjectRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PutObjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PutObjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PutObjectRequestMultiError, or nil if none found.
+func (m *PutObjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PutObjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	// no validation rules for ContentType
+
+	if len(m.GetContent()) < 1 {
+		err := PutObjectRequestValidationError{
+			field:  "Content",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Path
+
+	// no validation rules for FileName
+
+	if utf8.RuneCountInString(m.GetCacheControl()) > 256 {
+		err := PutObjectRequestValidationError{
+			field:  "CacheControl",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetContentMd5() != "" {
+
+		if !_PutObjectRequest_ContentMd5_Pattern.MatchString(m.GetContentMd5()) {
+			err := PutObjectRequestValidationError{
+				field:  "ContentMd5",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{32}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetChecksumSha256() != "" {
+
+		if !_PutObjectRequest_ChecksumSha256_Pattern.MatchString(m.GetChecksumSha256()) {
+			err := PutObjectRequestValidationError{
+				field:  "ChecksumSha256",
+				reason: "value does not match regex pattern \"^[a-fA-F0-9]{64}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetTags()) > 10 {
+		err := PutObjectRequestValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 10 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for KmsKeyId
+
+	if len(errors) > 0 {
+		return PutObjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PutObjectRequestMultiError is an error wrapping multiple validation errors
+// returned by PutObjectRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PutObjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PutObjectRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PutObjectRequestMultiError) AllErrors() []error { return m }
+
+// PutObjectRequestValidationError is the validation error returned by
+// PutObjectRequest.Validate if the designated constraints aren't met.
+type PutObjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PutObjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PutObjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PutObjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PutObjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PutObjectRequestValidationError) ErrorName() string { return "PutObjectRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PutObjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPutObjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PutObjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PutObjectRequestValidationError{}
+
+var _PutObjectRequest_ContentMd5_Pattern = regexp.MustCompile("^[a-fA-F0-9]{32}$")
+
+var _PutObjectRequest_ChecksumSha256_Pattern = regexp.MustCompile("^[a-fA-F0-9]{64}$")
+
+// Validate checks the field values on PutObjectResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PutObjectResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PutObjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PutObjectResponseMultiError, or nil if none found.
+func (m *PutObjectResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PutObjectResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ObjectKey
+
+	// no validation rules for Size
+
+	// no validation rules for Etag
+
+	if len(errors) > 0 {
+		return PutObjectResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PutObjectResponseMultiError is an error wrapping multiple validation errors
+// returned by PutObjectResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PutObjectResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PutObjectResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PutObjectResponseMultiError) AllErrors() []error { return m }
+
+// PutObjectResponseValidationError is the validation error returned by
+// PutObjectResponse.Validate if the designated constraints aren't met.
+type PutObjectResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PutObjectResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PutObjectResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PutObjectResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PutObjectResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PutObjectResponseValidationError) ErrorName() string {
+	return "PutObjectResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PutObjectResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPutObjectResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PutObjectResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PutObjectResponseValidationError{}
+
+// Validate checks the field values on UploadObjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadObjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadObjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadObjectRequestMultiError, or nil if none found.
+func (m *UploadObjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadObjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Data.(type) {
+	case *UploadObjectRequest_Metadata:
+		if v == nil {
+			err := UploadObjectRequestValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMetadata()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UploadObjectRequestValidationError{
+						field:  "Metadata",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UploadObjectRequestValidationError{
+						field:  "Metadata",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UploadObjectRequestValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UploadObjectRequest_Chunk:
+		if v == nil {
+			err := UploadObjectRequestValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Chunk
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return UploadObjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadObjectRequestMultiError is an error wrapping multiple validation
+// errors returned by UploadObjectRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UploadObjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadObjectRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadObjectRequestMultiError) AllErrors() []error { return m }
+
+// UploadObjectRequestValidationError is the validation error returned by
+// UploadObjectRequest.Validate if the designated constraints aren't met.
+type UploadObjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadObjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadObjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadObjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadObjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadObjectRequestValidationError) ErrorName() string {
+	return "UploadObjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadObjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadObjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadObjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadObjectRequestValidationError{}
+
+// Validate checks the field values on UploadObjectMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadObjectMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadObjectMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadObjectMetadataMultiError, or nil if none found.
+func (m *UploadObjectMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadObjectMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	// no validation rules for ContentType
+
+	// no validation rules for Path
+
+	// no validation rules for FileName
+
+	if m.GetSize() < 0 {
+		err := UploadObjectMetadataValidationError{
+			field:  "Size",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DetectContentType
+
+	// no validation rules for KmsKeyId
+
+	if len(errors) > 0 {
+		return UploadObjectMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadObjectMetadataMultiError is an error wrapping multiple validation
+// errors returned by UploadObjectMetadata.ValidateAll() if the designated
+// constraints aren't met.
+type UploadObjectMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadObjectMetadataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadObjectMetadataMultiError) AllErrors() []error { return m }
+
+// UploadObjectMetadataValidationError is the validation error returned by
+// UploadObjectMetadata.Validate if the designated constraints aren't met.
+type UploadObjectMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadObjectMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadObjectMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadObjectMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadObjectMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadObjectMetadataValidationError) ErrorName() string {
+	return "UploadObjectMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadObjectMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadObjectMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadObjectMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadObjectMetadataValidationError{}
+
+// Validate checks the field values on UploadObjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UploadObjectResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadObjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UploadObjectResponseMultiError, or nil if none found.
+func (m *UploadObjectResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadObjectResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ObjectKey
+
+	// no validation rules for Size
+
+	// no validation rules for Etag
+
+	if len(errors) > 0 {
+		return UploadObjectResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadObjectResponseMultiError is an error wrapping multiple validation
+// errors returned by UploadObjectResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UploadObjectResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadObjectResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadObjectResponseMultiError) AllErrors() []error { return m }
+
+// UploadObjectResponseValidationError is the validation error returned by
+// UploadObjectResponse.Validate if the designated constraints aren't met.
+type UploadObjectResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadObjectResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadObjectResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadObjectResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadObjectResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadObjectResponseValidationError) ErrorName() string {
+	return "UploadObjectResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UploadObjectResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadObjectResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadObjectResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadObjectResponseValidationError{}
+
+// Validate checks the field values on GetObjectRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetObjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetObjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetObjectRequestMultiError, or nil if none found.
+func (m *GetObjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetObjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	if utf8.RuneCountInString(m.GetObjectKey()) < 1 {
+		err := GetObjectRequestValidationError{
+			field:  "ObjectKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOffset() < 0 {
+		err := GetObjectRequestValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLength() < 0 {
+		err := GetObjectRequestValidationError{
+			field:  "Length",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetObjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetObjectRequestMultiError is an error wrapping multiple validation errors
+// returned by GetObjectRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetObjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetObjectRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetObjectRequestMultiError) AllErrors() []error { return m }
+
+// GetObjectRequestValidationError is the validation error returned by
+// GetObjectRequest.Validate if the designated constraints aren't met.
+type GetObjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetObjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetObjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetObjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetObjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetObjectRequestValidationError) ErrorName() string { return "GetObjectRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetObjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetObjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetObjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetObjectRequestValidationError{}
+
+// Validate checks the field values on GetObjectResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetObjectResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetObjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetObjectResponseMultiError, or nil if none found.
+func (m *GetObjectResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetObjectResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Data.(type) {
+	case *GetObjectResponse_Metadata:
+		if v == nil {
+			err := GetObjectResponseValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMetadata()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetObjectResponseValidationError{
+						field:  "Metadata",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetObjectResponseValidationError{
+						field:  "Metadata",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetObjectResponseValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *GetObjectResponse_Chunk:
+		if v == nil {
+			err := GetObjectResponseValidationError{
+				field:  "Data",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Chunk
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return GetObjectResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetObjectResponseMultiError is an error wrapping multiple validation errors
+// returned by GetObjectResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetObjectResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetObjectResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetObjectResponseMultiError) AllErrors() []error { return m }
+
+// GetObjectResponseValidationError is the validation error returned by
+// GetObjectResponse.Validate if the designated constraints aren't met.
+type GetObjectResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetObjectResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetObjectResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetObjectResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetObjectResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetObjectResponseValidationError) ErrorName() string {
+	return "GetObjectResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetObjectResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetObjectResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetObjectResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetObjectResponseValidationError{}
+
+// Validate checks the field values on GetObjectMetadata with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetObjectMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetObjectMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetObjectMetadataMultiError, or nil if none found.
+func (m *GetObjectMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetObjectMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ContentType
+
+	// no validation rules for Size
+
+	// no validation rules for Length
+
+	// no validation rules for Etag
+
+	if all {
+		switch v := interface{}(m.GetLastModified()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetObjectMetadataValidationError{
+					field:  "LastModified",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetObjectMetadataValidationError{
+					field:  "LastModified",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastModified()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetObjectMetadataValidationError{
+				field:  "LastModified",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetObjectMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetObjectMetadataMultiError is an error wrapping multiple validation errors
+// returned by GetObjectMetadata.ValidateAll() if the designated constraints
+// aren't met.
+type GetObjectMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetObjectMetadataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetObjectMetadataMultiError) AllErrors() []error { return m }
+
+// GetObjectMetadataValidationError is the validation error returned by
+// GetObjectMetadata.Validate if the designated constraints aren't met.
+type GetObjectMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetObjectMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetObjectMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetObjectMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetObjectMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetObjectMetadataValidationError) ErrorName() string {
+	return "GetObjectMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetObjectMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetObjectMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetObjectMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetObjectMetadataValidationError{}
+
+// Validate checks the field values on ConfirmUploadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmUploadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmUploadRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmUploadRequestMultiError, or nil if none found.
+func (m *ConfirmUploadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmUploadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	if utf8.RuneCountInString(m.GetObjectKey()) < 1 {
+		err := ConfirmUploadRequestValidationError{
+			field:  "ObjectKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ConfirmUploadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmUploadRequestMultiError is an error wrapping multiple validation
+// errors returned by ConfirmUploadRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ConfirmUploadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmUploadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmUploadRequestMultiError) AllErrors() []error { return m }
+
+// ConfirmUploadRequestValidationError is the validation error returned by
+// ConfirmUploadRequest.Validate if the designated constraints aren't met.
+type ConfirmUploadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmUploadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmUploadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmUploadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmUploadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmUploadRequestValidationError) ErrorName() string {
+	return "ConfirmUploadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmUploadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmUploadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmUploadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmUploadRequestValidationError{}
+
+// Validate checks the field values on ConfirmUploadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmUploadResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmUploadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmUploadResponseMultiError, or nil if none found.
+func (m *ConfirmUploadResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmUploadResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ObjectKey
+
+	// no validation rules for Size
+
+	// no validation rules for ContentType
+
+	// no validation rules for ChecksumVerified
+
+	// no validation rules for Tags
+
+	// no validation rules for MalwareScanned
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfirmUploadResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfirmUploadResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfirmUploadResponseValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Etag
+
+	if len(errors) > 0 {
+		return ConfirmUploadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmUploadResponseMultiError is an error wrapping multiple validation
+// errors returned by ConfirmUploadResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ConfirmUploadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmUploadResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmUploadResponseMultiError) AllErrors() []error { return m }
+
+// ConfirmUploadResponseValidationError is the validation error returned by
+// ConfirmUploadResponse.Validate if the designated constraints aren't met.
+type ConfirmUploadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmUploadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmUploadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmUploadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmUploadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmUploadResponseValidationError) ErrorName() string {
+	return "ConfirmUploadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmUploadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmUploadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmUploadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmUploadResponseValidationError{}
+
+// Validate checks the field values on CopyObjectRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CopyObjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CopyObjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CopyObjectRequestMultiError, or nil if none found.
+func (m *CopyObjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CopyObjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	if utf8.RuneCountInString(m.GetSourceKey()) < 1 {
+		err := CopyObjectRequestValidationError{
+			field:  "SourceKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDestinationKey()) < 1 {
+		err := CopyObjectRequestValidationError{
+			field:  "DestinationKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ContentType
+
+	if utf8.RuneCountInString(m.GetCacheControl()) > 256 {
+		err := CopyObjectRequestValidationError{
+			field:  "CacheControl",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Metadata
+
+	if utf8.RuneCountInString(m.GetIdempotencyKey()) > 256 {
+		err := CopyObjectRequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CopyObjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CopyObjectRequestMultiError is an error wrapping multiple validation errors
+// returned by CopyObjectRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CopyObjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CopyObjectRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CopyObjectRequestMultiError) AllErrors() []error { return m }
+
+// CopyObjectRequestValidationError is the validation error returned by
+// CopyObjectRequest.Validate if the designated constraints aren't met.
+type CopyObjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CopyObjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CopyObjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CopyObjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CopyObjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CopyObjectRequestValidationError) ErrorName() string {
+	return "CopyObjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CopyObjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCopyObjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CopyObjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CopyObjectRequestValidationError{}
+
+// Validate checks the field values on CopyObjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CopyObjectResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CopyObjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CopyObjectResponseMultiError, or nil if none found.
+func (m *CopyObjectResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CopyObjectResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ObjectKey
+
+	// no validation rules for Size
+
+	// no validation rules for ContentType
+
+	if len(errors) > 0 {
+		return CopyObjectResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CopyObjectResponseMultiError is an error wrapping multiple validation errors
+// returned by CopyObjectResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CopyObjectResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CopyObjectResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CopyObjectResponseMultiError) AllErrors() []error { return m }
+
+// CopyObjectResponseValidationError is the validation error returned by
+// CopyObjectResponse.Validate if the designated constraints aren't met.
+type CopyObjectResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CopyObjectResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CopyObjectResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CopyObjectResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CopyObjectResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CopyObjectResponseValidationError) ErrorName() string {
+	return "CopyObjectResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CopyObjectResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCopyObjectResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CopyObjectResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CopyObjectResponseValidationError{}
+
+// Validate checks the field values on MoveObjectRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MoveObjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveObjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveObjectRequestMultiError, or nil if none found.
+func (m *MoveObjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveObjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	if utf8.RuneCountInString(m.GetSourceKey()) < 1 {
+		err := MoveObjectRequestValidationError{
+			field:  "SourceKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DestinationBucket
+
+	// no validation rules for DestinationKey
+
+	if len(errors) > 0 {
+		return MoveObjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveObjectRequestMultiError is an error wrapping multiple validation errors
+// returned by MoveObjectRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MoveObjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveObjectRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveObjectRequestMultiError) AllErrors() []error { return m }
+
+// MoveObjectRequestValidationError is the validation error returned by
+// MoveObjectRequest.Validate if the designated constraints aren't met.
+type MoveObjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveObjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveObjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveObjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveObjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveObjectRequestValidationError) ErrorName() string {
+	return "MoveObjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MoveObjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveObjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveObjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveObjectRequestValidationError{}
+
+// Validate checks the field values on MoveObjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MoveObjectResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveObjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveObjectResponseMultiError, or nil if none found.
+func (m *MoveObjectResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveObjectResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	// no validation rules for ObjectKey
+
+	// no validation rules for Size
+
+	if len(errors) > 0 {
+		return MoveObjectResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveObjectResponseMultiError is an error wrapping multiple validation errors
+// returned by MoveObjectResponse.ValidateAll() if the designated constraints
+// aren't met.
+type MoveObjectResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveObjectResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveObjectResponseMultiError) AllErrors() []error { return m }
+
+// MoveObjectResponseValidationError is the validation error returned by
+// MoveObjectResponse.Validate if the designated constraints aren't met.
+type MoveObjectResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveObjectResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveObjectResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveObjectResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveObjectResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveObjectResponseValidationError) ErrorName() string {
+	return "MoveObjectResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MoveObjectResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveObjectResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveObjectResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveObjectResponseValidationError{}
+
+// Validate checks the field values on RestoreObjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreObjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreObjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreObjectRequestMultiError, or nil if none found.
+func (m *RestoreObjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreObjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	if utf8.RuneCountInString(m.GetObjectKey()) < 1 {
+		err := RestoreObjectRequestValidationError{
+			field:  "ObjectKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RestoreObjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreObjectRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreObjectRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreObjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreObjectRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreObjectRequestMultiError) AllErrors() []error { return m }
+
+// RestoreObjectRequestValidationError is the validation error returned by
+// RestoreObjectRequest.Validate if the designated constraints aren't met.
+type RestoreObjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreObjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreObjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreObjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreObjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreObjectRequestValidationError) ErrorName() string {
+	return "RestoreObjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreObjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreObjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreObjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreObjectRequestValidationError{}
+
+// Validate checks the field values on RestoreObjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreObjectResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreObjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreObjectResponseMultiError, or nil if none found.
+func (m *RestoreObjectResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreObjectResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ObjectKey
+
+	// no validation rules for Size
+
+	if len(errors) > 0 {
+		return RestoreObjectResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreObjectResponseMultiError is an error wrapping multiple validation
+// errors returned by RestoreObjectResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreObjectResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreObjectResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreObjectResponseMultiError) AllErrors() []error { return m }
+
+// RestoreObjectResponseValidationError is the validation error returned by
+// RestoreObjectResponse.Validate if the designated constraints aren't met.
+type RestoreObjectResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreObjectResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreObjectResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreObjectResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreObjectResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreObjectResponseValidationError) ErrorName() string {
+	return "RestoreObjectResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreObjectResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreObjectResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreObjectResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreObjectResponseValidationError{}
+
+// Validate checks the field values on SetObjectTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetObjectTagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetObjectTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetObjectTagsRequestMultiError, or nil if none found.
+func (m *SetObjectTagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetObjectTagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	if utf8.RuneCountInString(m.GetObjectKey()) < 1 {
+		err := SetObjectTagsRequestValidationError{
+			field:  "ObjectKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTags()) > 10 {
+		err := SetObjectTagsRequestValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 10 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetObjectTagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetObjectTagsRequestMultiError is an error wrapping multiple validation
+// errors returned by SetObjectTagsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetObjectTagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetObjectTagsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetObjectTagsRequestMultiError) AllErrors() []error { return m }
+
+// SetObjectTagsRequestValidationError is the validation error returned by
+// SetObjectTagsRequest.Validate if the designated constraints aren't met.
+type SetObjectTagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetObjectTagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetObjectTagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetObjectTagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetObjectTagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetObjectTagsRequestValidationError) ErrorName() string {
+	return "SetObjectTagsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetObjectTagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetObjectTagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetObjectTagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetObjectTagsRequestValidationError{}
+
+// Validate checks the field values on SetObjectTagsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetObjectTagsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetObjectTagsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetObjectTagsResponseMultiError, or nil if none found.
+func (m *SetObjectTagsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetObjectTagsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return SetObjectTagsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetObjectTagsResponseMultiError is an error wrapping multiple validation
+// errors returned by SetObjectTagsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetObjectTagsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetObjectTagsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetObjectTagsResponseMultiError) AllErrors() []error { return m }
+
+// SetObjectTagsResponseValidationError is the validation error returned by
+// SetObjectTagsResponse.Validate if the designated constraints aren't met.
+type SetObjectTagsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetObjectTagsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetObjectTagsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetObjectTagsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetObjectTagsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetObjectTagsResponseValidationError) ErrorName() string {
+	return "SetObjectTagsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetObjectTagsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetObjectTagsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetObjectTagsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetObjectTagsResponseValidationError{}
+
+// Validate checks the field values on GetObjectTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetObjectTagsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetObjectTagsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetObjectTagsRequestMultiError, or nil if none found.
+func (m *GetObjectTagsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetObjectTagsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	if utf8.RuneCountInString(m.GetObjectKey()) < 1 {
+		err := GetObjectTagsRequestValidationError{
+			field:  "ObjectKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetObjectTagsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetObjectTagsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetObjectTagsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetObjectTagsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetObjectTagsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetObjectTagsRequestMultiError) AllErrors() []error { return m }
+
+// GetObjectTagsRequestValidationError is the validation error returned by
+// GetObjectTagsRequest.Validate if the designated constraints aren't met.
+type GetObjectTagsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetObjectTagsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetObjectTagsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetObjectTagsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetObjectTagsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetObjectTagsRequestValidationError) ErrorName() string {
+	return "GetObjectTagsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetObjectTagsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetObjectTagsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetObjectTagsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetObjectTagsRequestValidationError{}
+
+// Validate checks the field values on GetObjectTagsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetObjectTagsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetObjectTagsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetObjectTagsResponseMultiError, or nil if none found.
+func (m *GetObjectTagsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetObjectTagsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Tags
+
+	if len(errors) > 0 {
+		return GetObjectTagsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetObjectTagsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetObjectTagsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetObjectTagsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetObjectTagsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetObjectTagsResponseMultiError) AllErrors() []error { return m }
+
+// GetObjectTagsResponseValidationError is the validation error returned by
+// GetObjectTagsResponse.Validate if the designated constraints aren't met.
+type GetObjectTagsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetObjectTagsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetObjectTagsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetObjectTagsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetObjectTagsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetObjectTagsResponseValidationError) ErrorName() string {
+	return "GetObjectTagsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetObjectTagsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetObjectTagsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetObjectTagsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetObjectTagsResponseValidationError{}
+
+// Validate checks the field values on SetObjectACLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetObjectACLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetObjectACLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetObjectACLRequestMultiError, or nil if none found.
+func (m *SetObjectACLRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetObjectACLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	if utf8.RuneCountInString(m.GetObjectKey()) < 1 {
+		err := SetObjectACLRequestValidationError{
+			field:  "ObjectKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _SetObjectACLRequest_Acl_NotInLookup[m.GetAcl()]; ok {
+		err := SetObjectACLRequestValidationError{
+			field:  "Acl",
+			reason: "value must not be in list [OBJECT_ACL_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ObjectACL_name[int32(m.GetAcl())]; !ok {
+		err := SetObjectACLRequestValidationError{
+			field:  "Acl",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetObjectACLRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetObjectACLRequestMultiError is an error wrapping multiple validation
+// errors returned by SetObjectACLRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetObjectACLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetObjectACLRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetObjectACLRequestMultiError) AllErrors() []error { return m }
+
+// SetObjectACLRequestValidationError is the validation error returned by
+// SetObjectACLRequest.Validate if the designated constraints aren't met.
+type SetObjectACLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetObjectACLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetObjectACLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetObjectACLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetObjectACLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetObjectACLRequestValidationError) ErrorName() string {
+	return "SetObjectACLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetObjectACLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetObjectACLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetObjectACLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetObjectACLRequestValidationError{}
+
+var _SetObjectACLRequest_Acl_NotInLookup = map[ObjectACL]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on SetObjectACLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetObjectACLResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetObjectACLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetObjectACLResponseMultiError, or nil if none found.
+func (m *SetObjectACLResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetObjectACLResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return SetObjectACLResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetObjectACLResponseMultiError is an error wrapping multiple validation
+// errors returned by SetObjectACLResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetObjectACLResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetObjectACLResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetObjectACLResponseMultiError) AllErrors() []error { return m }
+
+// SetObjectACLResponseValidationError is the validation error returned by
+// SetObjectACLResponse.Validate if the designated constraints aren't met.
+type SetObjectACLResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetObjectACLResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetObjectACLResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetObjectACLResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetObjectACLResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetObjectACLResponseValidationError) ErrorName() string {
+	return "SetObjectACLResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetObjectACLResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetObjectACLResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetObjectACLResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetObjectACLResponseValidationError{}
+
+// Validate checks the field values on GetObjectACLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetObjectACLRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetObjectACLRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetObjectACLRequestMultiError, or nil if none found.
+func (m *GetObjectACLRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetObjectACLRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	if utf8.RuneCountInString(m.GetObjectKey()) < 1 {
+		err := GetObjectACLRequestValidationError{
+			field:  "ObjectKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetObjectACLRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetObjectACLRequestMultiError is an error wrapping multiple validation
+// errors returned by GetObjectACLRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetObjectACLRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetObjectACLRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetObjectACLRequestMultiError) AllErrors() []error { return m }
+
+// GetObjectACLRequestValidationError is the validation error returned by
+// GetObjectACLRequest.Validate if the designated constraints aren't met.
+type GetObjectACLRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetObjectACLRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetObjectACLRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetObjectACLRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetObjectACLRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetObjectACLRequestValidationError) ErrorName() string {
+	return "GetObjectACLRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetObjectACLRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetObjectACLRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetObjectACLRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetObjectACLRequestValidationError{}
+
+// Validate checks the field values on GetObjectACLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetObjectACLResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetObjectACLResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetObjectACLResponseMultiError, or nil if none found.
+func (m *GetObjectACLResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetObjectACLResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Acl
+
+	if len(errors) > 0 {
+		return GetObjectACLResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetObjectACLResponseMultiError is an error wrapping multiple validation
+// errors returned by GetObjectACLResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetObjectACLResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetObjectACLResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetObjectACLResponseMultiError) AllErrors() []error { return m }
+
+// GetObjectACLResponseValidationError is the validation error returned by
+// GetObjectACLResponse.Validate if the designated constraints aren't met.
+type GetObjectACLResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetObjectACLResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetObjectACLResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetObjectACLResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetObjectACLResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetObjectACLResponseValidationError) ErrorName() string {
+	return "GetObjectACLResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetObjectACLResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetObjectACLResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetObjectACLResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetObjectACLResponseValidationError{}
+
+// Validate checks the field values on SetObjectRetentionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetObjectRetentionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetObjectRetentionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetObjectRetentionRequestMultiError, or nil if none found.
+func (m *SetObjectRetentionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetObjectRetentionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	if utf8.RuneCountInString(m.GetObjectKey()) < 1 {
+		err := SetObjectRetentionRequestValidationError{
+			field:  "ObjectKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for VersionId
+
+	if _, ok := _SetObjectRetentionRequest_Mode_NotInLookup[m.GetMode()]; ok {
+		err := SetObjectRetentionRequestValidationError{
+			field:  "Mode",
+			reason: "value must not be in list [RETENTION_MODE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := RetentionMode_name[int32(m.GetMode())]; !ok {
+		err := SetObjectRetentionRequestValidationError{
+			field:  "Mode",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRetainUntil() == nil {
+		err := SetObjectRetentionRequestValidationError{
+			field:  "RetainUntil",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for BypassGovernance
+
+	if len(errors) > 0 {
+		return SetObjectRetentionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetObjectRetentionRequestMultiError is an error wrapping multiple validation
+// errors returned by SetObjectRetentionRequest.ValidateAll() if the
+// designated constraints aren't met.
+type SetObjectRetentionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetObjectRetentionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetObjectRetentionRequestMultiError) AllErrors() []error { return m }
+
+// SetObjectRetentionRequestValidationError is the validation error returned by
+// SetObjectRetentionRequest.Validate if the designated constraints aren't met.
+type SetObjectRetentionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetObjectRetentionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetObjectRetentionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetObjectRetentionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetObjectRetentionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetObjectRetentionRequestValidationError) ErrorName() string {
+	return "SetObjectRetentionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetObjectRetentionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetObjectRetentionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetObjectRetentionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetObjectRetentionRequestValidationError{}
+
+var _SetObjectRetentionRequest_Mode_NotInLookup = map[RetentionMode]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on SetObjectRetentionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetObjectRetentionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetObjectRetentionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetObjectRetentionResponseMultiError, or nil if none found.
+func (m *SetObjectRetentionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetObjectRetentionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return SetObjectRetentionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetObjectRetentionResponseMultiError is an error wrapping multiple
+// validation errors returned by SetObjectRetentionResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SetObjectRetentionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetObjectRetentionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetObjectRetentionResponseMultiError) AllErrors() []error { return m }
+
+// SetObjectRetentionResponseValidationError is the validation error returned
+// by SetObjectRetentionResponse.Validate if the designated constraints aren't met.
+type SetObjectRetentionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetObjectRetentionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetObjectRetentionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetObjectRetentionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetObjectRetentionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetObjectRetentionResponseValidationError) ErrorName() string {
+	return "SetObjectRetentionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetObjectRetentionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetObjectRetentionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetObjectRetentionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetObjectRetentionResponseValidationError{}
+
+// Validate checks the field values on SetObjectLegalHoldRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetObjectLegalHoldRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetObjectLegalHoldRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetObjectLegalHoldRequestMultiError, or nil if none found.
+func (m *SetObjectLegalHoldRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetObjectLegalHoldRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	if utf8.RuneCountInString(m.GetObjectKey()) < 1 {
+		err := SetObjectLegalHoldRequestValidationError{
+			field:  "ObjectKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for VersionId
+
+	// no validation rules for Enabled
+
+	if len(errors) > 0 {
+		return SetObjectLegalHoldRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetObjectLegalHoldRequestMultiError is an error wrapping multiple validation
+// errors returned by SetObjectLegalHoldRequest.ValidateAll() if the
+// designated constraints aren't met.
+type SetObjectLegalHoldRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetObjectLegalHoldRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetObjectLegalHoldRequestMultiError) AllErrors() []error { return m }
+
+// SetObjectLegalHoldRequestValidationError is the validation error returned by
+// SetObjectLegalHoldRequest.Validate if the designated constraints aren't met.
+type SetObjectLegalHoldRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetObjectLegalHoldRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetObjectLegalHoldRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetObjectLegalHoldRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetObjectLegalHoldRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetObjectLegalHoldRequestValidationError) ErrorName() string {
+	return "SetObjectLegalHoldRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetObjectLegalHoldRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetObjectLegalHoldRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetObjectLegalHoldRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetObjectLegalHoldRequestValidationError{}
+
+// Validate checks the field values on SetObjectLegalHoldResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetObjectLegalHoldResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetObjectLegalHoldResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetObjectLegalHoldResponseMultiError, or nil if none found.
+func (m *SetObjectLegalHoldResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetObjectLegalHoldResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return SetObjectLegalHoldResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetObjectLegalHoldResponseMultiError is an error wrapping multiple
+// validation errors returned by SetObjectLegalHoldResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SetObjectLegalHoldResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetObjectLegalHoldResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetObjectLegalHoldResponseMultiError) AllErrors() []error { return m }
+
+// SetObjectLegalHoldResponseValidationError is the validation error returned
+// by SetObjectLegalHoldResponse.Validate if the designated constraints aren't met.
+type SetObjectLegalHoldResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetObjectLegalHoldResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetObjectLegalHoldResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetObjectLegalHoldResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetObjectLegalHoldResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetObjectLegalHoldResponseValidationError) ErrorName() string {
+	return "SetObjectLegalHoldResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetObjectLegalHoldResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetObjectLegalHoldResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetObjectLegalHoldResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetObjectLegalHoldResponseValidationError{}
+
+// Validate checks the field values on GetObjectMetadataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetObjectMetadataRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetObjectMetadataRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetObjectMetadataRequestMultiError, or nil if none found.
+func (m *GetObjectMetadataRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetObjectMetadataRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	if utf8.RuneCountInString(m.GetObjectKey()) < 1 {
+		err := GetObjectMetadataRequestValidationError{
+			field:  "ObjectKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetObjectMetadataRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetObjectMetadataRequestMultiError is an error wrapping multiple validation
+// errors returned by GetObjectMetadataRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetObjectMetadataRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetObjectMetadataRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetObjectMetadataRequestMultiError) AllErrors() []error { return m }
+
+// GetObjectMetadataRequestValidationError is the validation error returned by
+// GetObjectMetadataRequest.Validate if the designated constraints aren't met.
+type GetObjectMetadataRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetObjectMetadataRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetObjectMetadataRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetObjectMetadataRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetObjectMetadataRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetObjectMetadataRequestValidationError) ErrorName() string {
+	return "GetObjectMetadataRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetObjectMetadataRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetObjectMetadataRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetObjectMetadataRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetObjectMetadataRequestValidationError{}
+
+// Validate checks the field values on GetObjectMetadataResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetObjectMetadataResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetObjectMetadataResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetObjectMetadataResponseMultiError, or nil if none found.
+func (m *GetObjectMetadataResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetObjectMetadataResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ObjectKey
+
+	// no validation rules for Size
+
+	// no validation rules for ContentType
+
+	// no validation rules for Etag
+
+	if all {
+		switch v := interface{}(m.GetLastModified()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetObjectMetadataResponseValidationError{
+					field:  "LastModified",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetObjectMetadataResponseValidationError{
+					field:  "LastModified",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastModified()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetObjectMetadataResponseValidationError{
+				field:  "LastModified",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CacheControl
+
+	// no validation rules for Metadata
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetObjectMetadataResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetObjectMetadataResponseValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetObjectMetadataResponseValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetObjectMetadataResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetObjectMetadataResponseMultiError is an error wrapping multiple validation
+// errors returned by GetObjectMetadataResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetObjectMetadataResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetObjectMetadataResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetObjectMetadataResponseMultiError) AllErrors() []error { return m }
+
+// GetObjectMetadataResponseValidationError is the validation error returned by
+// GetObjectMetadataResponse.Validate if the designated constraints aren't met.
+type GetObjectMetadataResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetObjectMetadataResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetObjectMetadataResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetObjectMetadataResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetObjectMetadataResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetObjectMetadataResponseValidationError) ErrorName() string {
+	return "GetObjectMetadataResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetObjectMetadataResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetObjectMetadataResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetObjectMetadataResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetObjectMetadataResponseValidationError{}
+
+// Validate checks the field values on BatchObjectExistsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchObjectExistsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchObjectExistsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchObjectExistsRequestMultiError, or nil if none found.
+func (m *BatchObjectExistsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchObjectExistsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	if len(m.GetObjectKeys()) < 1 {
+		err := BatchObjectExistsRequestValidationError{
+			field:  "ObjectKeys",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BatchObjectExistsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchObjectExistsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchObjectExistsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchObjectExistsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchObjectExistsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchObjectExistsRequestMultiError) AllErrors() []error { return m }
+
+// BatchObjectExistsRequestValidationError is the validation error returned by
+// BatchObjectExistsRequest.Validate if the designated constraints aren't met.
+type BatchObjectExistsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchObjectExistsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchObjectExistsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchObjectExistsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchObjectExistsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchObjectExistsRequestValidationError) ErrorName() string {
+	return "BatchObjectExistsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchObjectExistsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchObjectExistsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchObjectExistsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchObjectExistsRequestValidationError{}
+
+// Validate checks the field values on BatchObjectExistsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchObjectExistsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchObjectExistsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchObjectExistsResponseMultiError, or nil if none found.
+func (m *BatchObjectExistsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchObjectExistsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchObjectExistsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchObjectExistsResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchObjectExistsResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchObjectExistsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchObjectExistsResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchObjectExistsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type BatchObjectExistsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchObjectExistsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchObjectExistsResponseMultiError) AllErrors() []error { return m }
+
+// BatchObjectExistsResponseValidationError is the validation error returned by
+// BatchObjectExistsResponse.Validate if the designated constraints aren't met.
+type BatchObjectExistsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchObjectExistsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchObjectExistsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchObjectExistsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchObjectExistsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchObjectExistsResponseValidationError) ErrorName() string {
+	return "BatchObjectExistsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchObjectExistsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchObjectExistsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchObjectExistsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchObjectExistsResponseValidationError{}
+
+// Validate checks the field values on ObjectExistsResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ObjectExistsResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ObjectExistsResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ObjectExistsResultMultiError, or nil if none found.
+func (m *ObjectExistsResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ObjectExistsResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ObjectKey
+
+	// no validation rules for Exists
+
+	// no validation rules for ErrorCode
+
+	// no validation rules for ErrorMessage
+
+	if len(errors) > 0 {
+		return ObjectExistsResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// ObjectExistsResultMultiError is an error wrapping multiple validation errors
+// returned by ObjectExistsResult.ValidateAll() if the designated constraints
+// aren't met.
+type ObjectExistsResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ObjectExistsResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ObjectExistsResultMultiError) AllErrors() []error { return m }
+
+// ObjectExistsResultValidationError is the validation error returned by
+// ObjectExistsResult.Validate if the designated constraints aren't met.
+type ObjectExistsResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ObjectExistsResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ObjectExistsResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ObjectExistsResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ObjectExistsResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ObjectExistsResultValidationError) ErrorName() string {
+	return "ObjectExistsResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ObjectExistsResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sObjectExistsResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ObjectExistsResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ObjectExistsResultValidationError{}
+
+// Validate checks the field values on SetBucketVersioningRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetBucketVersioningRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetBucketVersioningRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetBucketVersioningRequestMultiError, or nil if none found.
+func (m *SetBucketVersioningRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetBucketVersioningRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBucketName()) < 1 {
+		err := SetBucketVersioningRequestValidationError{
+			field:  "BucketName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Enabled
+
+	if len(errors) > 0 {
+		return SetBucketVersioningRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetBucketVersioningRequestMultiError is an error wrapping multiple
+// validation errors returned by SetBucketVersioningRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SetBucketVersioningRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetBucketVersioningRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetBucketVersioningRequestMultiError) AllErrors() []error { return m }
+
+// SetBucketVersioningRequestValidationError is the validation error returned
+// by SetBucketVersioningRequest.Validate if the designated constraints aren't met.
+type SetBucketVersioningRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetBucketVersioningRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetBucketVersioningRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetBucketVersioningRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetBucketVersioningRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetBucketVersioningRequestValidationError) ErrorName() string {
+	return "SetBucketVersioningRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetBucketVersioningRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetBucketVersioningRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetBucketVersioningRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetBucketVersioningRequestValidationError{}
+
+// Validate checks the field values on SetBucketVersioningResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetBucketVersioningResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetBucketVersioningResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetBucketVersioningResponseMultiError, or nil if none found.
+func (m *SetBucketVersioningResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetBucketVersioningResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return SetBucketVersioningResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetBucketVersioningResponseMultiError is an error wrapping multiple
+// validation errors returned by SetBucketVersioningResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SetBucketVersioningResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetBucketVersioningResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetBucketVersioningResponseMultiError) AllErrors() []error { return m }
+
+// SetBucketVersioningResponseValidationError is the validation error returned
+// by SetBucketVersioningResponse.Validate if the designated constraints
+// aren't met.
+type SetBucketVersioningResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetBucketVersioningResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetBucketVersioningResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetBucketVersioningResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetBucketVersioningResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetBucketVersioningResponseValidationError) ErrorName() string {
+	return "SetBucketVersioningResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetBucketVersioningResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetBucketVersioningResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetBucketVersioningResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetBucketVersioningResponseValidationError{}
+
+// Validate checks the field values on SetBucketLifecycleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetBucketLifecycleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetBucketLifecycleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetBucketLifecycleRequestMultiError, or nil if none found.
+func (m *SetBucketLifecycleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetBucketLifecycleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBucketName()) < 1 {
+		err := SetBucketLifecycleRequestValidationError{
+			field:  "BucketName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetPrefix()); l < 1 || l > 200 {
+		err := SetBucketLifecycleRequestValidationError{
+			field:  "Prefix",
+			reason: "value length must be between 1 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetExpirationDays() <= 0 {
+		err := SetBucketLifecycleRequestValidationError{
+			field:  "ExpirationDays",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetBucketLifecycleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetBucketLifecycleRequestMultiError is an error wrapping multiple validation
+// errors returned by SetBucketLifecycleRequest.ValidateAll() if the
+// designated constraints aren't met.
+type SetBucketLifecycleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetBucketLifecycleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetBucketLifecycleRequestMultiError) AllErrors() []error { return m }
+
+// SetBucketLifecycleRequestValidationError is the validation error returned by
+// SetBucketLifecycleRequest.Validate if the designated constraints aren't met.
+type SetBucketLifecycleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetBucketLifecycleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetBucketLifecycleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetBucketLifecycleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetBucketLifecycleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetBucketLifecycleRequestValidationError) ErrorName() string {
+	return "SetBucketLifecycleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetBucketLifecycleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetBucketLifecycleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetBucketLifecycleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetBucketLifecycleRequestValidationError{}
+
+// Validate checks the field values on SetBucketLifecycleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetBucketLifecycleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetBucketLifecycleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetBucketLifecycleResponseMultiError, or nil if none found.
+func (m *SetBucketLifecycleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetBucketLifecycleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Success
+
+	if len(errors) > 0 {
+		return SetBucketLifecycleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetBucketLifecycleResponseMultiError is an error wrapping multiple
+// validation errors returned by SetBucketLifecycleResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SetBucketLifecycleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetBucketLifecycleResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetBucketLifecycleResponseMultiError) AllErrors() []error { return m }
+
+// SetBucketLifecycleResponseValidationError is the validation error returned
+// by SetBucketLifecycleResponse.Validate if the designated constraints aren't met.
+type SetBucketLifecycleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetBucketLifecycleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetBucketLifecycleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetBucketLifecycleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetBucketLifecycleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetBucketLifecycleResponseValidationError) ErrorName() string {
+	return "SetBucketLifecycleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetBucketLifecycleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetBucketLifecycleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetBucketLifecycleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetBucketLifecycleResponseValidationError{}
+
+// Validate checks the field values on GetBucketStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBucketStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBucketStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBucketStatsRequestMultiError, or nil if none found.
+func (m *GetBucketStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBucketStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBucketName()) < 1 {
+		err := GetBucketStatsRequestValidationError{
+			field:  "BucketName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Prefix
+
+	if m.GetMaxObjects() < 0 {
+		err := GetBucketStatsRequestValidationError{
+			field:  "MaxObjects",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetBucketStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBucketStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetBucketStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetBucketStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBucketStatsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBucketStatsRequestMultiError) AllErrors() []error { return m }
+
+// GetBucketStatsRequestValidationError is the validation error returned by
+// GetBucketStatsRequest.Validate if the designated constraints aren't met.
+type GetBucketStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBucketStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBucketStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBucketStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBucketStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBucketStatsRequestValidationError) ErrorName() string {
+	return "GetBucketStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBucketStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBucketStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBucketStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBucketStatsRequestValidationError{}
+
+// Validate checks the field values on GetBucketStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetBucketStatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetBucketStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetBucketStatsResponseMultiError, or nil if none found.
+func (m *GetBucketStatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetBucketStatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ObjectCount
+
+	// no validation rules for TotalBytes
+
+	// no validation rules for Truncated
+
+	if len(errors) > 0 {
+		return GetBucketStatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBucketStatsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetBucketStatsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetBucketStatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBucketStatsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBucketStatsResponseMultiError) AllErrors() []error { return m }
+
+// GetBucketStatsResponseValidationError is the validation error returned by
+// GetBucketStatsResponse.Validate if the designated constraints aren't met.
+type GetBucketStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBucketStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBucketStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBucketStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBucketStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBucketStatsResponseValidationError) ErrorName() string {
+	return "GetBucketStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBucketStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBucketStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBucketStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBucketStatsResponseValidationError{}
+
+// Validate checks the field values on FindObjectsByTagRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FindObjectsByTagRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FindObjectsByTagRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FindObjectsByTagRequestMultiError, or nil if none found.
+func (m *FindObjectsByTagRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FindObjectsByTagRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	// no validation rules for Prefix
+
+	if len(m.GetTags()) < 1 {
+		err := FindObjectsByTagRequestValidationError{
+			field:  "Tags",
+			reason: "value must contain at least 1 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxResults() < 0 {
+		err := FindObjectsByTagRequestValidationError{
+			field:  "MaxResults",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FindObjectsByTagRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FindObjectsByTagRequestMultiError is an error wrapping multiple validation
+// errors returned by FindObjectsByTagRequest.ValidateAll() if the designated
+// constraints aren't met.
+type FindObjectsByTagRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FindObjectsByTagRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FindObjectsByTagRequestMultiError) AllErrors() []error { return m }
+
+// FindObjectsByTagRequestValidationError is the validation error returned by
+// FindObjectsByTagRequest.Validate if the designated constraints aren't met.
+type FindObjectsByTagRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FindObjectsByTagRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FindObjectsByTagRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FindObjectsByTagRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FindObjectsByTagRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FindObjectsByTagRequestValidationError) ErrorName() string {
+	return "FindObjectsByTagRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FindObjectsByTagRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindObjectsByTagRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FindObjectsByTagRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FindObjectsByTagRequestValidationError{}
+
+// Validate checks the field values on FindObjectsByTagResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FindObjectsByTagResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FindObjectsByTagResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FindObjectsByTagResponseMultiError, or nil if none found.
+func (m *FindObjectsByTagResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FindObjectsByTagResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetObjects() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FindObjectsByTagResponseValidationError{
+						field:  fmt.Sprintf("Objects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FindObjectsByTagResponseValidationError{
+						field:  fmt.Sprintf("Objects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FindObjectsByTagResponseValidationError{
+					field:  fmt.Sprintf("Objects[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Truncated
+
+	// no validation rules for ScannedObjects
+
+	if len(errors) > 0 {
+		return FindObjectsByTagResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FindObjectsByTagResponseMultiError is an error wrapping multiple validation
+// errors returned by FindObjectsByTagResponse.ValidateAll() if the designated
+// constraints aren't met.
+type FindObjectsByTagResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FindObjectsByTagResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FindObjectsByTagResponseMultiError) AllErrors() []error { return m }
+
+// FindObjectsByTagResponseValidationError is the validation error returned by
+// FindObjectsByTagResponse.Validate if the designated constraints aren't met.
+type FindObjectsByTagResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FindObjectsByTagResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FindObjectsByTagResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FindObjectsByTagResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FindObjectsByTagResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FindObjectsByTagResponseValidationError) ErrorName() string {
+	return "FindObjectsByTagResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FindObjectsByTagResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFindObjectsByTagResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FindObjectsByTagResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FindObjectsByTagResponseValidationError{}
+
+// Validate checks the field values on TaggedObject with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TaggedObject) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaggedObject with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TaggedObjectMultiError, or
+// nil if none found.
+func (m *TaggedObject) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaggedObject) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ObjectKey
+
+	// no validation rules for Tags
+
+	if len(errors) > 0 {
+		return TaggedObjectMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaggedObjectMultiError is an error wrapping multiple validation errors
+// returned by TaggedObject.ValidateAll() if the designated constraints aren't met.
+type TaggedObjectMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaggedObjectMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaggedObjectMultiError) AllErrors() []error { return m }
+
+// TaggedObjectValidationError is the validation error returned by
+// TaggedObject.Validate if the designated constraints aren't met.
+type TaggedObjectValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaggedObjectValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaggedObjectValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaggedObjectValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaggedObjectValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaggedObjectValidationError) ErrorName() string { return "TaggedObjectValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TaggedObjectValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaggedObject.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaggedObjectValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaggedObjectValidationError{}
+
+// Validate checks the field values on ListObjectVersionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListObjectVersionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListObjectVersionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListObjectVersionsRequestMultiError, or nil if none found.
+func (m *ListObjectVersionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListObjectVersionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	if utf8.RuneCountInString(m.GetObjectKey()) < 1 {
+		err := ListObjectVersionsRequestValidationError{
+			field:  "ObjectKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListObjectVersionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListObjectVersionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListObjectVersionsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListObjectVersionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListObjectVersionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListObjectVersionsRequestMultiError) AllErrors() []error { return m }
+
+// ListObjectVersionsRequestValidationError is the validation error returned by
+// ListObjectVersionsRequest.Validate if the designated constraints aren't met.
+type ListObjectVersionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListObjectVersionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListObjectVersionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListObjectVersionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListObjectVersionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListObjectVersionsRequestValidationError) ErrorName() string {
+	return "ListObjectVersionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListObjectVersionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListObjectVersionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListObjectVersionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListObjectVersionsRequestValidationError{}
+
+// Validate checks the field values on ObjectVersion with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ObjectVersion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ObjectVersion with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ObjectVersionMultiError, or
+// nil if none found.
+func (m *ObjectVersion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ObjectVersion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for VersionId
+
+	// no validation rules for IsLatest
+
+	// no validation rules for IsDeleteMarker
+
+	// no validation rules for Size
+
+	// no validation rules for Etag
+
+	if all {
+		switch v := interface{}(m.GetLastModified()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ObjectVersionValidationError{
+					field:  "LastModified",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ObjectVersionValidationError{
+					field:  "LastModified",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastModified()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ObjectVersionValidationError{
+				field:  "LastModified",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ObjectVersionMultiError(errors)
+	}
+
+	return nil
+}
+
+// ObjectVersionMultiError is an error wrapping multiple validation errors
+// returned by ObjectVersion.ValidateAll() if the designated constraints
+// aren't met.
+type ObjectVersionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ObjectVersionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ObjectVersionMultiError) AllErrors() []error { return m }
+
+// ObjectVersionValidationError is the validation error returned by
+// ObjectVersion.Validate if the designated constraints aren't met.
+type ObjectVersionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ObjectVersionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ObjectVersionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ObjectVersionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ObjectVersionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ObjectVersionValidationError) ErrorName() string { return "ObjectVersionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ObjectVersionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sObjectVersion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ObjectVersionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ObjectVersionValidationError{}
+
+// Validate checks the field values on ListObjectVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListObjectVersionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListObjectVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListObjectVersionsResponseMultiError, or nil if none found.
+func (m *ListObjectVersionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListObjectVersionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetVersions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListObjectVersionsResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListObjectVersionsResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListObjectVersionsResponseValidationError{
+					field:  fmt.Sprintf("Versions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListObjectVersionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListObjectVersionsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListObjectVersionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListObjectVersionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListObjectVersionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListObjectVersionsResponseMultiError) AllErrors() []error { return m }
+
+// ListObjectVersionsResponseValidationError is the validation error returned
+// by ListObjectVersionsResponse.Validate if the designated constraints aren't met.
+type ListObjectVersionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListObjectVersionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListObjectVersionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListObjectVersionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListObjectVersionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListObjectVersionsResponseValidationError) ErrorName() string {
+	return "ListObjectVersionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListObjectVersionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListObjectVersionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListObjectVersionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListObjectVersionsResponseValidationError{}
+
+// Validate checks the field values on ListUploadedPartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUploadedPartsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUploadedPartsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUploadedPartsRequestMultiError, or nil if none found.
+func (m *ListUploadedPartsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUploadedPartsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	if utf8.RuneCountInString(m.GetObjectKey()) < 1 {
+		err := ListUploadedPartsRequestValidationError{
+			field:  "ObjectKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUploadId()) < 1 {
+		err := ListUploadedPartsRequestValidationError{
+			field:  "UploadId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListUploadedPartsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUploadedPartsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListUploadedPartsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListUploadedPartsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUploadedPartsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUploadedPartsRequestMultiError) AllErrors() []error { return m }
+
+// ListUploadedPartsRequestValidationError is the validation error returned by
+// ListUploadedPartsRequest.Validate if the designated constraints aren't met.
+type ListUploadedPartsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUploadedPartsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUploadedPartsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUploadedPartsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUploadedPartsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUploadedPartsRequestValidationError) ErrorName() string {
+	return "ListUploadedPartsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUploadedPartsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUploadedPartsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUploadedPartsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUploadedPartsRequestValidationError{}
+
+// Validate checks the field values on UploadedPart with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UploadedPart) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UploadedPart with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UploadedPartMultiError, or
+// nil if none found.
+func (m *UploadedPart) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UploadedPart) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PartNumber
+
+	// no validation rules for Size
+
+	// no validation rules for Etag
+
+	if all {
+		switch v := interface{}(m.GetLastModified()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadedPartValidationError{
+					field:  "LastModified",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadedPartValidationError{
+					field:  "LastModified",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastModified()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadedPartValidationError{
+				field:  "LastModified",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UploadedPartMultiError(errors)
+	}
+
+	return nil
+}
+
+// UploadedPartMultiError is an error wrapping multiple validation errors
+// returned by UploadedPart.ValidateAll() if the designated constraints aren't met.
+type UploadedPartMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UploadedPartMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UploadedPartMultiError) AllErrors() []error { return m }
+
+// UploadedPartValidationError is the validation error returned by
+// UploadedPart.Validate if the designated constraints aren't met.
+type UploadedPartValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UploadedPartValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UploadedPartValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UploadedPartValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UploadedPartValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UploadedPartValidationError) ErrorName() string { return "UploadedPartValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UploadedPartValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUploadedPart.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UploadedPartValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UploadedPartValidationError{}
+
+// Validate checks the field values on ListUploadedPartsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUploadedPartsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUploadedPartsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUploadedPartsResponseMultiError, or nil if none found.
+func (m *ListUploadedPartsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUploadedPartsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetParts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUploadedPartsResponseValidationError{
+						field:  fmt.Sprintf("Parts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUploadedPartsResponseValidationError{
+						field:  fmt.Sprintf("Parts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListUploadedPartsResponseValidationError{
+					field:  fmt.Sprintf("Parts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListUploadedPartsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUploadedPartsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListUploadedPartsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListUploadedPartsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUploadedPartsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUploadedPartsResponseMultiError) AllErrors() []error { return m }
+
+// ListUploadedPartsResponseValidationError is the validation error returned by
+// ListUploadedPartsResponse.Validate if the designated constraints aren't met.
+type ListUploadedPartsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUploadedPartsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUploadedPartsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUploadedPartsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUploadedPartsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUploadedPartsResponseValidationError) ErrorName() string {
+	return "ListUploadedPartsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUploadedPartsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUploadedPartsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUploadedPartsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUploadedPartsResponseValidationError{}
+
+// Validate checks the field values on ConvertImageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConvertImageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConvertImageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConvertImageRequestMultiError, or nil if none found.
+func (m *ConvertImageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConvertImageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	if utf8.RuneCountInString(m.GetObjectKey()) < 1 {
+		err := ConvertImageRequestValidationError{
+			field:  "ObjectKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ConvertImageRequest_TargetFormat_InLookup[m.GetTargetFormat()]; !ok {
+		err := ConvertImageRequestValidationError{
+			field:  "TargetFormat",
+			reason: "value must be in list [jpeg jpg png webp]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetQuality(); val < 0 || val > 100 {
+		err := ConvertImageRequestValidationError{
+			field:  "Quality",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DestinationKey
+
+	if len(errors) > 0 {
+		return ConvertImageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConvertImageRequestMultiError is an error wrapping multiple validation
+// errors returned by ConvertImageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ConvertImageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConvertImageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConvertImageRequestMultiError) AllErrors() []error { return m }
+
+// ConvertImageRequestValidationError is the validation error returned by
+// ConvertImageRequest.Validate if the designated constraints aren't met.
+type ConvertImageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConvertImageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConvertImageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConvertImageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConvertImageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConvertImageRequestValidationError) ErrorName() string {
+	return "ConvertImageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConvertImageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConvertImageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConvertImageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConvertImageRequestValidationError{}
+
+var _ConvertImageRequest_TargetFormat_InLookup = map[string]struct{}{
+	"jpeg": {},
+	"jpg":  {},
+	"png":  {},
+	"webp": {},
+}
+
+// Validate checks the field values on ConvertImageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConvertImageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConvertImageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConvertImageResponseMultiError, or nil if none found.
+func (m *ConvertImageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConvertImageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ObjectKey
+
+	// no validation rules for ContentType
+
+	// no validation rules for Size
+
+	if len(errors) > 0 {
+		return ConvertImageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConvertImageResponseMultiError is an error wrapping multiple validation
+// errors returned by ConvertImageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ConvertImageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConvertImageResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConvertImageResponseMultiError) AllErrors() []error { return m }
+
+// ConvertImageResponseValidationError is the validation error returned by
+// ConvertImageResponse.Validate if the designated constraints aren't met.
+type ConvertImageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConvertImageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConvertImageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConvertImageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConvertImageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConvertImageResponseValidationError) ErrorName() string {
+	return "ConvertImageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConvertImageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConvertImageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConvertImageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConvertImageResponseValidationError{}
+
+// Validate checks the field values on SanitizeImageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SanitizeImageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SanitizeImageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SanitizeImageRequestMultiError, or nil if none found.
+func (m *SanitizeImageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SanitizeImageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BucketName
+
+	if utf8.RuneCountInString(m.GetObjectKey()) < 1 {
+		err := SanitizeImageRequestValidationError{
+			field:  "ObjectKey",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SanitizeImageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SanitizeImageRequestMultiError is an error wrapping multiple validation
+// errors returned by SanitizeImageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SanitizeImageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SanitizeImageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SanitizeImageRequestMultiError) AllErrors() []error { return m }
+
+// SanitizeImageRequestValidationError is the validation error returned by
+// SanitizeImageRequest.Validate if the designated constraints aren't met.
+type SanitizeImageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SanitizeImageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SanitizeImageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SanitizeImageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SanitizeImageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SanitizeImageRequestValidationError) ErrorName() string {
+	return "SanitizeImageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SanitizeImageRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -133,14 +9379,14 @@ func (e CreateBucketRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateBucketRequest.%s: %s%s",
+		"invalid %sSanitizeImageRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateBucketRequestValidationError{}
+var _ error = SanitizeImageRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -148,46 +9394,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateBucketRequestValidationError{}
+} = SanitizeImageRequestValidationError{}
 
-// Validate checks the field values on CreateBucketResponse with the rules
+// Validate checks the field values on SanitizeImageResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateBucketResponse) Validate() error {
+func (m *SanitizeImageResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateBucketResponse with the rules
+// ValidateAll checks the field values on SanitizeImageResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateBucketResponseMultiError, or nil if none found.
-func (m *CreateBucketResponse) ValidateAll() error {
+// SanitizeImageResponseMultiError, or nil if none found.
+func (m *SanitizeImageResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateBucketResponse) validate(all bool) error {
+func (m *SanitizeImageResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	// no validation rules for ContentType
+
+	// no validation rules for OriginalSize
+
+	// no validation rules for Size
 
 	if len(errors) > 0 {
-		return CreateBucketResponseMultiError(errors)
+		return SanitizeImageResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateBucketResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateBucketResponse.ValidateAll() if the designated
+// SanitizeImageResponseMultiError is an error wrapping multiple validation
+// errors returned by SanitizeImageResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CreateBucketResponseMultiError []error
+type SanitizeImageResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateBucketResponseMultiError) Error() string {
+func (m SanitizeImageResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -196,11 +9446,11 @@ func (m CreateBucketResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateBucketResponseMultiError) AllErrors() []error { return m }
+func (m SanitizeImageResponseMultiError) AllErrors() []error { return m }
 
-// CreateBucketResponseValidationError is the validation error returned by
-// CreateBucketResponse.Validate if the designated constraints aren't met.
-type CreateBucketResponseValidationError struct {
+// SanitizeImageResponseValidationError is the validation error returned by
+// SanitizeImageResponse.Validate if the designated constraints aren't met.
+type SanitizeImageResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -208,24 +9458,24 @@ type CreateBucketResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateBucketResponseValidationError) Field() string { return e.field }
+func (e SanitizeImageResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateBucketResponseValidationError) Reason() string { return e.reason }
+func (e SanitizeImageResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateBucketResponseValidationError) Cause() error { return e.cause }
+func (e SanitizeImageResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateBucketResponseValidationError) Key() bool { return e.key }
+func (e SanitizeImageResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateBucketResponseValidationError) ErrorName() string {
-	return "CreateBucketResponseValidationError"
+func (e SanitizeImageResponseValidationError) ErrorName() string {
+	return "SanitizeImageResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateBucketResponseValidationError) Error() string {
+func (e SanitizeImageResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -237,14 +9487,14 @@ func (e CreateBucketResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateBucketResponse.%s: %s%s",
+		"invalid %sSanitizeImageResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateBucketResponseValidationError{}
+var _ error = SanitizeImageResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -252,33 +9502,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateBucketResponseValidationError{}
+} = SanitizeImageResponseValidationError{}
 
-// Validate checks the field values on PresignUploadRequest with the rules
+// Validate checks the field values on UpdateCredentialsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PresignUploadRequest) Validate() error {
+func (m *UpdateCredentialsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PresignUploadRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateCredentialsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PresignUploadRequestMultiError, or nil if none found.
-func (m *PresignUploadRequest) ValidateAll() error {
+// UpdateCredentialsRequestMultiError, or nil if none found.
+func (m *UpdateCredentialsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PresignUploadRequest) validate(all bool) error {
+func (m *UpdateCredentialsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetBucketName()) < 1 {
-		err := PresignUploadRequestValidationError{
-			field:  "BucketName",
+	if utf8.RuneCountInString(m.GetAccessKeyId()) < 1 {
+		err := UpdateCredentialsRequestValidationError{
+			field:  "AccessKeyId",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -287,9 +9537,9 @@ func (m *PresignUploadRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetContentType()) < 1 {
-		err := PresignUploadRequestValidationError{
-			field:  "ContentType",
+	if utf8.RuneCountInString(m.GetSecretAccessKey()) < 1 {
+		err := UpdateCredentialsRequestValidationError{
+			field:  "SecretAccessKey",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -298,35 +9548,20 @@ func (m *PresignUploadRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetMaxFileSize() <= 0 {
-		err := PresignUploadRequestValidationError{
-			field:  "MaxFileSize",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for Path
-
-	// no validation rules for FileName
-
 	if len(errors) > 0 {
-		return PresignUploadRequestMultiError(errors)
+		return UpdateCredentialsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PresignUploadRequestMultiError is an error wrapping multiple validation
-// errors returned by PresignUploadRequest.ValidateAll() if the designated
+// UpdateCredentialsRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateCredentialsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type PresignUploadRequestMultiError []error
+type UpdateCredentialsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PresignUploadRequestMultiError) Error() string {
+func (m UpdateCredentialsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -335,11 +9570,11 @@ func (m PresignUploadRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PresignUploadRequestMultiError) AllErrors() []error { return m }
+func (m UpdateCredentialsRequestMultiError) AllErrors() []error { return m }
 
-// PresignUploadRequestValidationError is the validation error returned by
-// PresignUploadRequest.Validate if the designated constraints aren't met.
-type PresignUploadRequestValidationError struct {
+// UpdateCredentialsRequestValidationError is the validation error returned by
+// UpdateCredentialsRequest.Validate if the designated constraints aren't met.
+type UpdateCredentialsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -347,24 +9582,24 @@ type PresignUploadRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PresignUploadRequestValidationError) Field() string { return e.field }
+func (e UpdateCredentialsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PresignUploadRequestValidationError) Reason() string { return e.reason }
+func (e UpdateCredentialsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PresignUploadRequestValidationError) Cause() error { return e.cause }
+func (e UpdateCredentialsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PresignUploadRequestValidationError) Key() bool { return e.key }
+func (e UpdateCredentialsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PresignUploadRequestValidationError) ErrorName() string {
-	return "PresignUploadRequestValidationError"
+func (e UpdateCredentialsRequestValidationError) ErrorName() string {
+	return "UpdateCredentialsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PresignUploadRequestValidationError) Error() string {
+func (e UpdateCredentialsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -376,14 +9611,14 @@ func (e PresignUploadRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPresignUploadRequest.%s: %s%s",
+		"invalid %sUpdateCredentialsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PresignUploadRequestValidationError{}
+var _ error = UpdateCredentialsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -391,52 +9626,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PresignUploadRequestValidationError{}
+} = UpdateCredentialsRequestValidationError{}
 
-// Validate checks the field values on PresignUploadResponse with the rules
+// Validate checks the field values on UpdateCredentialsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PresignUploadResponse) Validate() error {
+func (m *UpdateCredentialsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PresignUploadResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateCredentialsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PresignUploadResponseMultiError, or nil if none found.
-func (m *PresignUploadResponse) ValidateAll() error {
+// UpdateCredentialsResponseMultiError, or nil if none found.
+func (m *UpdateCredentialsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PresignUploadResponse) validate(all bool) error {
+func (m *UpdateCredentialsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PresignedUrl
-
-	// no validation rules for ObjectKey
-
-	// no validation rules for ExpiresIn
-
-	// no validation rules for FormData
+	// no validation rules for Success
 
 	if len(errors) > 0 {
-		return PresignUploadResponseMultiError(errors)
+		return UpdateCredentialsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PresignUploadResponseMultiError is an error wrapping multiple validation
-// errors returned by PresignUploadResponse.ValidateAll() if the designated
-// constraints aren't met.
-type PresignUploadResponseMultiError []error
+// UpdateCredentialsResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateCredentialsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateCredentialsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PresignUploadResponseMultiError) Error() string {
+func (m UpdateCredentialsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -445,11 +9674,11 @@ func (m PresignUploadResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PresignUploadResponseMultiError) AllErrors() []error { return m }
+func (m UpdateCredentialsResponseMultiError) AllErrors() []error { return m }
 
-// PresignUploadResponseValidationError is the validation error returned by
-// PresignUploadResponse.Validate if the designated constraints aren't met.
-type PresignUploadResponseValidationError struct {
+// UpdateCredentialsResponseValidationError is the validation error returned by
+// UpdateCredentialsResponse.Validate if the designated constraints aren't met.
+type UpdateCredentialsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -457,24 +9686,24 @@ type PresignUploadResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PresignUploadResponseValidationError) Field() string { return e.field }
+func (e UpdateCredentialsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PresignUploadResponseValidationError) Reason() string { return e.reason }
+func (e UpdateCredentialsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PresignUploadResponseValidationError) Cause() error { return e.cause }
+func (e UpdateCredentialsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PresignUploadResponseValidationError) Key() bool { return e.key }
+func (e UpdateCredentialsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PresignUploadResponseValidationError) ErrorName() string {
-	return "PresignUploadResponseValidationError"
+func (e UpdateCredentialsResponseValidationError) ErrorName() string {
+	return "UpdateCredentialsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PresignUploadResponseValidationError) Error() string {
+func (e UpdateCredentialsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -486,14 +9715,14 @@ func (e PresignUploadResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPresignUploadResponse.%s: %s%s",
+		"invalid %sUpdateCredentialsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PresignUploadResponseValidationError{}
+var _ error = UpdateCredentialsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -501,33 +9730,35 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PresignUploadResponseValidationError{}
+} = UpdateCredentialsResponseValidationError{}
 
-// Validate checks the field values on PresignDownloadRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateOneTimeDownloadRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PresignDownloadRequest) Validate() error {
+func (m *CreateOneTimeDownloadRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PresignDownloadRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateOneTimeDownloadRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PresignDownloadRequestMultiError, or nil if none found.
-func (m *PresignDownloadRequest) ValidateAll() error {
+// CreateOneTimeDownloadRequestMultiError, or nil if none found.
+func (m *CreateOneTimeDownloadRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PresignDownloadRequest) validate(all bool) error {
+func (m *CreateOneTimeDownloadRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetBucketName()) < 1 {
-		err := PresignDownloadRequestValidationError{
-			field:  "BucketName",
+	// no validation rules for BucketName
+
+	if utf8.RuneCountInString(m.GetObjectKey()) < 1 {
+		err := CreateOneTimeDownloadRequestValidationError{
+			field:  "ObjectKey",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -536,10 +9767,12 @@ func (m *PresignDownloadRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetObjectKey()) < 1 {
-		err := PresignDownloadRequestValidationError{
-			field:  "ObjectKey",
-			reason: "value length must be at least 1 runes",
+	// no validation rules for VersionId
+
+	if m.GetExpiresIn() < 0 {
+		err := CreateOneTimeDownloadRequestValidationError{
+			field:  "ExpiresIn",
+			reason: "value must be greater than or equal to 0",
 		}
 		if !all {
 			return err
@@ -548,19 +9781,19 @@ func (m *PresignDownloadRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return PresignDownloadRequestMultiError(errors)
+		return CreateOneTimeDownloadRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PresignDownloadRequestMultiError is an error wrapping multiple validation
-// errors returned by PresignDownloadRequest.ValidateAll() if the designated
-// constraints aren't met.
-type PresignDownloadRequestMultiError []error
+// CreateOneTimeDownloadRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateOneTimeDownloadRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateOneTimeDownloadRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PresignDownloadRequestMultiError) Error() string {
+func (m CreateOneTimeDownloadRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -569,11 +9802,12 @@ func (m PresignDownloadRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PresignDownloadRequestMultiError) AllErrors() []error { return m }
+func (m CreateOneTimeDownloadRequestMultiError) AllErrors() []error { return m }
 
-// PresignDownloadRequestValidationError is the validation error returned by
-// PresignDownloadRequest.Validate if the designated constraints aren't met.
-type PresignDownloadRequestValidationError struct {
+// CreateOneTimeDownloadRequestValidationError is the validation error returned
+// by CreateOneTimeDownloadRequest.Validate if the designated constraints
+// aren't met.
+type CreateOneTimeDownloadRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -581,24 +9815,24 @@ type PresignDownloadRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PresignDownloadRequestValidationError) Field() string { return e.field }
+func (e CreateOneTimeDownloadRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PresignDownloadRequestValidationError) Reason() string { return e.reason }
+func (e CreateOneTimeDownloadRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PresignDownloadRequestValidationError) Cause() error { return e.cause }
+func (e CreateOneTimeDownloadRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PresignDownloadRequestValidationError) Key() bool { return e.key }
+func (e CreateOneTimeDownloadRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PresignDownloadRequestValidationError) ErrorName() string {
-	return "PresignDownloadRequestValidationError"
+func (e CreateOneTimeDownloadRequestValidationError) ErrorName() string {
+	return "CreateOneTimeDownloadRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PresignDownloadRequestValidationError) Error() string {
+func (e CreateOneTimeDownloadRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -610,14 +9844,14 @@ func (e PresignDownloadRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPresignDownloadRequest.%s: %s%s",
+		"invalid %sCreateOneTimeDownloadRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PresignDownloadRequestValidationError{}
+var _ error = CreateOneTimeDownloadRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -625,48 +9859,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PresignDownloadRequestValidationError{}
+} = CreateOneTimeDownloadRequestValidationError{}
 
-// Validate checks the field values on PresignDownloadResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CreateOneTimeDownloadResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PresignDownloadResponse) Validate() error {
+func (m *CreateOneTimeDownloadResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PresignDownloadResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// PresignDownloadResponseMultiError, or nil if none found.
-func (m *PresignDownloadResponse) ValidateAll() error {
+// ValidateAll checks the field values on CreateOneTimeDownloadResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateOneTimeDownloadResponseMultiError, or nil if none found.
+func (m *CreateOneTimeDownloadResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PresignDownloadResponse) validate(all bool) error {
+func (m *CreateOneTimeDownloadResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PresignedUrl
+	// no validation rules for Token
 
 	// no validation rules for ExpiresIn
 
 	if len(errors) > 0 {
-		return PresignDownloadResponseMultiError(errors)
+		return CreateOneTimeDownloadResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PresignDownloadResponseMultiError is an error wrapping multiple validation
-// errors returned by PresignDownloadResponse.ValidateAll() if the designated
-// constraints aren't met.
-type PresignDownloadResponseMultiError []error
+// CreateOneTimeDownloadResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateOneTimeDownloadResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CreateOneTimeDownloadResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PresignDownloadResponseMultiError) Error() string {
+func (m CreateOneTimeDownloadResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -675,11 +9909,12 @@ func (m PresignDownloadResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PresignDownloadResponseMultiError) AllErrors() []error { return m }
+func (m CreateOneTimeDownloadResponseMultiError) AllErrors() []error { return m }
 
-// PresignDownloadResponseValidationError is the validation error returned by
-// PresignDownloadResponse.Validate if the designated constraints aren't met.
-type PresignDownloadResponseValidationError struct {
+// CreateOneTimeDownloadResponseValidationError is the validation error
+// returned by CreateOneTimeDownloadResponse.Validate if the designated
+// constraints aren't met.
+type CreateOneTimeDownloadResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -687,24 +9922,24 @@ type PresignDownloadResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PresignDownloadResponseValidationError) Field() string { return e.field }
+func (e CreateOneTimeDownloadResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PresignDownloadResponseValidationError) Reason() string { return e.reason }
+func (e CreateOneTimeDownloadResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PresignDownloadResponseValidationError) Cause() error { return e.cause }
+func (e CreateOneTimeDownloadResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PresignDownloadResponseValidationError) Key() bool { return e.key }
+func (e CreateOneTimeDownloadResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PresignDownloadResponseValidationError) ErrorName() string {
-	return "PresignDownloadResponseValidationError"
+func (e CreateOneTimeDownloadResponseValidationError) ErrorName() string {
+	return "CreateOneTimeDownloadResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PresignDownloadResponseValidationError) Error() string {
+func (e CreateOneTimeDownloadResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -716,14 +9951,14 @@ func (e PresignDownloadResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPresignDownloadResponse.%s: %s%s",
+		"invalid %sCreateOneTimeDownloadResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PresignDownloadResponseValidationError{}
+var _ error = CreateOneTimeDownloadResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -731,44 +9966,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PresignDownloadResponseValidationError{}
+} = CreateOneTimeDownloadResponseValidationError{}
 
-// Validate checks the field values on DeleteObjectRequest with the rules
+// Validate checks the field values on RedeemDownloadRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteObjectRequest) Validate() error {
+func (m *RedeemDownloadRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteObjectRequest with the rules
+// ValidateAll checks the field values on RedeemDownloadRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteObjectRequestMultiError, or nil if none found.
-func (m *DeleteObjectRequest) ValidateAll() error {
+// RedeemDownloadRequestMultiError, or nil if none found.
+func (m *RedeemDownloadRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteObjectRequest) validate(all bool) error {
+func (m *RedeemDownloadRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetBucketName()) < 1 {
-		err := DeleteObjectRequestValidationError{
-			field:  "BucketName",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetObjectKey()) < 1 {
-		err := DeleteObjectRequestValidationError{
-			field:  "ObjectKey",
+	if utf8.RuneCountInString(m.GetToken()) < 1 {
+		err := RedeemDownloadRequestValidationError{
+			field:  "Token",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -778,19 +10002,19 @@ func (m *DeleteObjectRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DeleteObjectRequestMultiError(errors)
+		return RedeemDownloadRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteObjectRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteObjectRequest.ValidateAll() if the designated
+// RedeemDownloadRequestMultiError is an error wrapping multiple validation
+// errors returned by RedeemDownloadRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteObjectRequestMultiError []error
+type RedeemDownloadRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteObjectRequestMultiError) Error() string {
+func (m RedeemDownloadRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -799,11 +10023,11 @@ func (m DeleteObjectRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteObjectRequestMultiError) AllErrors() []error { return m }
+func (m RedeemDownloadRequestMultiError) AllErrors() []error { return m }
 
-// DeleteObjectRequestValidationError is the validation error returned by
-// DeleteObjectRequest.Validate if the designated constraints aren't met.
-type DeleteObjectRequestValidationError struct {
+// RedeemDownloadRequestValidationError is the validation error returned by
+// RedeemDownloadRequest.Validate if the designated constraints aren't met.
+type RedeemDownloadRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -811,24 +10035,24 @@ type DeleteObjectRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteObjectRequestValidationError) Field() string { return e.field }
+func (e RedeemDownloadRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteObjectRequestValidationError) Reason() string { return e.reason }
+func (e RedeemDownloadRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteObjectRequestValidationError) Cause() error { return e.cause }
+func (e RedeemDownloadRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteObjectRequestValidationError) Key() bool { return e.key }
+func (e RedeemDownloadRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteObjectRequestValidationError) ErrorName() string {
-	return "DeleteObjectRequestValidationError"
+func (e RedeemDownloadRequestValidationError) ErrorName() string {
+	return "RedeemDownloadRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteObjectRequestValidationError) Error() string {
+func (e RedeemDownloadRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -840,14 +10064,14 @@ func (e DeleteObjectRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteObjectRequest.%s: %s%s",
+		"invalid %sRedeemDownloadRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteObjectRequestValidationError{}
+var _ error = RedeemDownloadRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -855,46 +10079,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteObjectRequestValidationError{}
+} = RedeemDownloadRequestValidationError{}
 
-// Validate checks the field values on DeleteObjectResponse with the rules
+// Validate checks the field values on RedeemDownloadResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteObjectResponse) Validate() error {
+func (m *RedeemDownloadResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteObjectResponse with the rules
+// ValidateAll checks the field values on RedeemDownloadResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteObjectResponseMultiError, or nil if none found.
-func (m *DeleteObjectResponse) ValidateAll() error {
+// RedeemDownloadResponseMultiError, or nil if none found.
+func (m *RedeemDownloadResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteObjectResponse) validate(all bool) error {
+func (m *RedeemDownloadResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Success
+	// no validation rules for PresignedUrl
+
+	// no validation rules for ExpiresIn
 
 	if len(errors) > 0 {
-		return DeleteObjectResponseMultiError(errors)
+		return RedeemDownloadResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteObjectResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteObjectResponse.ValidateAll() if the designated
+// RedeemDownloadResponseMultiError is an error wrapping multiple validation
+// errors returned by RedeemDownloadResponse.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteObjectResponseMultiError []error
+type RedeemDownloadResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteObjectResponseMultiError) Error() string {
+func (m RedeemDownloadResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -903,11 +10129,11 @@ func (m DeleteObjectResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteObjectResponseMultiError) AllErrors() []error { return m }
+func (m RedeemDownloadResponseMultiError) AllErrors() []error { return m }
 
-// DeleteObjectResponseValidationError is the validation error returned by
-// DeleteObjectResponse.Validate if the designated constraints aren't met.
-type DeleteObjectResponseValidationError struct {
+// RedeemDownloadResponseValidationError is the validation error returned by
+// RedeemDownloadResponse.Validate if the designated constraints aren't met.
+type RedeemDownloadResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -915,24 +10141,24 @@ type DeleteObjectResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteObjectResponseValidationError) Field() string { return e.field }
+func (e RedeemDownloadResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteObjectResponseValidationError) Reason() string { return e.reason }
+func (e RedeemDownloadResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteObjectResponseValidationError) Cause() error { return e.cause }
+func (e RedeemDownloadResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteObjectResponseValidationError) Key() bool { return e.key }
+func (e RedeemDownloadResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteObjectResponseValidationError) ErrorName() string {
-	return "DeleteObjectResponseValidationError"
+func (e RedeemDownloadResponseValidationError) ErrorName() string {
+	return "RedeemDownloadResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteObjectResponseValidationError) Error() string {
+func (e RedeemDownloadResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -944,14 +10170,14 @@ func (e DeleteObjectResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteObjectResponse.%s: %s%s",
+		"invalid %sRedeemDownloadResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteObjectResponseValidationError{}
+var _ error = RedeemDownloadResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -959,4 +10185,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteObjectResponseValidationError{}
+} = RedeemDownloadResponseValidationError{}
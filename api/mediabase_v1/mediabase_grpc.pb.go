@@ -19,11 +19,44 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MediabaseService_Ping_FullMethodName            = "/v1.MediabaseService/Ping"
-	MediabaseService_PresignUpload_FullMethodName   = "/v1.MediabaseService/PresignUpload"
-	MediabaseService_PresignDownload_FullMethodName = "/v1.MediabaseService/PresignDownload"
-	MediabaseService_DeleteObject_FullMethodName    = "/v1.MediabaseService/DeleteObject"
-	MediabaseService_CreateBucket_FullMethodName    = "/v1.MediabaseService/CreateBucket"
+	MediabaseService_Ping_FullMethodName                  = "/v1.MediabaseService/Ping"
+	MediabaseService_PresignUpload_FullMethodName         = "/v1.MediabaseService/PresignUpload"
+	MediabaseService_PresignUploadBatch_FullMethodName    = "/v1.MediabaseService/PresignUploadBatch"
+	MediabaseService_PreflightUpload_FullMethodName       = "/v1.MediabaseService/PreflightUpload"
+	MediabaseService_PresignDownload_FullMethodName       = "/v1.MediabaseService/PresignDownload"
+	MediabaseService_CreateOneTimeDownload_FullMethodName = "/v1.MediabaseService/CreateOneTimeDownload"
+	MediabaseService_RedeemDownload_FullMethodName        = "/v1.MediabaseService/RedeemDownload"
+	MediabaseService_PresignHead_FullMethodName           = "/v1.MediabaseService/PresignHead"
+	MediabaseService_PresignDelete_FullMethodName         = "/v1.MediabaseService/PresignDelete"
+	MediabaseService_GetPublicURL_FullMethodName          = "/v1.MediabaseService/GetPublicURL"
+	MediabaseService_GetUploadConstraints_FullMethodName  = "/v1.MediabaseService/GetUploadConstraints"
+	MediabaseService_DeleteObject_FullMethodName          = "/v1.MediabaseService/DeleteObject"
+	MediabaseService_CreateBucket_FullMethodName          = "/v1.MediabaseService/CreateBucket"
+	MediabaseService_DeleteBucket_FullMethodName          = "/v1.MediabaseService/DeleteBucket"
+	MediabaseService_PutObject_FullMethodName             = "/v1.MediabaseService/PutObject"
+	MediabaseService_UploadObject_FullMethodName          = "/v1.MediabaseService/UploadObject"
+	MediabaseService_GetObject_FullMethodName             = "/v1.MediabaseService/GetObject"
+	MediabaseService_ConfirmUpload_FullMethodName         = "/v1.MediabaseService/ConfirmUpload"
+	MediabaseService_CopyObject_FullMethodName            = "/v1.MediabaseService/CopyObject"
+	MediabaseService_MoveObject_FullMethodName            = "/v1.MediabaseService/MoveObject"
+	MediabaseService_RestoreObject_FullMethodName         = "/v1.MediabaseService/RestoreObject"
+	MediabaseService_SetObjectACL_FullMethodName          = "/v1.MediabaseService/SetObjectACL"
+	MediabaseService_GetObjectACL_FullMethodName          = "/v1.MediabaseService/GetObjectACL"
+	MediabaseService_SetObjectRetention_FullMethodName    = "/v1.MediabaseService/SetObjectRetention"
+	MediabaseService_SetObjectLegalHold_FullMethodName    = "/v1.MediabaseService/SetObjectLegalHold"
+	MediabaseService_SetObjectTags_FullMethodName         = "/v1.MediabaseService/SetObjectTags"
+	MediabaseService_GetObjectTags_FullMethodName         = "/v1.MediabaseService/GetObjectTags"
+	MediabaseService_SetBucketVersioning_FullMethodName   = "/v1.MediabaseService/SetBucketVersioning"
+	MediabaseService_SetBucketLifecycle_FullMethodName    = "/v1.MediabaseService/SetBucketLifecycle"
+	MediabaseService_GetBucketStats_FullMethodName        = "/v1.MediabaseService/GetBucketStats"
+	MediabaseService_GetObjectMetadata_FullMethodName     = "/v1.MediabaseService/GetObjectMetadata"
+	MediabaseService_BatchObjectExists_FullMethodName     = "/v1.MediabaseService/BatchObjectExists"
+	MediabaseService_FindObjectsByTag_FullMethodName      = "/v1.MediabaseService/FindObjectsByTag"
+	MediabaseService_ListObjectVersions_FullMethodName    = "/v1.MediabaseService/ListObjectVersions"
+	MediabaseService_ListUploadedParts_FullMethodName     = "/v1.MediabaseService/ListUploadedParts"
+	MediabaseService_ConvertImage_FullMethodName          = "/v1.MediabaseService/ConvertImage"
+	MediabaseService_SanitizeImage_FullMethodName         = "/v1.MediabaseService/SanitizeImage"
+	MediabaseService_UpdateCredentials_FullMethodName     = "/v1.MediabaseService/UpdateCredentials"
 )
 
 // MediabaseServiceClient is the client API for MediabaseService service.
@@ -34,12 +67,84 @@ type MediabaseServiceClient interface {
 	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
 	// PresignUpload generates a presigned URL for uploading a file
 	PresignUpload(ctx context.Context, in *PresignUploadRequest, opts ...grpc.CallOption) (*PresignUploadResponse, error)
+	// PresignUploadBatch generates presigned upload URLs for several files at once
+	PresignUploadBatch(ctx context.Context, in *PresignUploadBatchRequest, opts ...grpc.CallOption) (*PresignUploadBatchResponse, error)
+	// PreflightUpload describes exactly what a browser must send for a presigned upload
+	PreflightUpload(ctx context.Context, in *PreflightUploadRequest, opts ...grpc.CallOption) (*PreflightUploadResponse, error)
 	// PresignDownload generates a presigned URL for downloading a file
 	PresignDownload(ctx context.Context, in *PresignDownloadRequest, opts ...grpc.CallOption) (*PresignDownloadResponse, error)
+	// CreateOneTimeDownload issues a token that can be redeemed once for a presigned download URL
+	CreateOneTimeDownload(ctx context.Context, in *CreateOneTimeDownloadRequest, opts ...grpc.CallOption) (*CreateOneTimeDownloadResponse, error)
+	// RedeemDownload consumes a one-time download token and returns a short-lived presigned download URL
+	RedeemDownload(ctx context.Context, in *RedeemDownloadRequest, opts ...grpc.CallOption) (*RedeemDownloadResponse, error)
+	// PresignHead generates a presigned URL for a HEAD request on an object
+	PresignHead(ctx context.Context, in *PresignHeadRequest, opts ...grpc.CallOption) (*PresignHeadResponse, error)
+	// PresignDelete generates a presigned URL for deleting an object directly in storage
+	PresignDelete(ctx context.Context, in *PresignDeleteRequest, opts ...grpc.CallOption) (*PresignDeleteResponse, error)
+	// GetPublicURL returns the unsigned, non-expiring URL of an object in a public bucket
+	GetPublicURL(ctx context.Context, in *GetPublicURLRequest, opts ...grpc.CallOption) (*GetPublicURLResponse, error)
+	// GetUploadConstraints returns the upload limits configured on the server
+	GetUploadConstraints(ctx context.Context, in *GetUploadConstraintsRequest, opts ...grpc.CallOption) (*GetUploadConstraintsResponse, error)
 	// DeleteObject deletes a file from storage
 	DeleteObject(ctx context.Context, in *DeleteObjectRequest, opts ...grpc.CallOption) (*DeleteObjectResponse, error)
 	// CreateBucket creates a bucket and optionally sets it to public read
 	CreateBucket(ctx context.Context, in *CreateBucketRequest, opts ...grpc.CallOption) (*CreateBucketResponse, error)
+	// DeleteBucket removes an empty bucket
+	DeleteBucket(ctx context.Context, in *DeleteBucketRequest, opts ...grpc.CallOption) (*DeleteBucketResponse, error)
+	// PutObject uploads a file directly through the server
+	PutObject(ctx context.Context, in *PutObjectRequest, opts ...grpc.CallOption) (*PutObjectResponse, error)
+	// UploadObject streams a file through the server in chunks without buffering it in memory.
+	// The first message must carry the metadata, every following message a chunk of content.
+	// Streaming is not available over the HTTP gateway.
+	UploadObject(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadObjectRequest, UploadObjectResponse], error)
+	// GetObject streams a file through the server in chunks for clients that cannot use presigned URLs.
+	// The first message carries the metadata, every following message a chunk of content.
+	// Streaming is not available over the HTTP gateway, which has the download proxy instead.
+	GetObject(ctx context.Context, in *GetObjectRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetObjectResponse], error)
+	// ConfirmUpload verifies that a presigned upload landed in storage
+	ConfirmUpload(ctx context.Context, in *ConfirmUploadRequest, opts ...grpc.CallOption) (*ConfirmUploadResponse, error)
+	// CopyObject copies an object within a bucket, optionally overriding its headers and metadata
+	CopyObject(ctx context.Context, in *CopyObjectRequest, opts ...grpc.CallOption) (*CopyObjectResponse, error)
+	// MoveObject moves an object to another key or bucket on the storage side
+	MoveObject(ctx context.Context, in *MoveObjectRequest, opts ...grpc.CallOption) (*MoveObjectResponse, error)
+	// RestoreObject moves a soft-deleted object back out of the trash
+	RestoreObject(ctx context.Context, in *RestoreObjectRequest, opts ...grpc.CallOption) (*RestoreObjectResponse, error)
+	// SetObjectACL makes a single object public or private
+	SetObjectACL(ctx context.Context, in *SetObjectACLRequest, opts ...grpc.CallOption) (*SetObjectACLResponse, error)
+	// GetObjectACL reports whether an object is public
+	GetObjectACL(ctx context.Context, in *GetObjectACLRequest, opts ...grpc.CallOption) (*GetObjectACLResponse, error)
+	// SetObjectRetention protects an object from deletion and overwrites until a date
+	SetObjectRetention(ctx context.Context, in *SetObjectRetentionRequest, opts ...grpc.CallOption) (*SetObjectRetentionResponse, error)
+	// SetObjectLegalHold places or removes a legal hold on an object
+	SetObjectLegalHold(ctx context.Context, in *SetObjectLegalHoldRequest, opts ...grpc.CallOption) (*SetObjectLegalHoldResponse, error)
+	// SetObjectTags replaces the tags of an object
+	SetObjectTags(ctx context.Context, in *SetObjectTagsRequest, opts ...grpc.CallOption) (*SetObjectTagsResponse, error)
+	// GetObjectTags returns the tags of an object
+	GetObjectTags(ctx context.Context, in *GetObjectTagsRequest, opts ...grpc.CallOption) (*GetObjectTagsResponse, error)
+	// SetBucketVersioning enables or suspends versioning on a bucket
+	SetBucketVersioning(ctx context.Context, in *SetBucketVersioningRequest, opts ...grpc.CallOption) (*SetBucketVersioningResponse, error)
+	// SetBucketLifecycle expires objects under a prefix after a number of days
+	SetBucketLifecycle(ctx context.Context, in *SetBucketLifecycleRequest, opts ...grpc.CallOption) (*SetBucketLifecycleResponse, error)
+	// GetBucketStats counts the objects in a bucket and sums their sizes
+	GetBucketStats(ctx context.Context, in *GetBucketStatsRequest, opts ...grpc.CallOption) (*GetBucketStatsResponse, error)
+	// GetObjectMetadata returns the attributes and application metadata of an object
+	GetObjectMetadata(ctx context.Context, in *GetObjectMetadataRequest, opts ...grpc.CallOption) (*GetObjectMetadataResponse, error)
+	// BatchObjectExists checks which of several objects exist
+	BatchObjectExists(ctx context.Context, in *BatchObjectExistsRequest, opts ...grpc.CallOption) (*BatchObjectExistsResponse, error)
+	// FindObjectsByTag finds the objects carrying a set of tags
+	FindObjectsByTag(ctx context.Context, in *FindObjectsByTagRequest, opts ...grpc.CallOption) (*FindObjectsByTagResponse, error)
+	// ListObjectVersions lists all versions of an object
+	ListObjectVersions(ctx context.Context, in *ListObjectVersionsRequest, opts ...grpc.CallOption) (*ListObjectVersionsResponse, error)
+	// ListUploadedParts lists the parts already uploaded to an in-progress multipart upload
+	ListUploadedParts(ctx context.Context, in *ListUploadedPartsRequest, opts ...grpc.CallOption) (*ListUploadedPartsResponse, error)
+	// ConvertImage transcodes a stored image into another format and stores it under a new key
+	ConvertImage(ctx context.Context, in *ConvertImageRequest, opts ...grpc.CallOption) (*ConvertImageResponse, error)
+	// SanitizeImage strips EXIF/GPS and other metadata from a stored JPEG or TIFF image in place
+	SanitizeImage(ctx context.Context, in *SanitizeImageRequest, opts ...grpc.CallOption) (*SanitizeImageResponse, error)
+	// UpdateCredentials replaces the storage access keys without a restart, once storage accepts them.
+	// Only callers presenting a client certificate listed in the admin subjects may call it,
+	// so it is not available over the HTTP gateway.
+	UpdateCredentials(ctx context.Context, in *UpdateCredentialsRequest, opts ...grpc.CallOption) (*UpdateCredentialsResponse, error)
 }
 
 type mediabaseServiceClient struct {
@@ -70,6 +175,26 @@ func (c *mediabaseServiceClient) PresignUpload(ctx context.Context, in *PresignU
 	return out, nil
 }
 
+func (c *mediabaseServiceClient) PresignUploadBatch(ctx context.Context, in *PresignUploadBatchRequest, opts ...grpc.CallOption) (*PresignUploadBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PresignUploadBatchResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_PresignUploadBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) PreflightUpload(ctx context.Context, in *PreflightUploadRequest, opts ...grpc.CallOption) (*PreflightUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreflightUploadResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_PreflightUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mediabaseServiceClient) PresignDownload(ctx context.Context, in *PresignDownloadRequest, opts ...grpc.CallOption) (*PresignDownloadResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PresignDownloadResponse)
@@ -77,175 +202,1244 @@ func (c *mediabaseServiceClient) PresignDownload(ctx context.Context, in *Presig
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) CreateOneTimeDownload(ctx context.Context, in *CreateOneTimeDownloadRequest, opts ...grpc.CallOption) (*CreateOneTimeDownloadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOneTimeDownloadResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_CreateOneTimeDownload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) RedeemDownload(ctx context.Context, in *RedeemDownloadRequest, opts ...grpc.CallOption) (*RedeemDownloadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedeemDownloadResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_RedeemDownload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) PresignHead(ctx context.Context, in *PresignHeadRequest, opts ...grpc.CallOption) (*PresignHeadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PresignHeadResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_PresignHead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) PresignDelete(ctx context.Context, in *PresignDeleteRequest, opts ...grpc.CallOption) (*PresignDeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PresignDeleteResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_PresignDelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) GetPublicURL(ctx context.Context, in *GetPublicURLRequest, opts ...grpc.CallOption) (*GetPublicURLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPublicURLResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_GetPublicURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) GetUploadConstraints(ctx context.Context, in *GetUploadConstraintsRequest, opts ...grpc.CallOption) (*GetUploadConstraintsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUploadConstraintsResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_GetUploadConstraints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) DeleteObject(ctx context.Context, in *DeleteObjectRequest, opts ...grpc.CallOption) (*DeleteObjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteObjectResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_DeleteObject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) CreateBucket(ctx context.Context, in *CreateBucketRequest, opts ...grpc.CallOption) (*CreateBucketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBucketResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_CreateBucket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) DeleteBucket(ctx context.Context, in *DeleteBucketRequest, opts ...grpc.CallOption) (*DeleteBucketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBucketResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_DeleteBucket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) PutObject(ctx context.Context, in *PutObjectRequest, opts ...grpc.CallOption) (*PutObjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutObjectResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_PutObject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) UploadObject(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadObjectRequest, UploadObjectResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MediabaseService_ServiceDesc.Streams[0], MediabaseService_UploadObject_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadObjectRequest, UploadObjectResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MediabaseService_UploadObjectClient = grpc.ClientStreamingClient[UploadObjectRequest, UploadObjectResponse]
+
+func (c *mediabaseServiceClient) GetObject(ctx context.Context, in *GetObjectRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetObjectResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MediabaseService_ServiceDesc.Streams[1], MediabaseService_GetObject_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetObjectRequest, GetObjectResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MediabaseService_GetObjectClient = grpc.ServerStreamingClient[GetObjectResponse]
+
+func (c *mediabaseServiceClient) ConfirmUpload(ctx context.Context, in *ConfirmUploadRequest, opts ...grpc.CallOption) (*ConfirmUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmUploadResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_ConfirmUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) CopyObject(ctx context.Context, in *CopyObjectRequest, opts ...grpc.CallOption) (*CopyObjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CopyObjectResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_CopyObject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) MoveObject(ctx context.Context, in *MoveObjectRequest, opts ...grpc.CallOption) (*MoveObjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveObjectResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_MoveObject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) RestoreObject(ctx context.Context, in *RestoreObjectRequest, opts ...grpc.CallOption) (*RestoreObjectResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreObjectResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_RestoreObject_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) SetObjectACL(ctx context.Context, in *SetObjectACLRequest, opts ...grpc.CallOption) (*SetObjectACLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetObjectACLResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_SetObjectACL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) GetObjectACL(ctx context.Context, in *GetObjectACLRequest, opts ...grpc.CallOption) (*GetObjectACLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetObjectACLResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_GetObjectACL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) SetObjectRetention(ctx context.Context, in *SetObjectRetentionRequest, opts ...grpc.CallOption) (*SetObjectRetentionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetObjectRetentionResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_SetObjectRetention_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) SetObjectLegalHold(ctx context.Context, in *SetObjectLegalHoldRequest, opts ...grpc.CallOption) (*SetObjectLegalHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetObjectLegalHoldResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_SetObjectLegalHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) SetObjectTags(ctx context.Context, in *SetObjectTagsRequest, opts ...grpc.CallOption) (*SetObjectTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetObjectTagsResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_SetObjectTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) GetObjectTags(ctx context.Context, in *GetObjectTagsRequest, opts ...grpc.CallOption) (*GetObjectTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetObjectTagsResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_GetObjectTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) SetBucketVersioning(ctx context.Context, in *SetBucketVersioningRequest, opts ...grpc.CallOption) (*SetBucketVersioningResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetBucketVersioningResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_SetBucketVersioning_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) SetBucketLifecycle(ctx context.Context, in *SetBucketLifecycleRequest, opts ...grpc.CallOption) (*SetBucketLifecycleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetBucketLifecycleResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_SetBucketLifecycle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) GetBucketStats(ctx context.Context, in *GetBucketStatsRequest, opts ...grpc.CallOption) (*GetBucketStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBucketStatsResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_GetBucketStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) GetObjectMetadata(ctx context.Context, in *GetObjectMetadataRequest, opts ...grpc.CallOption) (*GetObjectMetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetObjectMetadataResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_GetObjectMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) BatchObjectExists(ctx context.Context, in *BatchObjectExistsRequest, opts ...grpc.CallOption) (*BatchObjectExistsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchObjectExistsResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_BatchObjectExists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) FindObjectsByTag(ctx context.Context, in *FindObjectsByTagRequest, opts ...grpc.CallOption) (*FindObjectsByTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindObjectsByTagResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_FindObjectsByTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) ListObjectVersions(ctx context.Context, in *ListObjectVersionsRequest, opts ...grpc.CallOption) (*ListObjectVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListObjectVersionsResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_ListObjectVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) ListUploadedParts(ctx context.Context, in *ListUploadedPartsRequest, opts ...grpc.CallOption) (*ListUploadedPartsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUploadedPartsResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_ListUploadedParts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) ConvertImage(ctx context.Context, in *ConvertImageRequest, opts ...grpc.CallOption) (*ConvertImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConvertImageResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_ConvertImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) SanitizeImage(ctx context.Context, in *SanitizeImageRequest, opts ...grpc.CallOption) (*SanitizeImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SanitizeImageResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_SanitizeImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mediabaseServiceClient) UpdateCredentials(ctx context.Context, in *UpdateCredentialsRequest, opts ...grpc.CallOption) (*UpdateCredentialsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCredentialsResponse)
+	err := c.cc.Invoke(ctx, MediabaseService_UpdateCredentials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MediabaseServiceServer is the server API for MediabaseService service.
+// All implementations must embed UnimplementedMediabaseServiceServer
+// for forward compatibility.
+type MediabaseServiceServer interface {
+	// Ping is a simple GET request that returns a Pong message.
+	Ping(context.Context, *PingRequest) (*PingResponse, error)
+	// PresignUpload generates a presigned URL for uploading a file
+	PresignUpload(context.Context, *PresignUploadRequest) (*PresignUploadResponse, error)
+	// PresignUploadBatch generates presigned upload URLs for several files at once
+	PresignUploadBatch(context.Context, *PresignUploadBatchRequest) (*PresignUploadBatchResponse, error)
+	// PreflightUpload describes exactly what a browser must send for a presigned upload
+	PreflightUpload(context.Context, *PreflightUploadRequest) (*PreflightUploadResponse, error)
+	// PresignDownload generates a presigned URL for downloading a file
+	PresignDownload(context.Context, *PresignDownloadRequest) (*PresignDownloadResponse, error)
+	// CreateOneTimeDownload issues a token that can be redeemed once for a presigned download URL
+	CreateOneTimeDownload(context.Context, *CreateOneTimeDownloadRequest) (*CreateOneTimeDownloadResponse, error)
+	// RedeemDownload consumes a one-time download token and returns a short-lived presigned download URL
+	RedeemDownload(context.Context, *RedeemDownloadRequest) (*RedeemDownloadResponse, error)
+	// PresignHead generates a presigned URL for a HEAD request on an object
+	PresignHead(context.Context, *PresignHeadRequest) (*PresignHeadResponse, error)
+	// PresignDelete generates a presigned URL for deleting an object directly in storage
+	PresignDelete(context.Context, *PresignDeleteRequest) (*PresignDeleteResponse, error)
+	// GetPublicURL returns the unsigned, non-expiring URL of an object in a public bucket
+	GetPublicURL(context.Context, *GetPublicURLRequest) (*GetPublicURLResponse, error)
+	// GetUploadConstraints returns the upload limits configured on the server
+	GetUploadConstraints(context.Context, *GetUploadConstraintsRequest) (*GetUploadConstraintsResponse, error)
+	// DeleteObject deletes a file from storage
+	DeleteObject(context.Context, *DeleteObjectRequest) (*DeleteObjectResponse, error)
+	// CreateBucket creates a bucket and optionally sets it to public read
+	CreateBucket(context.Context, *CreateBucketRequest) (*CreateBucketResponse, error)
+	// DeleteBucket removes an empty bucket
+	DeleteBucket(context.Context, *DeleteBucketRequest) (*DeleteBucketResponse, error)
+	// PutObject uploads a file directly through the server
+	PutObject(context.Context, *PutObjectRequest) (*PutObjectResponse, error)
+	// UploadObject streams a file through the server in chunks without buffering it in memory.
+	// The first message must carry the metadata, every following message a chunk of content.
+	// Streaming is not available over the HTTP gateway.
+	UploadObject(grpc.ClientStreamingServer[UploadObjectRequest, UploadObjectResponse]) error
+	// GetObject streams a file through the server in chunks for clients that cannot use presigned URLs.
+	// The first message carries the metadata, every following message a chunk of content.
+	// Streaming is not available over the HTTP gateway, which has the download proxy instead.
+	GetObject(*GetObjectRequest, grpc.ServerStreamingServer[GetObjectResponse]) error
+	// ConfirmUpload verifies that a presigned upload landed in storage
+	ConfirmUpload(context.Context, *ConfirmUploadRequest) (*ConfirmUploadResponse, error)
+	// CopyObject copies an object within a bucket, optionally overriding its headers and metadata
+	CopyObject(context.Context, *CopyObjectRequest) (*CopyObjectResponse, error)
+	// MoveObject moves an object to another key or bucket on the storage side
+	MoveObject(context.Context, *MoveObjectRequest) (*MoveObjectResponse, error)
+	// RestoreObject moves a soft-deleted object back out of the trash
+	RestoreObject(context.Context, *RestoreObjectRequest) (*RestoreObjectResponse, error)
+	// SetObjectACL makes a single object public or private
+	SetObjectACL(context.Context, *SetObjectACLRequest) (*SetObjectACLResponse, error)
+	// GetObjectACL reports whether an object is public
+	GetObjectACL(context.Context, *GetObjectACLRequest) (*GetObjectACLResponse, error)
+	// SetObjectRetention protects an object from deletion and overwrites until a date
+	SetObjectRetention(context.Context, *SetObjectRetentionRequest) (*SetObjectRetentionResponse, error)
+	// SetObjectLegalHold places or removes a legal hold on an object
+	SetObjectLegalHold(context.Context, *SetObjectLegalHoldRequest) (*SetObjectLegalHoldResponse, error)
+	// SetObjectTags replaces the tags of an object
+	SetObjectTags(context.Context, *SetObjectTagsRequest) (*SetObjectTagsResponse, error)
+	// GetObjectTags returns the tags of an object
+	GetObjectTags(context.Context, *GetObjectTagsRequest) (*GetObjectTagsResponse, error)
+	// SetBucketVersioning enables or suspends versioning on a bucket
+	SetBucketVersioning(context.Context, *SetBucketVersioningRequest) (*SetBucketVersioningResponse, error)
+	// SetBucketLifecycle expires objects under a prefix after a number of days
+	SetBucketLifecycle(context.Context, *SetBucketLifecycleRequest) (*SetBucketLifecycleResponse, error)
+	// GetBucketStats counts the objects in a bucket and sums their sizes
+	GetBucketStats(context.Context, *GetBucketStatsRequest) (*GetBucketStatsResponse, error)
+	// GetObjectMetadata returns the attributes and application metadata of an object
+	GetObjectMetadata(context.Context, *GetObjectMetadataRequest) (*GetObjectMetadataResponse, error)
+	// BatchObjectExists checks which of several objects exist
+	BatchObjectExists(context.Context, *BatchObjectExistsRequest) (*BatchObjectExistsResponse, error)
+	// FindObjectsByTag finds the objects carrying a set of tags
+	FindObjectsByTag(context.Context, *FindObjectsByTagRequest) (*FindObjectsByTagResponse, error)
+	// ListObjectVersions lists all versions of an object
+	ListObjectVersions(context.Context, *ListObjectVersionsRequest) (*ListObjectVersionsResponse, error)
+	// ListUploadedParts lists the parts already uploaded to an in-progress multipart upload
+	ListUploadedParts(context.Context, *ListUploadedPartsRequest) (*ListUploadedPartsResponse, error)
+	// ConvertImage transcodes a stored image into another format and stores it under a new key
+	ConvertImage(context.Context, *ConvertImageRequest) (*ConvertImageResponse, error)
+	// SanitizeImage strips EXIF/GPS and other metadata from a stored JPEG or TIFF image in place
+	SanitizeImage(context.Context, *SanitizeImageRequest) (*SanitizeImageResponse, error)
+	// UpdateCredentials replaces the storage access keys without a restart, once storage accepts them.
+	// Only callers presenting a client certificate listed in the admin subjects may call it,
+	// so it is not available over the HTTP gateway.
+	UpdateCredentials(context.Context, *UpdateCredentialsRequest) (*UpdateCredentialsResponse, error)
+	mustEmbedUnimplementedMediabaseServiceServer()
+}
+
+// UnimplementedMediabaseServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMediabaseServiceServer struct{}
+
+func (UnimplementedMediabaseServiceServer) Ping(context.Context, *PingRequest) (*PingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (UnimplementedMediabaseServiceServer) PresignUpload(context.Context, *PresignUploadRequest) (*PresignUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PresignUpload not implemented")
+}
+func (UnimplementedMediabaseServiceServer) PresignUploadBatch(context.Context, *PresignUploadBatchRequest) (*PresignUploadBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PresignUploadBatch not implemented")
+}
+func (UnimplementedMediabaseServiceServer) PreflightUpload(context.Context, *PreflightUploadRequest) (*PreflightUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreflightUpload not implemented")
+}
+func (UnimplementedMediabaseServiceServer) PresignDownload(context.Context, *PresignDownloadRequest) (*PresignDownloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PresignDownload not implemented")
+}
+func (UnimplementedMediabaseServiceServer) CreateOneTimeDownload(context.Context, *CreateOneTimeDownloadRequest) (*CreateOneTimeDownloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOneTimeDownload not implemented")
+}
+func (UnimplementedMediabaseServiceServer) RedeemDownload(context.Context, *RedeemDownloadRequest) (*RedeemDownloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemDownload not implemented")
+}
+func (UnimplementedMediabaseServiceServer) PresignHead(context.Context, *PresignHeadRequest) (*PresignHeadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PresignHead not implemented")
+}
+func (UnimplementedMediabaseServiceServer) PresignDelete(context.Context, *PresignDeleteRequest) (*PresignDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PresignDelete not implemented")
+}
+func (UnimplementedMediabaseServiceServer) GetPublicURL(context.Context, *GetPublicURLRequest) (*GetPublicURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicURL not implemented")
+}
+func (UnimplementedMediabaseServiceServer) GetUploadConstraints(context.Context, *GetUploadConstraintsRequest) (*GetUploadConstraintsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUploadConstraints not implemented")
+}
+func (UnimplementedMediabaseServiceServer) DeleteObject(context.Context, *DeleteObjectRequest) (*DeleteObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteObject not implemented")
+}
+func (UnimplementedMediabaseServiceServer) CreateBucket(context.Context, *CreateBucketRequest) (*CreateBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBucket not implemented")
+}
+func (UnimplementedMediabaseServiceServer) DeleteBucket(context.Context, *DeleteBucketRequest) (*DeleteBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBucket not implemented")
+}
+func (UnimplementedMediabaseServiceServer) PutObject(context.Context, *PutObjectRequest) (*PutObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutObject not implemented")
+}
+func (UnimplementedMediabaseServiceServer) UploadObject(grpc.ClientStreamingServer[UploadObjectRequest, UploadObjectResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadObject not implemented")
+}
+func (UnimplementedMediabaseServiceServer) GetObject(*GetObjectRequest, grpc.ServerStreamingServer[GetObjectResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method GetObject not implemented")
+}
+func (UnimplementedMediabaseServiceServer) ConfirmUpload(context.Context, *ConfirmUploadRequest) (*ConfirmUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmUpload not implemented")
+}
+func (UnimplementedMediabaseServiceServer) CopyObject(context.Context, *CopyObjectRequest) (*CopyObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyObject not implemented")
+}
+func (UnimplementedMediabaseServiceServer) MoveObject(context.Context, *MoveObjectRequest) (*MoveObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveObject not implemented")
+}
+func (UnimplementedMediabaseServiceServer) RestoreObject(context.Context, *RestoreObjectRequest) (*RestoreObjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreObject not implemented")
+}
+func (UnimplementedMediabaseServiceServer) SetObjectACL(context.Context, *SetObjectACLRequest) (*SetObjectACLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetObjectACL not implemented")
+}
+func (UnimplementedMediabaseServiceServer) GetObjectACL(context.Context, *GetObjectACLRequest) (*GetObjectACLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetObjectACL not implemented")
+}
+func (UnimplementedMediabaseServiceServer) SetObjectRetention(context.Context, *SetObjectRetentionRequest) (*SetObjectRetentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetObjectRetention not implemented")
+}
+func (UnimplementedMediabaseServiceServer) SetObjectLegalHold(context.Context, *SetObjectLegalHoldRequest) (*SetObjectLegalHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetObjectLegalHold not implemented")
+}
+func (UnimplementedMediabaseServiceServer) SetObjectTags(context.Context, *SetObjectTagsRequest) (*SetObjectTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetObjectTags not implemented")
+}
+func (UnimplementedMediabaseServiceServer) GetObjectTags(context.Context, *GetObjectTagsRequest) (*GetObjectTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetObjectTags not implemented")
+}
+func (UnimplementedMediabaseServiceServer) SetBucketVersioning(context.Context, *SetBucketVersioningRequest) (*SetBucketVersioningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBucketVersioning not implemented")
+}
+func (UnimplementedMediabaseServiceServer) SetBucketLifecycle(context.Context, *SetBucketLifecycleRequest) (*SetBucketLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBucketLifecycle not implemented")
+}
+func (UnimplementedMediabaseServiceServer) GetBucketStats(context.Context, *GetBucketStatsRequest) (*GetBucketStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBucketStats not implemented")
+}
+func (UnimplementedMediabaseServiceServer) GetObjectMetadata(context.Context, *GetObjectMetadataRequest) (*GetObjectMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetObjectMetadata not implemented")
+}
+func (UnimplementedMediabaseServiceServer) BatchObjectExists(context.Context, *BatchObjectExistsRequest) (*BatchObjectExistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchObjectExists not implemented")
+}
+func (UnimplementedMediabaseServiceServer) FindObjectsByTag(context.Context, *FindObjectsByTagRequest) (*FindObjectsByTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindObjectsByTag not implemented")
+}
+func (UnimplementedMediabaseServiceServer) ListObjectVersions(context.Context, *ListObjectVersionsRequest) (*ListObjectVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListObjectVersions not implemented")
+}
+func (UnimplementedMediabaseServiceServer) ListUploadedParts(context.Context, *ListUploadedPartsRequest) (*ListUploadedPartsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUploadedParts not implemented")
+}
+func (UnimplementedMediabaseServiceServer) ConvertImage(context.Context, *ConvertImageRequest) (*ConvertImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertImage not implemented")
+}
+func (UnimplementedMediabaseServiceServer) SanitizeImage(context.Context, *SanitizeImageRequest) (*SanitizeImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SanitizeImage not implemented")
+}
+func (UnimplementedMediabaseServiceServer) UpdateCredentials(context.Context, *UpdateCredentialsRequest) (*UpdateCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredentials not implemented")
+}
+func (UnimplementedMediabaseServiceServer) mustEmbedUnimplementedMediabaseServiceServer() {}
+func (UnimplementedMediabaseServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeMediabaseServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MediabaseServiceServer will
+// result in compilation errors.
+type UnsafeMediabaseServiceServer interface {
+	mustEmbedUnimplementedMediabaseServiceServer()
+}
+
+func RegisterMediabaseServiceServer(s grpc.ServiceRegistrar, srv MediabaseServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMediabaseServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MediabaseService_ServiceDesc, srv)
+}
+
+func _MediabaseService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_Ping_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).Ping(ctx, req.(*PingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediabaseService_PresignUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PresignUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).PresignUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_PresignUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).PresignUpload(ctx, req.(*PresignUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediabaseService_PresignUploadBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PresignUploadBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).PresignUploadBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_PresignUploadBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).PresignUploadBatch(ctx, req.(*PresignUploadBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediabaseService_PreflightUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreflightUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).PreflightUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_PreflightUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).PreflightUpload(ctx, req.(*PreflightUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediabaseService_PresignDownload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PresignDownloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).PresignDownload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_PresignDownload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).PresignDownload(ctx, req.(*PresignDownloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediabaseService_CreateOneTimeDownload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOneTimeDownloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).CreateOneTimeDownload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_CreateOneTimeDownload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).CreateOneTimeDownload(ctx, req.(*CreateOneTimeDownloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediabaseService_RedeemDownload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemDownloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).RedeemDownload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_RedeemDownload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).RedeemDownload(ctx, req.(*RedeemDownloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediabaseService_PresignHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PresignHeadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).PresignHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_PresignHead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).PresignHead(ctx, req.(*PresignHeadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediabaseService_PresignDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PresignDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).PresignDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_PresignDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).PresignDelete(ctx, req.(*PresignDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediabaseService_GetPublicURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).GetPublicURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_GetPublicURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).GetPublicURL(ctx, req.(*GetPublicURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediabaseService_GetUploadConstraints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUploadConstraintsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).GetUploadConstraints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_GetUploadConstraints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).GetUploadConstraints(ctx, req.(*GetUploadConstraintsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediabaseService_DeleteObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).DeleteObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_DeleteObject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).DeleteObject(ctx, req.(*DeleteObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediabaseService_CreateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBucketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).CreateBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_CreateBucket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).CreateBucket(ctx, req.(*CreateBucketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediabaseService_DeleteBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBucketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).DeleteBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_DeleteBucket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).DeleteBucket(ctx, req.(*DeleteBucketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediabaseService_PutObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).PutObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_PutObject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).PutObject(ctx, req.(*PutObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediabaseService_UploadObject_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MediabaseServiceServer).UploadObject(&grpc.GenericServerStream[UploadObjectRequest, UploadObjectResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MediabaseService_UploadObjectServer = grpc.ClientStreamingServer[UploadObjectRequest, UploadObjectResponse]
+
+func _MediabaseService_GetObject_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetObjectRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MediabaseServiceServer).GetObject(m, &grpc.GenericServerStream[GetObjectRequest, GetObjectResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MediabaseService_GetObjectServer = grpc.ServerStreamingServer[GetObjectResponse]
+
+func _MediabaseService_ConfirmUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).ConfirmUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_ConfirmUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).ConfirmUpload(ctx, req.(*ConfirmUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediabaseService_CopyObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).CopyObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_CopyObject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).CopyObject(ctx, req.(*CopyObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediabaseService_MoveObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).MoveObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_MoveObject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).MoveObject(ctx, req.(*MoveObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediabaseService_RestoreObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).RestoreObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_RestoreObject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).RestoreObject(ctx, req.(*RestoreObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediabaseService_SetObjectACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetObjectACLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).SetObjectACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_SetObjectACL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).SetObjectACL(ctx, req.(*SetObjectACLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediabaseService_GetObjectACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetObjectACLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).GetObjectACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_GetObjectACL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).GetObjectACL(ctx, req.(*GetObjectACLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MediabaseService_SetObjectRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetObjectRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).SetObjectRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_SetObjectRetention_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).SetObjectRetention(ctx, req.(*SetObjectRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mediabaseServiceClient) DeleteObject(ctx context.Context, in *DeleteObjectRequest, opts ...grpc.CallOption) (*DeleteObjectResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteObjectResponse)
-	err := c.cc.Invoke(ctx, MediabaseService_DeleteObject_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _MediabaseService_SetObjectLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetObjectLegalHoldRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).SetObjectLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_SetObjectLegalHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).SetObjectLegalHold(ctx, req.(*SetObjectLegalHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *mediabaseServiceClient) CreateBucket(ctx context.Context, in *CreateBucketRequest, opts ...grpc.CallOption) (*CreateBucketResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreateBucketResponse)
-	err := c.cc.Invoke(ctx, MediabaseService_CreateBucket_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _MediabaseService_SetObjectTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetObjectTagsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).SetObjectTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_SetObjectTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).SetObjectTags(ctx, req.(*SetObjectTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// MediabaseServiceServer is the server API for MediabaseService service.
-// All implementations must embed UnimplementedMediabaseServiceServer
-// for forward compatibility.
-type MediabaseServiceServer interface {
-	// Ping is a simple GET request that returns a Pong message.
-	Ping(context.Context, *PingRequest) (*PingResponse, error)
-	// PresignUpload generates a presigned URL for uploading a file
-	PresignUpload(context.Context, *PresignUploadRequest) (*PresignUploadResponse, error)
-	// PresignDownload generates a presigned URL for downloading a file
-	PresignDownload(context.Context, *PresignDownloadRequest) (*PresignDownloadResponse, error)
-	// DeleteObject deletes a file from storage
-	DeleteObject(context.Context, *DeleteObjectRequest) (*DeleteObjectResponse, error)
-	// CreateBucket creates a bucket and optionally sets it to public read
-	CreateBucket(context.Context, *CreateBucketRequest) (*CreateBucketResponse, error)
-	mustEmbedUnimplementedMediabaseServiceServer()
+func _MediabaseService_GetObjectTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetObjectTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).GetObjectTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_GetObjectTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).GetObjectTags(ctx, req.(*GetObjectTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedMediabaseServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedMediabaseServiceServer struct{}
-
-func (UnimplementedMediabaseServiceServer) Ping(context.Context, *PingRequest) (*PingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
-}
-func (UnimplementedMediabaseServiceServer) PresignUpload(context.Context, *PresignUploadRequest) (*PresignUploadResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PresignUpload not implemented")
+func _MediabaseService_SetBucketVersioning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBucketVersioningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).SetBucketVersioning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_SetBucketVersioning_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).SetBucketVersioning(ctx, req.(*SetBucketVersioningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMediabaseServiceServer) PresignDownload(context.Context, *PresignDownloadRequest) (*PresignDownloadResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PresignDownload not implemented")
+
+func _MediabaseService_SetBucketLifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBucketLifecycleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).SetBucketLifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_SetBucketLifecycle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).SetBucketLifecycle(ctx, req.(*SetBucketLifecycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMediabaseServiceServer) DeleteObject(context.Context, *DeleteObjectRequest) (*DeleteObjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteObject not implemented")
+
+func _MediabaseService_GetBucketStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBucketStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).GetBucketStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_GetBucketStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).GetBucketStats(ctx, req.(*GetBucketStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMediabaseServiceServer) CreateBucket(context.Context, *CreateBucketRequest) (*CreateBucketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBucket not implemented")
+
+func _MediabaseService_GetObjectMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetObjectMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).GetObjectMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_GetObjectMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).GetObjectMetadata(ctx, req.(*GetObjectMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMediabaseServiceServer) mustEmbedUnimplementedMediabaseServiceServer() {}
-func (UnimplementedMediabaseServiceServer) testEmbeddedByValue()                          {}
 
-// UnsafeMediabaseServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to MediabaseServiceServer will
-// result in compilation errors.
-type UnsafeMediabaseServiceServer interface {
-	mustEmbedUnimplementedMediabaseServiceServer()
+func _MediabaseService_BatchObjectExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchObjectExistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).BatchObjectExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_BatchObjectExists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).BatchObjectExists(ctx, req.(*BatchObjectExistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterMediabaseServiceServer(s grpc.ServiceRegistrar, srv MediabaseServiceServer) {
-	// If the following call pancis, it indicates UnimplementedMediabaseServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _MediabaseService_FindObjectsByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindObjectsByTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&MediabaseService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(MediabaseServiceServer).FindObjectsByTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MediabaseService_FindObjectsByTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MediabaseServiceServer).FindObjectsByTag(ctx, req.(*FindObjectsByTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _MediabaseService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PingRequest)
+func _MediabaseService_ListObjectVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListObjectVersionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MediabaseServiceServer).Ping(ctx, in)
+		return srv.(MediabaseServiceServer).ListObjectVersions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MediabaseService_Ping_FullMethodName,
+		FullMethod: MediabaseService_ListObjectVersions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MediabaseServiceServer).Ping(ctx, req.(*PingRequest))
+		return srv.(MediabaseServiceServer).ListObjectVersions(ctx, req.(*ListObjectVersionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MediabaseService_PresignUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PresignUploadRequest)
+func _MediabaseService_ListUploadedParts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUploadedPartsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MediabaseServiceServer).PresignUpload(ctx, in)
+		return srv.(MediabaseServiceServer).ListUploadedParts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MediabaseService_PresignUpload_FullMethodName,
+		FullMethod: MediabaseService_ListUploadedParts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MediabaseServiceServer).PresignUpload(ctx, req.(*PresignUploadRequest))
+		return srv.(MediabaseServiceServer).ListUploadedParts(ctx, req.(*ListUploadedPartsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MediabaseService_PresignDownload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PresignDownloadRequest)
+func _MediabaseService_ConvertImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertImageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MediabaseServiceServer).PresignDownload(ctx, in)
+		return srv.(MediabaseServiceServer).ConvertImage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MediabaseService_PresignDownload_FullMethodName,
+		FullMethod: MediabaseService_ConvertImage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MediabaseServiceServer).PresignDownload(ctx, req.(*PresignDownloadRequest))
+		return srv.(MediabaseServiceServer).ConvertImage(ctx, req.(*ConvertImageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MediabaseService_DeleteObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteObjectRequest)
+func _MediabaseService_SanitizeImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SanitizeImageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MediabaseServiceServer).DeleteObject(ctx, in)
+		return srv.(MediabaseServiceServer).SanitizeImage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MediabaseService_DeleteObject_FullMethodName,
+		FullMethod: MediabaseService_SanitizeImage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MediabaseServiceServer).DeleteObject(ctx, req.(*DeleteObjectRequest))
+		return srv.(MediabaseServiceServer).SanitizeImage(ctx, req.(*SanitizeImageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MediabaseService_CreateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateBucketRequest)
+func _MediabaseService_UpdateCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCredentialsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MediabaseServiceServer).CreateBucket(ctx, in)
+		return srv.(MediabaseServiceServer).UpdateCredentials(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MediabaseService_CreateBucket_FullMethodName,
+		FullMethod: MediabaseService_UpdateCredentials_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MediabaseServiceServer).CreateBucket(ctx, req.(*CreateBucketRequest))
+		return srv.(MediabaseServiceServer).UpdateCredentials(ctx, req.(*UpdateCredentialsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -265,10 +1459,42 @@ var MediabaseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PresignUpload",
 			Handler:    _MediabaseService_PresignUpload_Handler,
 		},
+		{
+			MethodName: "PresignUploadBatch",
+			Handler:    _MediabaseService_PresignUploadBatch_Handler,
+		},
+		{
+			MethodName: "PreflightUpload",
+			Handler:    _MediabaseService_PreflightUpload_Handler,
+		},
 		{
 			MethodName: "PresignDownload",
 			Handler:    _MediabaseService_PresignDownload_Handler,
 		},
+		{
+			MethodName: "CreateOneTimeDownload",
+			Handler:    _MediabaseService_CreateOneTimeDownload_Handler,
+		},
+		{
+			MethodName: "RedeemDownload",
+			Handler:    _MediabaseService_RedeemDownload_Handler,
+		},
+		{
+			MethodName: "PresignHead",
+			Handler:    _MediabaseService_PresignHead_Handler,
+		},
+		{
+			MethodName: "PresignDelete",
+			Handler:    _MediabaseService_PresignDelete_Handler,
+		},
+		{
+			MethodName: "GetPublicURL",
+			Handler:    _MediabaseService_GetPublicURL_Handler,
+		},
+		{
+			MethodName: "GetUploadConstraints",
+			Handler:    _MediabaseService_GetUploadConstraints_Handler,
+		},
 		{
 			MethodName: "DeleteObject",
 			Handler:    _MediabaseService_DeleteObject_Handler,
@@ -277,7 +1503,110 @@ var MediabaseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateBucket",
 			Handler:    _MediabaseService_CreateBucket_Handler,
 		},
+		{
+			MethodName: "DeleteBucket",
+			Handler:    _MediabaseService_DeleteBucket_Handler,
+		},
+		{
+			MethodName: "PutObject",
+			Handler:    _MediabaseService_PutObject_Handler,
+		},
+		{
+			MethodName: "ConfirmUpload",
+			Handler:    _MediabaseService_ConfirmUpload_Handler,
+		},
+		{
+			MethodName: "CopyObject",
+			Handler:    _MediabaseService_CopyObject_Handler,
+		},
+		{
+			MethodName: "MoveObject",
+			Handler:    _MediabaseService_MoveObject_Handler,
+		},
+		{
+			MethodName: "RestoreObject",
+			Handler:    _MediabaseService_RestoreObject_Handler,
+		},
+		{
+			MethodName: "SetObjectACL",
+			Handler:    _MediabaseService_SetObjectACL_Handler,
+		},
+		{
+			MethodName: "GetObjectACL",
+			Handler:    _MediabaseService_GetObjectACL_Handler,
+		},
+		{
+			MethodName: "SetObjectRetention",
+			Handler:    _MediabaseService_SetObjectRetention_Handler,
+		},
+		{
+			MethodName: "SetObjectLegalHold",
+			Handler:    _MediabaseService_SetObjectLegalHold_Handler,
+		},
+		{
+			MethodName: "SetObjectTags",
+			Handler:    _MediabaseService_SetObjectTags_Handler,
+		},
+		{
+			MethodName: "GetObjectTags",
+			Handler:    _MediabaseService_GetObjectTags_Handler,
+		},
+		{
+			MethodName: "SetBucketVersioning",
+			Handler:    _MediabaseService_SetBucketVersioning_Handler,
+		},
+		{
+			MethodName: "SetBucketLifecycle",
+			Handler:    _MediabaseService_SetBucketLifecycle_Handler,
+		},
+		{
+			MethodName: "GetBucketStats",
+			Handler:    _MediabaseService_GetBucketStats_Handler,
+		},
+		{
+			MethodName: "GetObjectMetadata",
+			Handler:    _MediabaseService_GetObjectMetadata_Handler,
+		},
+		{
+			MethodName: "BatchObjectExists",
+			Handler:    _MediabaseService_BatchObjectExists_Handler,
+		},
+		{
+			MethodName: "FindObjectsByTag",
+			Handler:    _MediabaseService_FindObjectsByTag_Handler,
+		},
+		{
+			MethodName: "ListObjectVersions",
+			Handler:    _MediabaseService_ListObjectVersions_Handler,
+		},
+		{
+			MethodName: "ListUploadedParts",
+			Handler:    _MediabaseService_ListUploadedParts_Handler,
+		},
+		{
+			MethodName: "ConvertImage",
+			Handler:    _MediabaseService_ConvertImage_Handler,
+		},
+		{
+			MethodName: "SanitizeImage",
+			Handler:    _MediabaseService_SanitizeImage_Handler,
+		},
+		{
+			MethodName: "UpdateCredentials",
+			Handler:    _MediabaseService_UpdateCredentials_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadObject",
+			Handler:       _MediabaseService_UploadObject_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "GetObject",
+			Handler:       _MediabaseService_GetObject_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/mediabase/v1/mediabase.proto",
 }
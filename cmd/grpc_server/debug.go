@@ -0,0 +1,34 @@
+package grpc_server
+
+import (
+	"context"
+
+	"github.com/gofreego/mediabase/internal/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// debugMetadata returns the value of the debug logging metadata of a call, if any
+func debugMetadata(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(service.DebugHeader); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+// debugUnaryInterceptor turns on debug logging for unary calls that ask for it
+func debugUnaryInterceptor(svc *service.Service) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(svc.WithRequestDebug(ctx, debugMetadata(ctx)), req)
+	}
+}
+
+// debugStreamInterceptor turns on debug logging for streaming calls that ask for it
+func debugStreamInterceptor(svc *service.Service) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := svc.WithRequestDebug(ss.Context(), debugMetadata(ss.Context()))
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
@@ -4,32 +4,52 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/gofreego/mediabase/api/mediabase_v1"
 	"github.com/gofreego/mediabase/internal/configs"
 	"github.com/gofreego/mediabase/internal/service"
-	minioStorage "github.com/gofreego/mediabase/internal/storage/minio"
 
 	"github.com/gofreego/goutils/logger"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
 )
 
 type GRPCServer struct {
-	cfg    *configs.Configuration
-	server *grpc.Server
+	cfg     *configs.Configuration
+	server  *grpc.Server
+	service *service.Service
 }
 
 func (a *GRPCServer) Name() string {
 	return "GRPC_Server"
 }
 
+// Shutdown stops accepting RPCs and waits up to the drain timeout for in-flight
+// RPCs, including streaming uploads, before cancelling the remaining ones
 func (a *GRPCServer) Shutdown(ctx context.Context) {
-	a.server.GracefulStop()
+	stopped := make(chan struct{})
+	go func() {
+		a.server.GracefulStop()
+		close(stopped)
+	}()
+
+	timer := time.NewTimer(a.cfg.Server.GetDrainTimeout())
+	defer timer.Stop()
+
+	select {
+	case <-stopped:
+	case <-timer.C:
+		logger.Warn(ctx, "%s drain timed out with %d streaming operations still active", a.Name(), a.service.ActiveStreams())
+		a.server.Stop()
+	}
 }
 
-func NewGRPCServer(cfg *configs.Configuration) *GRPCServer {
+// NewGRPCServer serves the shared service, which the caller closes once every server is down
+func NewGRPCServer(cfg *configs.Configuration, svc *service.Service) *GRPCServer {
 	return &GRPCServer{
-		cfg: cfg,
+		cfg:     cfg,
+		service: svc,
 	}
 }
 
@@ -39,16 +59,20 @@ func (a *GRPCServer) Run(ctx context.Context) error {
 		logger.Panic(ctx, "grpc port is not provided")
 	}
 
-	// Initialize MinIO storage
-	storage, err := minioStorage.NewMinIOStorage(a.cfg.Storage)
+	service := a.service
+
+	creds, err := serverCredentials(a.cfg.Server.GRPCTLS)
 	if err != nil {
-		logger.Panic(ctx, "failed to initialize storage: %v", err)
+		logger.Panic(ctx, "failed to configure grpc transport security: %v", err)
 	}
 
-	service := service.NewService(ctx, &a.cfg.Service, storage)
-
 	// Create a new gRPC server
-	a.server = grpc.NewServer()
+	opts := append([]grpc.ServerOption{
+		grpc.Creds(creds),
+		grpc.ChainUnaryInterceptor(requestIDUnaryInterceptor, debugUnaryInterceptor(service)),
+		grpc.ChainStreamInterceptor(requestIDStreamInterceptor, debugStreamInterceptor(service)),
+	}, serverOptions(a.cfg.Server.GRPC)...)
+	a.server = grpc.NewServer(opts...)
 
 	mediabase_v1.RegisterMediabaseServiceServer(a.server, service)
 
@@ -66,3 +90,26 @@ func (a *GRPCServer) Run(ctx context.Context) error {
 	}
 	return nil
 }
+
+// serverOptions translates the configured message limits and keepalive settings into server options,
+// leaving gRPC defaults in place for unset values
+func serverOptions(cfg configs.GRPCConfig) []grpc.ServerOption {
+	var opts []grpc.ServerOption
+	if cfg.MaxRecvMsgSize > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(cfg.MaxRecvMsgSize))
+	}
+	if cfg.MaxSendMsgSize > 0 {
+		opts = append(opts, grpc.MaxSendMsgSize(cfg.MaxSendMsgSize))
+	}
+
+	opts = append(opts, grpc.KeepaliveParams(keepalive.ServerParameters{
+		Time:              cfg.KeepaliveTime,
+		Timeout:           cfg.KeepaliveTimeout,
+		MaxConnectionIdle: cfg.MaxConnectionIdle,
+	}))
+	opts = append(opts, grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+		MinTime:             cfg.KeepaliveMinTime,
+		PermitWithoutStream: cfg.PermitWithoutStream,
+	}))
+	return opts
+}
@@ -0,0 +1,58 @@
+package grpc_server
+
+import (
+	"context"
+
+	"github.com/gofreego/goutils/logger"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// requestIDHeader is the metadata key carrying the request ID, matching the X-Request-ID HTTP header
+const requestIDHeader = "x-request-id"
+
+// requestContext stores the caller's request ID, or a new one, in the context the way
+// logger.WithRequestMiddleware does for HTTP, so logger.RequestMiddleLayer adds it to log lines.
+// The ID is returned to the caller in the response header.
+func requestContext(ctx context.Context, method string) context.Context {
+	var requestID string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(requestIDHeader); len(values) > 0 {
+			requestID = values[0]
+		}
+	}
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+
+	rc := logger.RequestContext{RequestID: requestID, Method: method}
+	if p, ok := peer.FromContext(ctx); ok {
+		rc.IP = p.Addr.String()
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, requestID)); err != nil {
+		logger.Warn(ctx, "failed to send request id header: %v", err)
+	}
+	return context.WithValue(ctx, logger.RequestContextKey, rc)
+}
+
+// requestIDUnaryInterceptor attaches a request ID to unary calls
+func requestIDUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	return handler(requestContext(ctx, info.FullMethod), req)
+}
+
+// requestIDStreamInterceptor attaches a request ID to streaming calls
+func requestIDStreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &contextStream{ServerStream: ss, ctx: requestContext(ss.Context(), info.FullMethod)})
+}
+
+// contextStream overrides the context of a server stream
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,194 @@
+package http_server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofreego/mediabase/internal/service"
+	"github.com/gofreego/mediabase/internal/storage"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/status"
+)
+
+// downloadPath is the route of the streaming download proxy; object keys may contain slashes
+const downloadPath = "/api/download/{bucket_name}/{object_key=**}"
+
+// errUnsatisfiableRange is returned when a Range header lies outside the object
+var errUnsatisfiableRange = errors.New("range not satisfiable")
+
+// downloadHandler streams object bytes through the server for clients that cannot use presigned URLs.
+// With a configured signing secret, only URLs signed by PresignDownload are served; unsigned,
+// tampered and expired ones get 403 Forbidden before any storage access.
+// A single-range Range header is answered with 206 Partial Content so media players can seek.
+// If-None-Match and If-Modified-Since are answered with 304 Not Modified when the cached copy is
+// still current. The request context is cancelled when the client disconnects, which aborts the
+// storage read.
+func downloadHandler(svc *service.Service) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		ctx := r.Context()
+		bucketName, objectKey := pathParams["bucket_name"], pathParams["object_key"]
+
+		query := r.URL.Query()
+		if err := svc.VerifyProxyDownload(bucketName, objectKey, query.Get("expires"), query.Get("signature")); err != nil {
+			http.Error(w, status.Convert(err).Message(), http.StatusForbidden)
+			return
+		}
+
+		info, err := svc.StatObject(ctx, bucketName, objectKey)
+		if err != nil {
+			http.Error(w, status.Convert(err).Message(), runtime.HTTPStatusFromCode(status.Code(err)))
+			return
+		}
+
+		contentType := info.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Accept-Ranges", "bytes")
+		if info.ETag != "" {
+			w.Header().Set("ETag", `"`+strings.Trim(info.ETag, `"`)+`"`)
+		}
+		if cacheControl := info.UserMetadata[storage.CacheControlMetadataKey]; cacheControl != "" {
+			w.Header().Set("Cache-Control", cacheControl)
+		}
+		if !info.LastModified.IsZero() {
+			w.Header().Set("Last-Modified", info.LastModified.UTC().Format(http.TimeFormat))
+		}
+		if notModified(r, info) {
+			// The validators stay so the client can refresh its cached copy
+			w.Header().Del("Content-Type")
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		offset, length := int64(0), info.Size
+		statusCode := http.StatusOK
+		if header := r.Header.Get("Range"); header != "" {
+			start, n, ok, err := parseRange(header, info.Size)
+			if err != nil {
+				w.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", info.Size))
+				http.Error(w, err.Error(), http.StatusRequestedRangeNotSatisfiable)
+				return
+			}
+			if ok {
+				offset, length = start, n
+				statusCode = http.StatusPartialContent
+				w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, start+n-1, info.Size))
+			}
+		}
+		w.Header().Set("Content-Length", strconv.FormatInt(length, 10))
+
+		tw := &trackingWriter{ResponseWriter: w, status: statusCode}
+		streamLength := length
+		if statusCode == http.StatusOK {
+			// Stream to the end so the service can tell the whole object was sent
+			streamLength = -1
+		}
+		err = svc.DownloadObject(ctx, bucketName, objectKey, offset, streamLength, tw)
+		if err != nil && !tw.written {
+			w.Header().Del("Content-Range")
+			w.Header().Del("Content-Length")
+			http.Error(w, status.Convert(err).Message(), runtime.HTTPStatusFromCode(status.Code(err)))
+			return
+		}
+		if !tw.written {
+			// Empty objects produce no writes, so send the headers explicitly
+			w.WriteHeader(statusCode)
+		}
+	}
+}
+
+// notModified evaluates the cache validators of a request against the object. If-None-Match
+// takes precedence over If-Modified-Since, which is only compared at second precision since
+// Last-Modified has no finer resolution.
+func notModified(r *http.Request, info *storage.ObjectInfo) bool {
+	if header := r.Header.Get("If-None-Match"); header != "" {
+		etag := strings.Trim(info.ETag, `"`)
+		for _, candidate := range strings.Split(header, ",") {
+			candidate = strings.TrimSpace(candidate)
+			if candidate == "*" {
+				return true
+			}
+			// Weak comparison: W/ prefixes are ignored
+			candidate = strings.Trim(strings.TrimPrefix(candidate, "W/"), `"`)
+			if etag != "" && candidate == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	if header := r.Header.Get("If-Modified-Since"); header != "" && !info.LastModified.IsZero() {
+		since, err := http.ParseTime(header)
+		if err != nil {
+			return false
+		}
+		return !info.LastModified.Truncate(time.Second).After(since)
+	}
+	return false
+}
+
+// parseRange parses a single "bytes=" range against the object size and returns the start and length.
+// ok is false when the header should be ignored and the whole object served, which includes
+// multi-range requests and other units.
+func parseRange(header string, size int64) (start, length int64, ok bool, err error) {
+	spec, found := strings.CutPrefix(header, "bytes=")
+	if !found || strings.Contains(spec, ",") {
+		return 0, 0, false, nil
+	}
+
+	first, last, found := strings.Cut(strings.TrimSpace(spec), "-")
+	if !found {
+		return 0, 0, false, errUnsatisfiableRange
+	}
+
+	if first == "" {
+		// Suffix range: the last n bytes
+		n, err := strconv.ParseInt(last, 10, 64)
+		if err != nil || n <= 0 || size == 0 {
+			return 0, 0, false, errUnsatisfiableRange
+		}
+		if n > size {
+			n = size
+		}
+		return size - n, n, true, nil
+	}
+
+	start, err = strconv.ParseInt(first, 10, 64)
+	if err != nil || start < 0 || start >= size {
+		return 0, 0, false, errUnsatisfiableRange
+	}
+	end := size - 1
+	if last != "" {
+		end, err = strconv.ParseInt(last, 10, 64)
+		if err != nil || end < start {
+			return 0, 0, false, errUnsatisfiableRange
+		}
+		if end >= size {
+			end = size - 1
+		}
+	}
+	return start, end - start + 1, true, nil
+}
+
+// trackingWriter records whether any body bytes were sent, since an error
+// status can no longer be reported once streaming has started
+type trackingWriter struct {
+	http.ResponseWriter
+	status  int
+	written bool
+}
+
+func (t *trackingWriter) Write(p []byte) (int, error) {
+	if !t.written {
+		t.written = true
+		t.ResponseWriter.WriteHeader(t.status)
+	}
+	return t.ResponseWriter.Write(p)
+}
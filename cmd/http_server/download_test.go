@@ -0,0 +1,259 @@
+package http_server
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"github.com/gofreego/mediabase/internal/service"
+	"github.com/gofreego/mediabase/internal/storage"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+// objectModified is the modification time of every object served by objectStorage
+var objectModified = time.Date(2026, 3, 1, 12, 30, 45, 0, time.UTC)
+
+// objectStorage serves objects of one bucket; the methods the proxy does not use panic
+type objectStorage struct {
+	storage.Storage
+	objects map[string][]byte
+	stats   int
+	gets    int
+}
+
+func (o *objectStorage) ObjectExists(ctx context.Context, bucketName, objectKey string) (bool, error) {
+	_, ok := o.objects[objectKey]
+	return ok, nil
+}
+
+func (o *objectStorage) GetObjectRange(ctx context.Context, bucketName, objectKey string, offset, length int64) (io.ReadCloser, int64, error) {
+	o.gets++
+	data, ok := o.objects[objectKey]
+	if !ok {
+		return nil, 0, storage.ErrObjectNotFound
+	}
+	end := int64(len(data))
+	if length >= 0 {
+		end = min(end, offset+length)
+	}
+	return io.NopCloser(bytes.NewReader(data[offset:end])), int64(len(data)), nil
+}
+
+func (o *objectStorage) DeleteObject(ctx context.Context, bucketName, objectKey string) error {
+	delete(o.objects, objectKey)
+	return nil
+}
+
+func (o *objectStorage) StatObject(ctx context.Context, bucketName, objectKey string) (*storage.ObjectInfo, error) {
+	o.stats++
+	data, ok := o.objects[objectKey]
+	if !ok {
+		return nil, storage.ErrObjectNotFound
+	}
+	sum := md5.Sum(data)
+	return &storage.ObjectInfo{Key: objectKey, Size: int64(len(data)), ContentType: "image/png", ETag: hex.EncodeToString(sum[:]), LastModified: objectModified}, nil
+}
+
+func (o *objectStorage) GetObject(ctx context.Context, bucketName, objectKey string) (io.ReadCloser, error) {
+	o.gets++
+	data, ok := o.objects[objectKey]
+	if !ok {
+		return nil, storage.ErrObjectNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (o *objectStorage) Capabilities() storage.Capabilities {
+	return storage.Capabilities{}
+}
+
+func downloadTestConfig() service.Config {
+	return service.Config{
+		MaxFileSize:         1 << 20,
+		AllowedContentTypes: []string{"image/png"},
+	}
+}
+
+// newDownloadServer serves the download proxy with cfg
+func newDownloadServer(t *testing.T, cfg service.Config, st storage.Storage) (*service.Service, *httptest.Server) {
+	t.Helper()
+	svc := service.NewService(context.Background(), &cfg, st)
+
+	mux := runtime.NewServeMux()
+	if err := mux.HandlePath(http.MethodGet, downloadPath, downloadHandler(svc)); err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return svc, server
+}
+
+func get(t *testing.T, rawURL string) (int, string) {
+	t.Helper()
+	resp, err := http.Get(rawURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, string(body)
+}
+
+func TestDownloadProxyAutoDeletesOnlyAfterFullDownload(t *testing.T) {
+	st := &objectStorage{objects: map[string][]byte{"once/a.png": []byte("0123456789")}}
+	cfg := downloadTestConfig()
+	cfg.AutoDeleteOnDownloadPrefixes = []string{"once/"}
+	_, server := newDownloadServer(t, cfg, st)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/api/download/media/once/a.png", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Range", "bytes=0-4")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusPartialContent {
+		t.Fatalf("range request: status %d, want 206", resp.StatusCode)
+	}
+	if _, ok := st.objects["once/a.png"]; !ok {
+		t.Fatal("object deleted after a partial download")
+	}
+
+	if code, body := get(t, server.URL+"/api/download/media/once/a.png"); code != http.StatusOK || body != "0123456789" {
+		t.Fatalf("full download: status %d, body %q", code, body)
+	}
+	// The delete follows the response; closing waits for the handler to finish
+	server.Close()
+	if _, ok := st.objects["once/a.png"]; ok {
+		t.Error("object kept after a full download")
+	}
+}
+
+// proxyTestConfig signs proxy URLs
+func proxyTestConfig() service.Config {
+	cfg := downloadTestConfig()
+	cfg.DefaultBucket = "media"
+	cfg.ProxyDownload = service.ProxyDownloadConfig{Secret: "0123456789abcdef0123456789abcdef"}
+	return cfg
+}
+
+func TestDownloadProxyServesSignedURLs(t *testing.T) {
+	st := &objectStorage{objects: map[string][]byte{"users/a b/c.png": []byte("png")}}
+	svc, server := newDownloadServer(t, proxyTestConfig(), st)
+
+	resp, err := svc.PresignDownload(context.Background(), &mediabase_v1.PresignDownloadRequest{ObjectKey: "users/a b/c.png", Proxy: true})
+	if err != nil {
+		t.Fatalf("PresignDownload: %v", err)
+	}
+	code, body := get(t, server.URL+resp.PresignedUrl)
+	if code != http.StatusOK || body != "png" {
+		t.Errorf("signed URL: status %d, body %q, want 200 with the object", code, body)
+	}
+}
+
+func TestDownloadProxyRejectsUnsignedAndTamperedURLs(t *testing.T) {
+	st := &objectStorage{objects: map[string][]byte{"a.png": []byte("png"), "b.png": []byte("secret")}}
+	svc, server := newDownloadServer(t, proxyTestConfig(), st)
+
+	resp, err := svc.PresignDownload(context.Background(), &mediabase_v1.PresignDownloadRequest{ObjectKey: "a.png", Proxy: true})
+	if err != nil {
+		t.Fatalf("PresignDownload: %v", err)
+	}
+	signed, err := url.Parse(resp.PresignedUrl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := signed.Query()
+	stats := st.stats
+
+	extended := url.Values{"expires": {"99999999999"}, "signature": {query.Get("signature")}}
+	for name, rawURL := range map[string]string{
+		"unsigned":        "/api/download/media/a.png",
+		"other key":       strings.Replace(resp.PresignedUrl, "a.png", "b.png", 1),
+		"extended expiry": signed.Path + "?" + extended.Encode(),
+		"bad signature":   signed.Path + "?expires=" + query.Get("expires") + "&signature=AAAA",
+	} {
+		code, _ := get(t, server.URL+rawURL)
+		if code != http.StatusForbidden {
+			t.Errorf("%s: status %d, want 403", name, code)
+		}
+	}
+	if st.stats != stats {
+		t.Error("rejected requests reached storage")
+	}
+}
+
+func TestDownloadProxyAnswersConditionalRequests(t *testing.T) {
+	st := &objectStorage{objects: map[string][]byte{"a.png": []byte("png")}}
+	_, server := newDownloadServer(t, downloadTestConfig(), st)
+	objectURL := server.URL + "/api/download/media/a.png"
+
+	resp, err := http.Get(objectURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	etag, lastModified := resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+	if etag == "" || lastModified != objectModified.Format(http.TimeFormat) {
+		t.Fatalf("validators ETag %q, Last-Modified %q, want both set from the object", etag, lastModified)
+	}
+
+	for _, tc := range []struct {
+		name    string
+		headers map[string]string
+		want    int
+	}{
+		{"matching etag", map[string]string{"If-None-Match": etag}, http.StatusNotModified},
+		{"weak etag in a list", map[string]string{"If-None-Match": `"other", W/` + etag}, http.StatusNotModified},
+		{"any etag", map[string]string{"If-None-Match": "*"}, http.StatusNotModified},
+		{"other etag", map[string]string{"If-None-Match": `"other"`}, http.StatusOK},
+		{"not modified since", map[string]string{"If-Modified-Since": lastModified}, http.StatusNotModified},
+		{"modified since", map[string]string{"If-Modified-Since": objectModified.Add(-time.Second).Format(http.TimeFormat)}, http.StatusOK},
+		{"unparseable date", map[string]string{"If-Modified-Since": "yesterday"}, http.StatusOK},
+		// If-None-Match decides when both are sent
+		{"etag over date", map[string]string{"If-None-Match": `"other"`, "If-Modified-Since": lastModified}, http.StatusOK},
+	} {
+		req, err := http.NewRequest(http.MethodGet, objectURL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for name, value := range tc.headers {
+			req.Header.Set(name, value)
+		}
+		gets := st.gets
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		if resp.StatusCode != tc.want {
+			t.Errorf("%s: status %d, want %d", tc.name, resp.StatusCode, tc.want)
+			continue
+		}
+		if tc.want == http.StatusNotModified {
+			if len(body) != 0 || st.gets != gets {
+				t.Errorf("%s: 304 sent %d body bytes after %d reads, want none", tc.name, len(body), st.gets-gets)
+			}
+			if resp.Header.Get("ETag") != etag {
+				t.Errorf("%s: 304 without the ETag", tc.name)
+			}
+		} else if string(body) != "png" {
+			t.Errorf("%s: body %q, want the object", tc.name, body)
+		}
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"github.com/gofreego/mediabase/api/mediabase_v1"
 	"github.com/gofreego/mediabase/internal/configs"
 	"github.com/gofreego/mediabase/internal/service"
-	minioStorage "github.com/gofreego/mediabase/internal/storage/minio"
 
 	"github.com/gofreego/goutils/api"
 	"github.com/gofreego/goutils/api/debug"
@@ -18,23 +17,34 @@ import (
 )
 
 type HTTPServer struct {
-	cfg    *configs.Configuration
-	server *http.Server
+	cfg     *configs.Configuration
+	server  *http.Server
+	service *service.Service
 }
 
 func (a *HTTPServer) Name() string {
 	return "HTTP_Server"
 }
 
+// Shutdown stops accepting requests and waits up to the drain timeout for in-flight
+// requests, including streaming downloads, before closing the remaining connections
 func (a *HTTPServer) Shutdown(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, a.cfg.Server.GetDrainTimeout())
+	defer cancel()
+
 	if err := a.server.Shutdown(ctx); err != nil {
-		logger.Panic(ctx, "failed to shutdown %s : %v", a.Name(), err)
+		logger.Warn(ctx, "%s drain timed out with %d streaming operations still active: %v", a.Name(), a.service.ActiveStreams(), err)
+		if err := a.server.Close(); err != nil {
+			logger.Error(ctx, "failed to close %s : %v", a.Name(), err)
+		}
 	}
 }
 
-func NewHTTPServer(cfg *configs.Configuration) *HTTPServer {
+// NewHTTPServer serves the shared service, which the caller closes once every server is down
+func NewHTTPServer(cfg *configs.Configuration, svc *service.Service) *HTTPServer {
 	return &HTTPServer{
-		cfg: cfg,
+		cfg:     cfg,
+		service: svc,
 	}
 }
 
@@ -44,22 +54,21 @@ func (a *HTTPServer) Run(ctx context.Context) error {
 		logger.Panic(ctx, "http port is not provided")
 	}
 
-	// Initialize MinIO storage
-	storage, err := minioStorage.NewMinIOStorage(a.cfg.Storage)
-	if err != nil {
-		logger.Panic(ctx, "failed to initialize storage: %v", err)
-	}
-
-	service := service.NewService(ctx, &a.cfg.Service, storage)
+	service := a.service
 
 	mux := runtime.NewServeMux()
 
 	api.RegisterSwaggerHandler(ctx, mux, "/mediabase/v1/swagger", "./api/docs/proto", "/mediabase/v1/mediabase.swagger.json")
-	err = mediabase_v1.RegisterMediabaseServiceHandlerServer(ctx, mux, service)
+	err := mediabase_v1.RegisterMediabaseServiceHandlerServer(ctx, mux, service)
 	if err != nil {
 		logger.Panic(ctx, "failed to register ping service : %v", err)
 	}
 
+	err = mux.HandlePath(http.MethodGet, downloadPath, downloadHandler(service))
+	if err != nil {
+		logger.Panic(ctx, "failed to register download handler : %v", err)
+	}
+
 	// Register debug endpoints if enabled
 	if a.cfg.Debug.Enabled {
 		debug.RegisterDebugHandlersWithGateway(ctx, &a.cfg.Debug, mux, a.cfg.Logger.AppName, string(a.cfg.Logger.Build), "/mediabase/v1")
@@ -77,7 +86,7 @@ func (a *HTTPServer) Run(ctx context.Context) error {
 
 	a.server = &http.Server{
 		Addr:    fmt.Sprintf(":%d", a.cfg.Server.HTTPPort),
-		Handler: logger.WithRequestMiddleware(logger.WithRequestTimeMiddleware(api.CORSMiddleware(rootHandler))),
+		Handler: logger.WithRequestMiddleware(withRequestIDHeader(withDebugLogging(service, logger.WithRequestTimeMiddleware(api.CORSMiddleware(rootHandler))))),
 	}
 
 	logger.Info(ctx, "Starting HTTP server on port %d", a.cfg.Server.HTTPPort)
@@ -92,3 +101,24 @@ func (a *HTTPServer) Run(ctx context.Context) error {
 	}
 	return nil
 }
+
+// withRequestIDHeader returns the request ID assigned by logger.WithRequestMiddleware in the
+// X-Request-ID response header, so clients can quote it when reporting a failed request
+func withRequestIDHeader(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if rc, ok := r.Context().Value(logger.RequestContextKey).(logger.RequestContext); ok {
+			w.Header().Set("X-Request-ID", rc.RequestID)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// withDebugLogging turns on debug logging for requests that ask for it with the debug header
+func withDebugLogging(svc *service.Service, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if value := r.Header.Get(service.DebugHeader); value != "" {
+			r = r.WithContext(svc.WithRequestDebug(r.Context(), value))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
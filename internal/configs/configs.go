@@ -3,9 +3,11 @@ package configs
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/gofreego/mediabase/internal/service"
 	"github.com/gofreego/mediabase/internal/storage"
+	"github.com/gofreego/mediabase/internal/storage/router"
 
 	"github.com/gofreego/goutils/api/debug"
 	"github.com/gofreego/goutils/configutils"
@@ -21,11 +23,58 @@ type Configuration struct {
 	Service      service.Config     `yaml:"Service"`
 	Debug        debug.Config       `yaml:"Debug"`
 	Storage      storage.Config     `yaml:"Storage"`
+	// StorageRoutes sends some buckets or content types to other backends than Storage
+	StorageRoutes []router.RouteConfig `yaml:"StorageRoutes"`
 }
 
 type Server struct {
-	GRPCPort int `yaml:"GRPCPort"`
-	HTTPPort int `yaml:"HTTPPort"`
+	GRPCPort int        `yaml:"GRPCPort"`
+	HTTPPort int        `yaml:"HTTPPort"`
+	GRPCTLS  TLSConfig  `yaml:"GRPCTLS"`
+	GRPC     GRPCConfig `yaml:"GRPC"`
+	// DrainTimeout is how long shutdown waits for in-flight requests and streams (default 30s)
+	DrainTimeout time.Duration `yaml:"DrainTimeout"`
+}
+
+// defaultDrainTimeout is used when Server.DrainTimeout is not configured
+const defaultDrainTimeout = 30 * time.Second
+
+// GetDrainTimeout returns the configured drain timeout or the default
+func (s Server) GetDrainTimeout() time.Duration {
+	if s.DrainTimeout > 0 {
+		return s.DrainTimeout
+	}
+	return defaultDrainTimeout
+}
+
+// GRPCConfig tunes message size limits and keepalive of the gRPC server.
+// Zero values fall back to the defaults noted on each field.
+type GRPCConfig struct {
+	// MaxRecvMsgSize is the largest message the server accepts in bytes (default 4MB)
+	MaxRecvMsgSize int `yaml:"MaxRecvMsgSize"`
+	// MaxSendMsgSize is the largest message the server sends in bytes (default unlimited)
+	MaxSendMsgSize int `yaml:"MaxSendMsgSize"`
+	// KeepaliveTime is how long a connection may be idle before the server pings the client (default 2h)
+	KeepaliveTime time.Duration `yaml:"KeepaliveTime"`
+	// KeepaliveTimeout is how long the server waits for a ping ack before closing the connection (default 20s)
+	KeepaliveTimeout time.Duration `yaml:"KeepaliveTimeout"`
+	// MaxConnectionIdle closes connections without active RPCs after this long (default infinite)
+	MaxConnectionIdle time.Duration `yaml:"MaxConnectionIdle"`
+	// KeepaliveMinTime is the minimum interval clients may send pings at (default 5m)
+	KeepaliveMinTime time.Duration `yaml:"KeepaliveMinTime"`
+	// PermitWithoutStream allows client pings while no RPC is active
+	PermitWithoutStream bool `yaml:"PermitWithoutStream"`
+}
+
+// TLSConfig configures transport security for a server
+type TLSConfig struct {
+	// CertFile and KeyFile are the PEM-encoded server certificate and private key
+	CertFile string `yaml:"CertFile"`
+	KeyFile  string `yaml:"KeyFile"`
+	// ClientCAFile enables mutual TLS: clients must present a certificate signed by one of these CAs
+	ClientCAFile string `yaml:"ClientCAFile"`
+	// Insecure serves plaintext when no certificate is configured; only meant for local development
+	Insecure bool `yaml:"Insecure"`
 }
 
 func LoadConfig(ctx context.Context, path string, env string) *Configuration {
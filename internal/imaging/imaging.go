@@ -0,0 +1,121 @@
+package imaging
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+
+	"github.com/HugoSmits86/nativewebp"
+	"golang.org/x/image/tiff"
+)
+
+// Format identifies an image encoding supported by the pipeline
+type Format string
+
+const (
+	FormatJPEG Format = "jpeg"
+	FormatPNG  Format = "png"
+	FormatWebP Format = "webp"
+	FormatTIFF Format = "tiff"
+)
+
+// DefaultJPEGQuality is used when no explicit quality is requested for lossy output
+const DefaultJPEGQuality = 85
+
+// contentTypes maps supported formats to their MIME types
+var contentTypes = map[Format]string{
+	FormatJPEG: "image/jpeg",
+	FormatPNG:  "image/png",
+	FormatWebP: "image/webp",
+	FormatTIFF: "image/tiff",
+}
+
+// extensions maps supported formats to their canonical file extensions
+var extensions = map[Format]string{
+	FormatJPEG: ".jpg",
+	FormatPNG:  ".png",
+	FormatWebP: ".webp",
+	FormatTIFF: ".tiff",
+}
+
+// ParseFormat converts a format name (e.g. "jpeg", "jpg", "png", "webp", "tiff") into a Format
+func ParseFormat(name string) (Format, error) {
+	switch name {
+	case "jpeg", "jpg":
+		return FormatJPEG, nil
+	case "png":
+		return FormatPNG, nil
+	case "webp":
+		return FormatWebP, nil
+	case "tiff", "tif":
+		return FormatTIFF, nil
+	}
+	return "", fmt.Errorf("unsupported image format: %s", name)
+}
+
+// FormatFromContentType returns the format for a MIME type
+func FormatFromContentType(contentType string) (Format, bool) {
+	for f, ct := range contentTypes {
+		if ct == contentType {
+			return f, true
+		}
+	}
+	return "", false
+}
+
+// ContentType returns the MIME type of the format
+func (f Format) ContentType() string {
+	return contentTypes[f]
+}
+
+// Extension returns the canonical file extension of the format, including the leading dot
+func (f Format) Extension() string {
+	return extensions[f]
+}
+
+// Decode parses an encoded image, rejecting inputs larger than maxPixels before decoding pixel data.
+// It returns the decoded image and its detected format.
+func Decode(data []byte, maxPixels int64) (image.Image, Format, error) {
+	cfg, name, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read image header: %w", err)
+	}
+
+	format, err := ParseFormat(name)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if maxPixels > 0 && int64(cfg.Width)*int64(cfg.Height) > maxPixels {
+		return nil, "", fmt.Errorf("image dimensions %dx%d exceed the maximum of %d pixels", cfg.Width, cfg.Height, maxPixels)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to decode image: %w", err)
+	}
+	return img, format, nil
+}
+
+// Encode writes the image in the given format.
+// Quality (1-100) only applies to lossy formats; zero selects the default.
+func Encode(w io.Writer, img image.Image, format Format, quality int) error {
+	switch format {
+	case FormatJPEG:
+		if quality == 0 {
+			quality = DefaultJPEGQuality
+		}
+		return jpeg.Encode(w, img, &jpeg.Options{Quality: quality})
+	case FormatPNG:
+		return png.Encode(w, img)
+	case FormatWebP:
+		// Pure-Go WebP encoding is lossless, so quality does not apply
+		return nativewebp.Encode(w, img, nil)
+	case FormatTIFF:
+		return tiff.Encode(w, img, &tiff.Options{Compression: tiff.Deflate})
+	}
+	return fmt.Errorf("unsupported image format: %s", format)
+}
@@ -0,0 +1,239 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+)
+
+// JPEG markers relevant to metadata stripping
+const (
+	markerSOI   = 0xD8
+	markerSOS   = 0xDA
+	markerAPP0  = 0xE0
+	markerAPP1  = 0xE1
+	markerAPP2  = 0xE2
+	markerAPP14 = 0xEE
+	markerCOM   = 0xFE
+
+	tagOrientation = 0x0112
+)
+
+var exifHeader = []byte("Exif\x00\x00")
+
+// StripMetadata removes privacy-sensitive metadata from a JPEG or TIFF image and verifies
+// the result still decodes to an image of the expected size before returning it
+func StripMetadata(data []byte, maxPixels int64) ([]byte, Format, error) {
+	cfg, name, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read image header: %w", err)
+	}
+
+	var stripped []byte
+	format := Format(name)
+	switch format {
+	case FormatJPEG:
+		stripped, err = StripJPEGMetadata(data)
+	case FormatTIFF:
+		stripped, err = StripTIFFMetadata(data, maxPixels)
+	default:
+		return nil, format, fmt.Errorf("metadata stripping is only supported for JPEG and TIFF images, got %s", name)
+	}
+	if err != nil {
+		return nil, format, err
+	}
+
+	// The sanitized image must fully decode with the same pixel area (TIFF may be rotated)
+	img, resultFormat, err := Decode(stripped, maxPixels)
+	if err != nil {
+		return nil, format, fmt.Errorf("sanitized image is invalid: %w", err)
+	}
+	b := img.Bounds()
+	if resultFormat != format || b.Dx()*b.Dy() != cfg.Width*cfg.Height {
+		return nil, format, fmt.Errorf("sanitized image does not match the original")
+	}
+	return stripped, format, nil
+}
+
+// StripJPEGMetadata removes EXIF, XMP, IPTC and comment segments from a JPEG without
+// touching the compressed pixel data. JFIF, ICC profile and Adobe segments are kept since
+// they affect how pixels are rendered, and the EXIF orientation is carried over in a
+// minimal EXIF segment so the image keeps displaying the right way up.
+func StripJPEGMetadata(data []byte) ([]byte, error) {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != markerSOI {
+		return nil, errors.New("not a JPEG image")
+	}
+
+	var out bytes.Buffer
+	out.Write(data[:2])
+
+	var kept [][]byte
+	orientation := 0
+	pos := 2
+	for {
+		if pos+4 > len(data) || data[pos] != 0xFF {
+			return nil, fmt.Errorf("malformed JPEG segment at offset %d", pos)
+		}
+		// markers may be preceded by fill bytes
+		if data[pos+1] == 0xFF {
+			pos++
+			continue
+		}
+		marker := data[pos+1]
+		if marker == markerSOS {
+			break
+		}
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		end := pos + 2 + length
+		if length < 2 || end > len(data) {
+			return nil, fmt.Errorf("malformed JPEG segment length at offset %d", pos)
+		}
+		segment := data[pos:end]
+		payload := data[pos+4 : end]
+
+		switch {
+		case marker == markerAPP1 && bytes.HasPrefix(payload, exifHeader):
+			if o, err := readTIFFOrientation(payload[len(exifHeader):]); err == nil {
+				orientation = o
+			}
+		case marker == markerAPP0, marker == markerAPP2, marker == markerAPP14:
+			kept = append(kept, segment)
+		case marker >= markerAPP0 && marker <= 0xEF, marker == markerCOM:
+			// other application segments and comments carry metadata only
+		default:
+			kept = append(kept, segment)
+		}
+		pos = end
+	}
+
+	// JFIF must stay first, then the orientation-only EXIF segment
+	if len(kept) > 0 && kept[0][1] == markerAPP0 {
+		out.Write(kept[0])
+		kept = kept[1:]
+	}
+	if orientation > 1 {
+		out.Write(orientationSegment(orientation))
+	}
+	for _, segment := range kept {
+		out.Write(segment)
+	}
+	out.Write(data[pos:])
+	return out.Bytes(), nil
+}
+
+// orientationSegment builds an APP1 EXIF segment holding only the orientation tag
+func orientationSegment(orientation int) []byte {
+	var b bytes.Buffer
+	b.Write([]byte{0xFF, markerAPP1, 0x00, 0x22})
+	b.Write(exifHeader)
+	b.Write([]byte{'M', 'M', 0x00, 0x2A, 0x00, 0x00, 0x00, 0x08})   // big-endian TIFF header, IFD0 at offset 8
+	b.Write([]byte{0x00, 0x01})                                     // one entry
+	b.Write([]byte{0x01, 0x12, 0x00, 0x03, 0x00, 0x00, 0x00, 0x01}) // orientation, SHORT, count 1
+	b.Write([]byte{0x00, byte(orientation), 0x00, 0x00})
+	b.Write([]byte{0x00, 0x00, 0x00, 0x00}) // no next IFD
+	return b.Bytes()
+}
+
+// readTIFFOrientation reads the orientation tag from the first IFD of a TIFF structure
+func readTIFFOrientation(data []byte) (int, error) {
+	if len(data) < 8 {
+		return 0, errors.New("TIFF header too short")
+	}
+	var order binary.ByteOrder
+	switch string(data[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 0, errors.New("invalid TIFF byte order")
+	}
+
+	ifd := int(order.Uint32(data[4:]))
+	if ifd+2 > len(data) {
+		return 0, errors.New("IFD offset out of range")
+	}
+	count := int(order.Uint16(data[ifd:]))
+	for i := 0; i < count; i++ {
+		entry := ifd + 2 + i*12
+		if entry+12 > len(data) {
+			break
+		}
+		if order.Uint16(data[entry:]) == tagOrientation {
+			orientation := int(order.Uint16(data[entry+8:]))
+			if orientation < 1 || orientation > 8 {
+				return 0, fmt.Errorf("invalid orientation %d", orientation)
+			}
+			return orientation, nil
+		}
+	}
+	return 1, nil
+}
+
+// StripTIFFMetadata re-encodes a TIFF losslessly with baseline tags only, dropping EXIF,
+// GPS and other private IFDs. Since baseline output has no orientation tag, the original
+// orientation is applied to the pixels.
+func StripTIFFMetadata(data []byte, maxPixels int64) ([]byte, error) {
+	img, format, err := Decode(data, maxPixels)
+	if err != nil {
+		return nil, err
+	}
+	if format != FormatTIFF {
+		return nil, errors.New("not a TIFF image")
+	}
+
+	orientation, err := readTIFFOrientation(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	if err := Encode(&out, applyOrientation(img, orientation), FormatTIFF, 0); err != nil {
+		return nil, fmt.Errorf("failed to encode TIFF: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+// applyOrientation transforms the image so it displays upright for the given EXIF orientation
+func applyOrientation(img image.Image, orientation int) image.Image {
+	if orientation <= 1 {
+		return img
+	}
+
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+
+	src := image.NewNRGBA(image.Rect(0, 0, w, h))
+	draw.Draw(src, src.Bounds(), img, b.Min, draw.Src)
+	dst := image.NewNRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2: // mirrored horizontally
+				sx, sy = w-1-x, y
+			case 3: // rotated 180
+				sx, sy = w-1-x, h-1-y
+			case 4: // mirrored vertically
+				sx, sy = x, h-1-y
+			case 5: // transposed
+				sx, sy = y, x
+			case 6: // rotate 90 clockwise to display
+				sx, sy = y, h-1-x
+			case 7: // transversed
+				sx, sy = w-1-y, h-1-x
+			case 8: // rotate 90 counter-clockwise to display
+				sx, sy = w-1-y, x
+			}
+			dst.SetNRGBA(x, y, src.NRGBAAt(sx, sy))
+		}
+	}
+	return dst
+}
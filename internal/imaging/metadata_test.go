@@ -0,0 +1,177 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+// testImage is a small image with distinct corners, so rotations can be told apart
+func testImage(w, h int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.SetNRGBA(x, y, color.NRGBA{R: uint8(x * 40), G: uint8(y * 40), B: 128, A: 255})
+		}
+	}
+	return img
+}
+
+// segment builds a JPEG marker segment around the payload
+func segment(marker byte, payload []byte) []byte {
+	b := []byte{0xFF, marker, 0, 0}
+	binary.BigEndian.PutUint16(b[2:], uint16(len(payload)+2))
+	return append(b, payload...)
+}
+
+// exifPayload is an EXIF block with the orientation and a GPS marker string in IFD0
+func exifPayload(orientation int) []byte {
+	var b bytes.Buffer
+	b.Write(exifHeader)
+	b.Write([]byte{'I', 'I', 0x2A, 0x00, 0x08, 0x00, 0x00, 0x00})
+	b.Write([]byte{0x02, 0x00})
+	b.Write([]byte{0x12, 0x01, 0x03, 0x00, 0x01, 0x00, 0x00, 0x00, byte(orientation), 0x00, 0x00, 0x00})
+	// an ASCII tag stored after the IFD, standing in for camera and location data
+	b.Write([]byte{0x0F, 0x01, 0x02, 0x00, 0x0C, 0x00, 0x00, 0x00, 0x26, 0x00, 0x00, 0x00})
+	b.Write([]byte{0x00, 0x00, 0x00, 0x00})
+	b.WriteString("GPS 52.5 13.")
+	return b.Bytes()
+}
+
+// jpegWithMetadata encodes a JPEG and inserts EXIF, XMP and comment segments after SOI
+func jpegWithMetadata(t *testing.T, orientation int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, testImage(4, 3), nil); err != nil {
+		t.Fatal(err)
+	}
+	plain := buf.Bytes()
+
+	var out bytes.Buffer
+	out.Write(plain[:2])
+	out.Write(segment(markerAPP1, exifPayload(orientation)))
+	out.Write(segment(markerAPP1, []byte("http://ns.adobe.com/xap/1.0/\x00<x:xmpmeta>secret</x:xmpmeta>")))
+	out.Write(segment(markerCOM, []byte("taken at home")))
+	out.Write(plain[2:])
+	return out.Bytes()
+}
+
+// scanData returns the bytes from the SOS marker on, which hold the compressed pixels
+func scanData(t *testing.T, data []byte) []byte {
+	t.Helper()
+	i := bytes.Index(data, []byte{0xFF, markerSOS})
+	if i < 0 {
+		t.Fatal("no SOS marker")
+	}
+	return data[i:]
+}
+
+func TestStripJPEGMetadata(t *testing.T) {
+	data := jpegWithMetadata(t, 6)
+
+	stripped, format, err := StripMetadata(data, 1<<20)
+	if err != nil {
+		t.Fatalf("StripMetadata: %v", err)
+	}
+	if format != FormatJPEG {
+		t.Errorf("format = %s, want jpeg", format)
+	}
+	for _, secret := range []string{"GPS", "xmpmeta", "taken at home"} {
+		if bytes.Contains(stripped, []byte(secret)) {
+			t.Errorf("stripped image still contains %q", secret)
+		}
+	}
+	if !bytes.Equal(scanData(t, stripped), scanData(t, data)) {
+		t.Error("compressed pixel data changed")
+	}
+
+	// Only the orientation survives, in a minimal EXIF segment
+	i := bytes.Index(stripped, exifHeader)
+	if i < 0 {
+		t.Fatal("orientation segment missing")
+	}
+	if orientation, err := readTIFFOrientation(stripped[i+len(exifHeader):]); err != nil || orientation != 6 {
+		t.Errorf("orientation = %d, %v, want 6", orientation, err)
+	}
+}
+
+func TestStripJPEGMetadataWithoutOrientation(t *testing.T) {
+	stripped, err := StripJPEGMetadata(jpegWithMetadata(t, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(stripped, exifHeader) {
+		t.Error("upright image got an EXIF segment")
+	}
+	if _, err := jpeg.Decode(bytes.NewReader(stripped)); err != nil {
+		t.Errorf("stripped image does not decode: %v", err)
+	}
+}
+
+func TestStripMetadataRejectsUnsupportedAndBrokenImages(t *testing.T) {
+	var pngData bytes.Buffer
+	if err := png.Encode(&pngData, testImage(2, 2)); err != nil {
+		t.Fatal(err)
+	}
+	data := jpegWithMetadata(t, 1)
+
+	for name, input := range map[string][]byte{
+		"png":       pngData.Bytes(),
+		"not image": []byte("hello"),
+		"truncated": data[:len(data)/2],
+	} {
+		if _, _, err := StripMetadata(input, 1<<20); err == nil {
+			t.Errorf("%s: stripped without error", name)
+		}
+	}
+	if _, err := StripJPEGMetadata([]byte{0xFF, markerSOI, 0xFF, markerAPP1, 0xFF, 0xFF}); err == nil {
+		t.Error("segment longer than the data accepted")
+	}
+}
+
+func TestStripTIFFMetadata(t *testing.T) {
+	var buf bytes.Buffer
+	img := testImage(3, 2)
+	if err := Encode(&buf, img, FormatTIFF, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	stripped, format, err := StripMetadata(buf.Bytes(), 1<<20)
+	if err != nil {
+		t.Fatalf("StripMetadata: %v", err)
+	}
+	if format != FormatTIFF {
+		t.Errorf("format = %s, want tiff", format)
+	}
+	decoded, _, err := Decode(stripped, 1<<20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Bounds().Dx() != 3 || decoded.Bounds().Dy() != 2 {
+		t.Errorf("bounds = %v, want 3x2", decoded.Bounds())
+	}
+	r, g, _, _ := decoded.At(2, 1).RGBA()
+	if r>>8 != 80 || g>>8 != 40 {
+		t.Errorf("pixel (2,1) = %d,%d, want the lossless original 80,40", r>>8, g>>8)
+	}
+}
+
+func TestApplyOrientation(t *testing.T) {
+	img := testImage(3, 2)
+
+	rotated := applyOrientation(img, 6)
+	if b := rotated.Bounds(); b.Dx() != 2 || b.Dy() != 3 {
+		t.Fatalf("bounds = %v, want 2x3", b)
+	}
+	// Rotating 90 degrees clockwise moves the bottom left corner to the top left
+	if got, want := rotated.At(0, 0), img.At(0, 1); got != want {
+		t.Errorf("top left = %v, want the original bottom left %v", got, want)
+	}
+	if applyOrientation(img, 1) != image.Image(img) {
+		t.Error("upright image was copied")
+	}
+}
@@ -0,0 +1,102 @@
+package kvstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Store types selectable through Config.Type
+const (
+	// TypeMemory keeps state in the process, so it is not shared between replicas
+	TypeMemory = "memory"
+	// TypeRedis keeps state in Redis, shared by every replica using the same server
+	TypeRedis = "redis"
+)
+
+// KVStore keeps short-lived state that replicas of the service must agree on
+type KVStore interface {
+	// Get returns the value of a key
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - key: the key to look up
+	// Returns:
+	//   - the value
+	//   - false if the key does not exist or has expired
+	//   - error if the operation fails
+	Get(ctx context.Context, key string) (string, bool, error)
+
+	// Set stores a value, replacing any previous one
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - key: the key to store
+	//   - value: the value to store
+	//   - ttl: how long the key lives; zero keeps it until it is deleted
+	// Returns:
+	//   - error if the operation fails
+	Set(ctx context.Context, key, value string, ttl time.Duration) error
+
+	// SetNX atomically stores a value only if the key does not exist yet
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - key: the key to store
+	//   - value: the value to store
+	//   - ttl: how long the key lives; zero keeps it until it is deleted
+	// Returns:
+	//   - true if the value was stored, false if the key already existed
+	//   - error if the operation fails
+	SetNX(ctx context.Context, key, value string, ttl time.Duration) (bool, error)
+
+	// Delete atomically removes a key. Of several concurrent deletes of a key, only one reports it.
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - key: the key to remove
+	// Returns:
+	//   - true if the key existed
+	//   - error if the operation fails
+	Delete(ctx context.Context, key string) (bool, error)
+
+	// Close releases the connections of the store
+	Close() error
+}
+
+// Config selects and configures the store shared state is kept in
+type Config struct {
+	// Type is memory (default) or redis
+	Type string `yaml:"Type"`
+	// Redis configures the Redis store
+	Redis RedisConfig `yaml:"Redis"`
+}
+
+// RedisConfig configures the connection to Redis
+type RedisConfig struct {
+	// Addr is the host:port of the Redis server
+	Addr string `yaml:"Addr"`
+	// Username and Password authenticate with Redis ACLs; both are optional
+	Username string `yaml:"Username"`
+	Password string `yaml:"Password"`
+	// DB is the logical database to use
+	DB int `yaml:"DB"`
+	// TLS connects to Redis over TLS
+	TLS bool `yaml:"TLS"`
+	// KeyPrefix is prepended to every key, so several deployments can share a server
+	KeyPrefix string `yaml:"KeyPrefix"`
+}
+
+// Validate checks that the selected store is configured
+func (c *Config) Validate() error {
+	switch c.Type {
+	case "", TypeMemory:
+	case TypeRedis:
+		if c.Redis.Addr == "" {
+			return errors.New("KVStore.Redis.Addr is required for the redis store")
+		}
+		if c.Redis.DB < 0 {
+			return errors.New("KVStore.Redis.DB must not be negative")
+		}
+	default:
+		return fmt.Errorf("unknown KVStore type: %s", c.Type)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often expired keys that were never read are dropped
+const sweepInterval = time.Minute
+
+// Store implements kvstore.KVStore in process memory. State is lost on restart and not shared
+// between replicas, which suits single-instance deployments.
+type Store struct {
+	mu        sync.Mutex
+	entries   map[string]entry
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+type entry struct {
+	value string
+	// expiresAt is zero for keys without a TTL
+	expiresAt time.Time
+}
+
+// New creates an empty store
+func New() *Store {
+	return &Store{
+		entries: make(map[string]entry),
+		now:     time.Now,
+	}
+}
+
+// live returns the entry of a key unless it has expired, which drops it; callers hold mu
+func (s *Store) live(key string, now time.Time) (entry, bool) {
+	e, ok := s.entries[key]
+	if !ok {
+		return entry{}, false
+	}
+	if !e.expiresAt.IsZero() && !now.Before(e.expiresAt) {
+		delete(s.entries, key)
+		return entry{}, false
+	}
+	return e, true
+}
+
+// put stores an entry and occasionally drops expired ones, which would otherwise pile up; callers hold mu
+func (s *Store) put(key, value string, ttl time.Duration, now time.Time) {
+	if now.Sub(s.lastSweep) > sweepInterval {
+		for k, e := range s.entries {
+			if !e.expiresAt.IsZero() && !now.Before(e.expiresAt) {
+				delete(s.entries, k)
+			}
+		}
+		s.lastSweep = now
+	}
+
+	e := entry{value: value}
+	if ttl > 0 {
+		e.expiresAt = now.Add(ttl)
+	}
+	s.entries[key] = e
+}
+
+func (s *Store) Get(ctx context.Context, key string) (string, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.live(key, s.now())
+	return e.value, ok, nil
+}
+
+func (s *Store) Set(ctx context.Context, key, value string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.put(key, value, ttl, s.now())
+	return nil
+}
+
+func (s *Store) SetNX(ctx context.Context, key, value string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if _, ok := s.live(key, now); ok {
+		return false, nil
+	}
+	s.put(key, value, ttl, now)
+	return true, nil
+}
+
+func (s *Store) Delete(ctx context.Context, key string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.live(key, s.now())
+	delete(s.entries, key)
+	return ok, nil
+}
+
+func (s *Store) Close() error {
+	return nil
+}
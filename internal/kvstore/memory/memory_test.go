@@ -0,0 +1,145 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTestStore returns a store whose clock only moves when the returned function is called
+func newTestStore() (*Store, func(time.Duration)) {
+	s := New()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.now = func() time.Time { return now }
+	return s, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestSetAndGet(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestStore()
+
+	if _, ok, err := s.Get(ctx, "missing"); ok || err != nil {
+		t.Errorf("Get of a missing key = %v, %v", ok, err)
+	}
+	if err := s.Set(ctx, "k", "v1", 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Set(ctx, "k", "v2", 0); err != nil {
+		t.Fatal(err)
+	}
+	if value, ok, _ := s.Get(ctx, "k"); !ok || value != "v2" {
+		t.Errorf("Get = %q, %v, want the replaced value v2", value, ok)
+	}
+}
+
+func TestExpiry(t *testing.T) {
+	ctx := context.Background()
+	s, advance := newTestStore()
+
+	s.Set(ctx, "short", "v", time.Second)
+	s.Set(ctx, "forever", "v", 0)
+
+	advance(999 * time.Millisecond)
+	if _, ok, _ := s.Get(ctx, "short"); !ok {
+		t.Error("key expired before its TTL")
+	}
+	advance(time.Millisecond)
+	if _, ok, _ := s.Get(ctx, "short"); ok {
+		t.Error("key outlived its TTL")
+	}
+	if _, ok, _ := s.Get(ctx, "forever"); !ok {
+		t.Error("key without a TTL expired")
+	}
+}
+
+func TestSetNX(t *testing.T) {
+	ctx := context.Background()
+	s, advance := newTestStore()
+
+	if ok, _ := s.SetNX(ctx, "lock", "a", time.Minute); !ok {
+		t.Fatal("SetNX of a new key failed")
+	}
+	if ok, _ := s.SetNX(ctx, "lock", "b", time.Minute); ok {
+		t.Error("SetNX replaced an existing key")
+	}
+	if value, _, _ := s.Get(ctx, "lock"); value != "a" {
+		t.Errorf("value = %q, want a", value)
+	}
+
+	// An expired key can be claimed again
+	advance(time.Minute)
+	if ok, _ := s.SetNX(ctx, "lock", "c", time.Minute); !ok {
+		t.Error("SetNX of an expired key failed")
+	}
+}
+
+func TestDelete(t *testing.T) {
+	ctx := context.Background()
+	s, advance := newTestStore()
+
+	s.Set(ctx, "k", "v", 0)
+	if ok, _ := s.Delete(ctx, "k"); !ok {
+		t.Error("Delete of an existing key reported it missing")
+	}
+	if ok, _ := s.Delete(ctx, "k"); ok {
+		t.Error("second Delete reported the key")
+	}
+
+	s.Set(ctx, "expired", "v", time.Second)
+	advance(time.Second)
+	if ok, _ := s.Delete(ctx, "expired"); ok {
+		t.Error("Delete reported an expired key")
+	}
+}
+
+func TestSweepDropsExpiredKeys(t *testing.T) {
+	ctx := context.Background()
+	s, advance := newTestStore()
+
+	for i := range 10 {
+		s.Set(ctx, fmt.Sprint("k", i), "v", time.Second)
+	}
+	advance(2 * sweepInterval)
+	s.Set(ctx, "fresh", "v", 0)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.entries) != 1 {
+		t.Errorf("%d entries left after a sweep, want 1", len(s.entries))
+	}
+}
+
+func TestConcurrentSetNXAndDelete(t *testing.T) {
+	ctx := context.Background()
+	s := New()
+	s.Set(ctx, "token", "v", 0)
+
+	var claimed, deleted atomic.Int32
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			if ok, _ := s.SetNX(ctx, "lock", "v", 0); ok {
+				claimed.Add(1)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			if ok, _ := s.Delete(ctx, "token"); ok {
+				deleted.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if claimed.Load() != 1 {
+		t.Errorf("%d concurrent SetNX calls succeeded, want 1", claimed.Load())
+	}
+	if deleted.Load() != 1 {
+		t.Errorf("%d concurrent deletes reported the key, want 1", deleted.Load())
+	}
+}
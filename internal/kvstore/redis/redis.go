@@ -0,0 +1,79 @@
+package redis
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofreego/mediabase/internal/kvstore"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// Store implements kvstore.KVStore on Redis, so every replica connected to the same server
+// sees the same state. Expiry is left to Redis.
+type Store struct {
+	client    *goredis.Client
+	keyPrefix string
+}
+
+// New creates a store for the Redis server in the config. It connects lazily, on the first command.
+func New(config kvstore.RedisConfig) *Store {
+	opts := &goredis.Options{
+		Addr:     config.Addr,
+		Username: config.Username,
+		Password: config.Password,
+		DB:       config.DB,
+	}
+	if config.TLS {
+		opts.TLSConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	return &Store{
+		client:    goredis.NewClient(opts),
+		keyPrefix: config.KeyPrefix,
+	}
+}
+
+// key returns the Redis key of a store key
+func (s *Store) key(key string) string {
+	return s.keyPrefix + key
+}
+
+func (s *Store) Get(ctx context.Context, key string) (string, bool, error) {
+	value, err := s.client.Get(ctx, s.key(key)).Result()
+	if errors.Is(err, goredis.Nil) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("failed to get key from redis: %w", err)
+	}
+	return value, true, nil
+}
+
+func (s *Store) Set(ctx context.Context, key, value string, ttl time.Duration) error {
+	if err := s.client.Set(ctx, s.key(key), value, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to set key in redis: %w", err)
+	}
+	return nil
+}
+
+func (s *Store) SetNX(ctx context.Context, key, value string, ttl time.Duration) (bool, error) {
+	stored, err := s.client.SetNX(ctx, s.key(key), value, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to set key in redis: %w", err)
+	}
+	return stored, nil
+}
+
+func (s *Store) Delete(ctx context.Context, key string) (bool, error) {
+	deleted, err := s.client.Del(ctx, s.key(key)).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to delete key from redis: %w", err)
+	}
+	return deleted > 0, nil
+}
+
+func (s *Store) Close() error {
+	return s.client.Close()
+}
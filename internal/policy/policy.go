@@ -0,0 +1,293 @@
+package policy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+)
+
+// Template identifies a predefined bucket access policy
+type Template string
+
+const (
+	// PublicRead allows anyone to download objects
+	PublicRead Template = "public-read"
+	// ReadWrite allows anyone to download, upload and delete objects
+	ReadWrite Template = "read-write"
+	// ReadOnlyPrefix allows anyone to download objects under a single prefix
+	ReadOnlyPrefix Template = "read-only-prefix"
+)
+
+// document is an S3 bucket policy document
+type document struct {
+	Version   string      `json:"Version"`
+	Statement []statement `json:"Statement"`
+}
+
+type statement struct {
+	Effect    string     `json:"Effect"`
+	Principal principal  `json:"Principal"`
+	Action    stringList `json:"Action"`
+	Resource  stringList `json:"Resource"`
+}
+
+type principal struct {
+	AWS stringList `json:"AWS"`
+}
+
+// UnmarshalJSON also accepts the "*" shorthand for all principals
+func (p *principal) UnmarshalJSON(data []byte) error {
+	var all string
+	if err := json.Unmarshal(data, &all); err == nil {
+		p.AWS = stringList{all}
+		return nil
+	}
+	type plain principal
+	return json.Unmarshal(data, (*plain)(p))
+}
+
+// stringList is a policy element that may be written as a single string or a list
+type stringList []string
+
+func (l *stringList) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*l = stringList{single}
+		return nil
+	}
+	return json.Unmarshal(data, (*[]string)(l))
+}
+
+// Build renders the policy template for a bucket. The prefix is only used by ReadOnlyPrefix.
+func Build(template Template, bucketName, prefix string) (string, error) {
+	switch template {
+	case PublicRead:
+		return PublicReadPolicy(bucketName)
+	case ReadWrite:
+		return ReadWritePolicy(bucketName)
+	case ReadOnlyPrefix:
+		return ReadOnlyPrefixPolicy(bucketName, prefix)
+	}
+	return "", fmt.Errorf("unknown policy template: %s", template)
+}
+
+// PublicReadPolicy allows anonymous s3:GetObject on all objects in the bucket
+func PublicReadPolicy(bucketName string) (string, error) {
+	return anonymousPolicy(bucketName, "*", "s3:GetObject")
+}
+
+// ReadWritePolicy allows anonymous downloads, uploads and deletes on all objects in the bucket
+func ReadWritePolicy(bucketName string) (string, error) {
+	return anonymousPolicy(bucketName, "*", "s3:GetObject", "s3:PutObject", "s3:DeleteObject")
+}
+
+// ReadOnlyPrefixPolicy allows anonymous s3:GetObject on objects under the prefix only
+func ReadOnlyPrefixPolicy(bucketName, prefix string) (string, error) {
+	if prefix == "" {
+		return "", errors.New("prefix is required for the read-only-prefix policy")
+	}
+	// Wildcards would widen the rule beyond the prefix
+	if strings.ContainsAny(prefix, "*?") {
+		return "", fmt.Errorf("prefix must not contain wildcards: %q", prefix)
+	}
+	return anonymousPolicy(bucketName, prefix+"*", "s3:GetObject")
+}
+
+// anonymousPolicy renders a policy granting the actions to all principals on bucket/resource
+func anonymousPolicy(bucketName, resource string, actions ...string) (string, error) {
+	if err := ValidateBucketName(bucketName); err != nil {
+		return "", err
+	}
+
+	doc := document{
+		Version: "2012-10-17",
+		Statement: []statement{{
+			Effect:    "Allow",
+			Principal: principal{AWS: []string{"*"}},
+			Action:    actions,
+			Resource:  []string{"arn:aws:s3:::" + bucketName + "/" + resource},
+		}},
+	}
+
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode policy: %w", err)
+	}
+	return string(data), nil
+}
+
+// objectReadSid identifies the statement SetObjectRead keeps the publicly readable objects in
+const objectReadSid = "MediabaseObjectRead"
+
+// SetObjectRead grants or revokes anonymous s3:GetObject on a single object in a bucket policy.
+// The object is added to or removed from the resources of a dedicated statement; all other
+// statements are kept exactly as they are. An empty result means no statement is left, so the
+// policy should be removed.
+func SetObjectRead(bucketPolicy, bucketName, objectKey string, allow bool) (string, error) {
+	// Wildcards would widen the grant beyond the object
+	if strings.ContainsAny(objectKey, "*?") {
+		return "", fmt.Errorf("object key must not contain wildcards: %q", objectKey)
+	}
+
+	// Decode generically so elements this package does not model, such as conditions, survive
+	doc := map[string]any{}
+	if bucketPolicy != "" {
+		if err := json.Unmarshal([]byte(bucketPolicy), &doc); err != nil {
+			return "", fmt.Errorf("failed to decode policy: %w", err)
+		}
+	}
+	statements, ok := doc["Statement"].([]any)
+	if !ok && doc["Statement"] != nil {
+		statements = []any{doc["Statement"]}
+	}
+
+	var resources []string
+	kept := make([]any, 0, len(statements)+1)
+	for _, st := range statements {
+		if m, ok := st.(map[string]any); ok && m["Sid"] == objectReadSid {
+			resources = anyStrings(m["Resource"])
+			continue
+		}
+		kept = append(kept, st)
+	}
+
+	resource := "arn:aws:s3:::" + bucketName + "/" + objectKey
+	resources = slices.DeleteFunc(resources, func(r string) bool { return r == resource })
+	if allow {
+		resources = append(resources, resource)
+	}
+	if len(resources) > 0 {
+		kept = append(kept, map[string]any{
+			"Sid":       objectReadSid,
+			"Effect":    "Allow",
+			"Principal": map[string]any{"AWS": []string{"*"}},
+			"Action":    []string{"s3:GetObject"},
+			"Resource":  resources,
+		})
+	}
+	if len(kept) == 0 {
+		return "", nil
+	}
+
+	if doc["Version"] == nil {
+		doc["Version"] = "2012-10-17"
+	}
+	doc["Statement"] = kept
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode policy: %w", err)
+	}
+	return string(data), nil
+}
+
+// anyStrings converts a generically decoded policy element, a string or a list of them
+func anyStrings(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		out := make([]string, 0, len(v))
+		for _, e := range v {
+			if s, ok := e.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+// AllowsAnonymousRead checks if a bucket policy lets anyone download an object: some
+// statement must allow s3:GetObject on it to all principals, and none may deny it
+func AllowsAnonymousRead(policy, bucketName, objectKey string) (bool, error) {
+	if policy == "" {
+		return false, nil
+	}
+
+	var doc document
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return false, fmt.Errorf("failed to decode policy: %w", err)
+	}
+
+	resource := "arn:aws:s3:::" + bucketName + "/" + objectKey
+	allowed := false
+	for _, st := range doc.Statement {
+		if !matchesAny(st.Action, "s3:GetObject") || !matchesAny(st.Resource, resource) {
+			continue
+		}
+		switch st.Effect {
+		case "Deny":
+			return false, nil
+		case "Allow":
+			allowed = allowed || slices.Contains(st.Principal.AWS, "*")
+		}
+	}
+	return allowed, nil
+}
+
+// matchesAny checks a value against policy patterns, where * matches any run of
+// characters and ? any single character
+func matchesAny(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if wildcardMatch(pattern, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func wildcardMatch(pattern, value string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			for i := len(value); i >= 0; i-- {
+				if wildcardMatch(pattern[1:], value[i:]) {
+					return true
+				}
+			}
+			return false
+		case '?':
+			if len(value) == 0 {
+				return false
+			}
+		default:
+			if len(value) == 0 || pattern[0] != value[0] {
+				return false
+			}
+		}
+		pattern, value = pattern[1:], value[1:]
+	}
+	return len(value) == 0
+}
+
+// ValidateBucketName checks a bucket name against the S3 naming rules
+func ValidateBucketName(name string) error {
+	if len(name) < 3 || len(name) > 63 {
+		return fmt.Errorf("bucket name must be between 3 and 63 characters, got %d", len(name))
+	}
+	for _, c := range name {
+		if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '.' || c == '-') {
+			return fmt.Errorf("bucket name %q may only contain lowercase letters, numbers, dots and hyphens", name)
+		}
+	}
+	if !isAlphanumeric(name[0]) || !isAlphanumeric(name[len(name)-1]) {
+		return fmt.Errorf("bucket name %q must begin and end with a letter or number", name)
+	}
+	if strings.Contains(name, "..") {
+		return fmt.Errorf("bucket name %q must not contain consecutive dots", name)
+	}
+	if net.ParseIP(name) != nil {
+		return fmt.Errorf("bucket name %q must not be formatted as an IP address", name)
+	}
+	if strings.HasPrefix(name, "xn--") || strings.HasSuffix(name, "-s3alias") || strings.HasSuffix(name, "--ol-s3") {
+		return fmt.Errorf("bucket name %q uses a reserved prefix or suffix", name)
+	}
+	return nil
+}
+
+func isAlphanumeric(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= '0' && c <= '9'
+}
@@ -0,0 +1,163 @@
+package policy
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// decode parses a rendered policy into its single statement
+func decode(t *testing.T, rendered string) statement {
+	t.Helper()
+	var doc document
+	if err := json.Unmarshal([]byte(rendered), &doc); err != nil {
+		t.Fatalf("policy %s: %v", rendered, err)
+	}
+	if doc.Version != "2012-10-17" || len(doc.Statement) != 1 {
+		t.Fatalf("policy %s: want one statement of version 2012-10-17", rendered)
+	}
+	return doc.Statement[0]
+}
+
+func TestBuild(t *testing.T) {
+	for _, tc := range []struct {
+		template Template
+		prefix   string
+		actions  []string
+		resource string
+	}{
+		{PublicRead, "", []string{"s3:GetObject"}, "arn:aws:s3:::media/*"},
+		{ReadWrite, "", []string{"s3:GetObject", "s3:PutObject", "s3:DeleteObject"}, "arn:aws:s3:::media/*"},
+		{ReadOnlyPrefix, "public/", []string{"s3:GetObject"}, "arn:aws:s3:::media/public/*"},
+	} {
+		rendered, err := Build(tc.template, "media", tc.prefix)
+		if err != nil {
+			t.Errorf("%s: %v", tc.template, err)
+			continue
+		}
+		st := decode(t, rendered)
+		if st.Effect != "Allow" || !slices.Equal(st.Principal.AWS, []string{"*"}) {
+			t.Errorf("%s: statement %+v does not allow everyone", tc.template, st)
+		}
+		if !slices.Equal(st.Action, tc.actions) || !slices.Equal(st.Resource, []string{tc.resource}) {
+			t.Errorf("%s: actions %v on %v, want %v on %s", tc.template, st.Action, st.Resource, tc.actions, tc.resource)
+		}
+	}
+
+	if _, err := Build("public-write", "media", ""); err == nil {
+		t.Error("unknown template accepted")
+	}
+}
+
+func TestBuildEscapesInput(t *testing.T) {
+	// Characters that would break out of a concatenated JSON string stay inside the resource
+	prefix := `a"],"Action":["s3:PutObject`
+	rendered, err := Build(ReadOnlyPrefix, "media", prefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	st := decode(t, rendered)
+	if !slices.Equal(st.Action, []string{"s3:GetObject"}) || st.Resource[0] != "arn:aws:s3:::media/"+prefix+"*" {
+		t.Errorf("prefix changed the statement: %+v", st)
+	}
+
+	if _, err := Build(PublicRead, `media","Action":"s3:*`, ""); err == nil {
+		t.Error("bucket name with JSON accepted")
+	}
+}
+
+func TestReadOnlyPrefixPolicyRejectsWideningPrefixes(t *testing.T) {
+	for _, prefix := range []string{"", "*", "public/*", "p?blic/"} {
+		if _, err := ReadOnlyPrefixPolicy("media", prefix); err == nil {
+			t.Errorf("prefix %q accepted", prefix)
+		}
+	}
+}
+
+func TestValidateBucketName(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		ok   bool
+	}{
+		{"media", true},
+		{"my-bucket.2024", true},
+		{"abc", true},
+		{strings.Repeat("a", 63), true},
+		{"ab", false},
+		{strings.Repeat("a", 64), false},
+		{"Media", false},
+		{"my_bucket", false},
+		{"my bucket", false},
+		{"-media", false},
+		{"media-", false},
+		{".media", false},
+		{"my..bucket", false},
+		{"192.168.1.1", false},
+		{"xn--media", false},
+		{"media-s3alias", false},
+		{"media--ol-s3", false},
+		{"médias", false},
+	} {
+		if err := ValidateBucketName(tc.name); (err == nil) != tc.ok {
+			t.Errorf("ValidateBucketName(%q) = %v, want ok %v", tc.name, err, tc.ok)
+		}
+	}
+}
+
+func TestAllowsAnonymousRead(t *testing.T) {
+	prefixPolicy, err := ReadOnlyPrefixPolicy("media", "public/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		name, policy, key string
+		want              bool
+	}{
+		{"no policy", "", "a.png", false},
+		{"under prefix", prefixPolicy, "public/a.png", true},
+		{"outside prefix", prefixPolicy, "private/a.png", false},
+		{"denied", `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::media/*"},{"Effect":"Deny","Principal":"*","Action":"s3:*","Resource":"arn:aws:s3:::media/secret/*"}]}`, "secret/a.png", false},
+		{"named principal", `{"Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::1:root"]},"Action":"s3:GetObject","Resource":"arn:aws:s3:::media/*"}]}`, "a.png", false},
+	} {
+		got, err := AllowsAnonymousRead(tc.policy, "media", tc.key)
+		if err != nil || got != tc.want {
+			t.Errorf("%s: AllowsAnonymousRead = %v, %v, want %v", tc.name, got, err, tc.want)
+		}
+	}
+}
+
+func TestSetObjectRead(t *testing.T) {
+	base, err := ReadOnlyPrefixPolicy("media", "public/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	granted, err := SetObjectRead(base, "media", "private/a.png", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for key, want := range map[string]bool{"private/a.png": true, "private/b.png": false, "public/x.png": true} {
+		if got, _ := AllowsAnonymousRead(granted, "media", key); got != want {
+			t.Errorf("after granting, %s readable = %v, want %v", key, got, want)
+		}
+	}
+
+	revoked, err := SetObjectRead(granted, "media", "private/a.png", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := AllowsAnonymousRead(revoked, "media", "private/a.png"); got {
+		t.Error("object still readable after revoking")
+	}
+	if got, _ := AllowsAnonymousRead(revoked, "media", "public/x.png"); !got {
+		t.Error("revoking removed the other statements")
+	}
+
+	if empty, err := SetObjectRead("", "media", "a.png", false); err != nil || empty != "" {
+		t.Errorf("revoking from no policy = %q, %v, want no policy", empty, err)
+	}
+	if _, err := SetObjectRead("", "media", "*.png", true); err == nil {
+		t.Error("wildcard object key accepted")
+	}
+}
@@ -0,0 +1,140 @@
+package clamav
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/gofreego/mediabase/internal/scanner"
+)
+
+const (
+	// defaultAddress is the usual clamd TCP socket
+	defaultAddress = "localhost:3310"
+	// defaultTimeout bounds a scan when no timeout is configured
+	defaultTimeout = 5 * time.Minute
+	// chunkSize is the amount of content sent per INSTREAM chunk; clamd rejects chunks
+	// larger than its StreamMaxLength
+	chunkSize = 64 * 1024
+)
+
+// errContent is returned when the content to scan could not be read
+var errContent = errors.New("failed to read content")
+
+// ClamAV scans content with a clamd daemon over TCP using the INSTREAM command, which
+// streams the content in chunks so it is never held in memory as a whole
+type ClamAV struct {
+	address string
+	timeout time.Duration
+	dialer  net.Dialer
+}
+
+// NewClamAV creates a scanner talking to the clamd socket in the config
+func NewClamAV(config scanner.Config) *ClamAV {
+	c := &ClamAV{
+		address: config.Address,
+		timeout: config.Timeout,
+	}
+	if c.address == "" {
+		c.address = defaultAddress
+	}
+	if c.timeout == 0 {
+		c.timeout = defaultTimeout
+	}
+	return c
+}
+
+// Scan streams the content to clamd and parses its verdict
+func (c *ClamAV) Scan(ctx context.Context, content io.Reader) (scanner.Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	conn, err := c.dialer.DialContext(ctx, "tcp", c.address)
+	if err != nil {
+		return scanner.Result{}, fmt.Errorf("failed to connect to clamd: %w", err)
+	}
+	defer conn.Close()
+
+	// Unblock reads and writes once the context ends
+	stop := context.AfterFunc(ctx, func() {
+		conn.SetDeadline(time.Now())
+	})
+	defer stop()
+
+	// clamd may stop reading early, e.g. when the stream exceeds its size limit,
+	// and then explains why in its reply
+	sendErr := c.send(conn, content)
+	if errors.Is(sendErr, errContent) {
+		// clamd is still waiting for the rest of the stream
+		return scanner.Result{}, sendErr
+	}
+	reply, err := bufio.NewReader(conn).ReadString(0)
+	reply = strings.TrimRight(reply, "\x00\n")
+	if ctx.Err() != nil {
+		return scanner.Result{}, ctx.Err()
+	}
+	if reply == "" {
+		if sendErr != nil {
+			return scanner.Result{}, sendErr
+		}
+		return scanner.Result{}, fmt.Errorf("failed to read clamd reply: %w", err)
+	}
+	return parseReply(reply)
+}
+
+// send writes the INSTREAM command, the content as length-prefixed chunks and the terminating empty chunk
+func (c *ClamAV) send(conn net.Conn, content io.Reader) error {
+	w := bufio.NewWriterSize(conn, chunkSize+4)
+	if _, err := w.WriteString("zINSTREAM\x00"); err != nil {
+		return fmt.Errorf("failed to send clamd command: %w", err)
+	}
+
+	buf := make([]byte, chunkSize)
+	var size [4]byte
+	for {
+		n, err := io.ReadFull(content, buf)
+		if n > 0 {
+			binary.BigEndian.PutUint32(size[:], uint32(n))
+			w.Write(size[:])
+			if _, err := w.Write(buf[:n]); err != nil {
+				return fmt.Errorf("failed to stream content to clamd: %w", err)
+			}
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %w", errContent, err)
+		}
+	}
+
+	binary.BigEndian.PutUint32(size[:], 0)
+	w.Write(size[:])
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("failed to stream content to clamd: %w", err)
+	}
+	return nil
+}
+
+// parseReply interprets a clamd reply such as "stream: OK" or "stream: Eicar-Signature FOUND"
+func parseReply(reply string) (scanner.Result, error) {
+	verdict, found := strings.CutPrefix(reply, "stream: ")
+	switch {
+	case !found:
+		return scanner.Result{}, fmt.Errorf("clamd error: %s", reply)
+	case verdict == "OK":
+		return scanner.Result{}, nil
+	case strings.HasSuffix(verdict, " FOUND"):
+		return scanner.Result{
+			Infected:  true,
+			Signature: strings.TrimSuffix(verdict, " FOUND"),
+		}, nil
+	}
+	return scanner.Result{}, fmt.Errorf("clamd error: %s", verdict)
+}
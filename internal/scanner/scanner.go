@@ -0,0 +1,37 @@
+package scanner
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// Scanner checks content for malware
+type Scanner interface {
+	// Scan reads content to the end and reports whether it is infected
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - content: data stream to scan
+	// Returns:
+	//   - scan result
+	//   - error if the content could not be scanned
+	Scan(ctx context.Context, content io.Reader) (Result, error)
+}
+
+// Result is the verdict of a scan
+type Result struct {
+	// Infected is true when malware was detected
+	Infected bool
+	// Signature names the detected malware
+	Signature string
+}
+
+// Config configures the malware scanner used to check confirmed uploads
+type Config struct {
+	// Enabled turns on scanning of presigned uploads in ConfirmUpload
+	Enabled bool `yaml:"Enabled"`
+	// Address is the host:port of the clamd TCP socket (defaults to localhost:3310)
+	Address string `yaml:"Address"`
+	// Timeout bounds a whole scan, including the transfer of the content (defaults to 5m)
+	Timeout time.Duration `yaml:"Timeout"`
+}
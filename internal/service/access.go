@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofreego/goutils/logger"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// AccessOperation names an audited object operation
+type AccessOperation string
+
+// Audited object operations
+const (
+	AccessPresignDownload AccessOperation = "presign_download"
+	AccessDownload        AccessOperation = "download"
+	AccessDelete          AccessOperation = "delete"
+)
+
+// accessLogBufferSize is the number of access events queued before new ones are dropped
+const accessLogBufferSize = 1024
+
+// AccessEvent records a successful access to an object
+type AccessEvent struct {
+	Time      time.Time
+	Operation AccessOperation
+	// Subject is the common name of the verified client certificate, empty for unauthenticated callers
+	Subject   string
+	Bucket    string
+	Key       string
+	VersionID string
+	// Bytes is the number of bytes streamed by downloads through the server
+	Bytes int64
+}
+
+// AccessLogger receives access events for auditing. Events are delivered from a single
+// background goroutine, so implementations may block without slowing down requests.
+type AccessLogger interface {
+	LogAccess(ctx context.Context, event AccessEvent)
+}
+
+// WithAccessLogger sends access events to a custom sink instead of the default structured log
+func WithAccessLogger(l AccessLogger) Option {
+	return func(s *Service) {
+		s.accessLogger = l
+	}
+}
+
+// jsonAccessLogger writes access events as structured log entries
+type jsonAccessLogger struct{}
+
+func (jsonAccessLogger) LogAccess(ctx context.Context, event AccessEvent) {
+	logger.Infow(ctx, "object access", logger.NewFields().
+		AddField("time", event.Time.UTC().Format(time.RFC3339Nano)).
+		AddField("operation", event.Operation).
+		AddField("subject", event.Subject).
+		AddField("bucket", event.Bucket).
+		AddField("key", event.Key).
+		AddField("version_id", event.VersionID).
+		AddField("bytes", event.Bytes))
+}
+
+// accessRecord is a queued event with the context of the request that produced it
+type accessRecord struct {
+	ctx   context.Context
+	event AccessEvent
+}
+
+// asyncAccessLogger hands events to a sink from a background goroutine. When the queue is
+// full, events are dropped rather than delaying requests.
+type asyncAccessLogger struct {
+	sink    AccessLogger
+	records chan accessRecord
+	done    chan struct{}
+	dropped atomic.Int64
+
+	// mu guards closed, so requests still running after a drain timeout cannot send on a closed queue
+	mu     sync.RWMutex
+	closed bool
+}
+
+func newAsyncAccessLogger(sink AccessLogger) *asyncAccessLogger {
+	a := &asyncAccessLogger{
+		sink:    sink,
+		records: make(chan accessRecord, accessLogBufferSize),
+		done:    make(chan struct{}),
+	}
+	go a.run()
+	return a
+}
+
+func (a *asyncAccessLogger) run() {
+	defer close(a.done)
+	for r := range a.records {
+		a.sink.LogAccess(r.ctx, r.event)
+	}
+}
+
+// log queues an event without blocking
+func (a *asyncAccessLogger) log(ctx context.Context, event AccessEvent) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if a.closed {
+		a.dropped.Add(1)
+		return
+	}
+	select {
+	case a.records <- accessRecord{ctx: context.WithoutCancel(ctx), event: event}:
+	default:
+		a.dropped.Add(1)
+	}
+}
+
+// close delivers the queued events and stops the background goroutine
+func (a *asyncAccessLogger) close(ctx context.Context) {
+	a.mu.Lock()
+	if a.closed {
+		a.mu.Unlock()
+		return
+	}
+	a.closed = true
+	close(a.records)
+	a.mu.Unlock()
+
+	<-a.done
+	if dropped := a.dropped.Load(); dropped > 0 {
+		logger.Warn(ctx, "%d access events were dropped because the access log queue was full or closed", dropped)
+	}
+}
+
+// logAccess records an object access if access logging is enabled
+func (s *Service) logAccess(ctx context.Context, op AccessOperation, bucketName, objectKey, versionID string, bytes int64) {
+	if s.accessLog == nil {
+		return
+	}
+	s.accessLog.log(ctx, AccessEvent{
+		Time:      time.Now(),
+		Operation: op,
+		Subject:   subjectFromContext(ctx),
+		Bucket:    bucketName,
+		Key:       objectKey,
+		VersionID: versionID,
+		Bytes:     bytes,
+	})
+}
+
+// subjectFromContext returns the common name of the client certificate verified by mutual TLS
+func subjectFromContext(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	return tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"github.com/gofreego/mediabase/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UpdateCredentials hot-swaps the storage access keys. Storage checks the new keys before they
+// are used, so a rejected pair leaves the current keys in place.
+func (s *Service) UpdateCredentials(ctx context.Context, req *mediabase_v1.UpdateCredentialsRequest) (*mediabase_v1.UpdateCredentialsResponse, error) {
+	logDebug(ctx, "UpdateCredentials request received, access_key_id: %s", req.AccessKeyId)
+
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if req.AccessKeyId == "" || req.SecretAccessKey == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "access_key_id and secret_access_key are required")
+	}
+
+	if err := s.storage.UpdateCredentials(ctx, req.AccessKeyId, req.SecretAccessKey); err != nil {
+		logger.Error(ctx, "Failed to update storage credentials: %v", err)
+		if errors.Is(err, storage.ErrAccessDenied) {
+			return nil, status.Errorf(codes.InvalidArgument, "storage rejected the new credentials: %v", err)
+		}
+		return nil, storageError("failed to update storage credentials", err)
+	}
+
+	logger.Info(ctx, "Storage credentials updated by %s, access_key_id: %s", subjectFromContext(ctx), req.AccessKeyId)
+
+	return &mediabase_v1.UpdateCredentialsResponse{
+		Success: true,
+	}, nil
+}
+
+// requireAdmin checks that the caller presented a client certificate listed in AdminSubjects
+func (s *Service) requireAdmin(ctx context.Context) error {
+	subject := subjectFromContext(ctx)
+	if subject == "" || !s.adminSubjects[subject] {
+		return status.Errorf(codes.PermissionDenied, "admin access requires a client certificate listed in AdminSubjects")
+	}
+	return nil
+}
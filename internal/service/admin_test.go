@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"github.com/gofreego/mediabase/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUpdateCredentialsRequiresAdmin(t *testing.T) {
+	cfg := testConfig()
+	cfg.AdminSubjects = []string{"ops"}
+	fake := newFakeStorage("media")
+	s := newTestService(t, cfg, fake)
+	req := &mediabase_v1.UpdateCredentialsRequest{AccessKeyId: "new-key", SecretAccessKey: "new-secret"}
+
+	for name, ctx := range map[string]context.Context{
+		"no certificate":   context.Background(),
+		"unlisted subject": withSubject(context.Background(), "intruder"),
+	} {
+		_, err := s.UpdateCredentials(ctx, req)
+		if status.Code(err) != codes.PermissionDenied {
+			t.Errorf("%s: error = %v, want PERMISSION_DENIED", name, err)
+		}
+	}
+	if fake.callCount("UpdateCredentials") != 0 {
+		t.Error("storage credentials were updated without admin access")
+	}
+
+	if _, err := s.UpdateCredentials(withSubject(context.Background(), "ops"), req); err != nil {
+		t.Fatalf("UpdateCredentials as admin: %v", err)
+	}
+	if fake.accessKeyID != "new-key" {
+		t.Errorf("storage uses %q, want new-key", fake.accessKeyID)
+	}
+}
+
+func TestUpdateCredentialsRejectedKeys(t *testing.T) {
+	cfg := testConfig()
+	cfg.AdminSubjects = []string{"ops"}
+	fake := newFakeStorage("media")
+	fake.failWith("UpdateCredentials", fmt.Errorf("failed to verify credentials: %w", storage.ErrAccessDenied))
+	s := newTestService(t, cfg, fake)
+	ctx := withSubject(context.Background(), "ops")
+
+	_, err := s.UpdateCredentials(ctx, &mediabase_v1.UpdateCredentialsRequest{AccessKeyId: "bad", SecretAccessKey: "bad"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("rejected keys: error = %v, want INVALID_ARGUMENT", err)
+	}
+
+	_, err = s.UpdateCredentials(ctx, &mediabase_v1.UpdateCredentialsRequest{AccessKeyId: "key"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("missing secret: error = %v, want INVALID_ARGUMENT", err)
+	}
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// bucketRPCs calls each bucket-accepting RPC on bucketName
+func bucketRPCs(s *Service, bucketName string) map[string]func(ctx context.Context) error {
+	return map[string]func(ctx context.Context) error{
+		"CreateBucket": func(ctx context.Context) error {
+			_, err := s.CreateBucket(ctx, &mediabase_v1.CreateBucketRequest{BucketName: bucketName})
+			return err
+		},
+		"DeleteBucket": func(ctx context.Context) error {
+			_, err := s.DeleteBucket(ctx, &mediabase_v1.DeleteBucketRequest{BucketName: bucketName})
+			return err
+		},
+		"PresignUpload": func(ctx context.Context) error {
+			_, err := s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{BucketName: bucketName, ContentType: "image/png"})
+			return err
+		},
+		"PresignDownload": func(ctx context.Context) error {
+			_, err := s.PresignDownload(ctx, &mediabase_v1.PresignDownloadRequest{BucketName: bucketName, ObjectKey: "a.png"})
+			return err
+		},
+		"PutObject": func(ctx context.Context) error {
+			_, err := s.PutObject(ctx, &mediabase_v1.PutObjectRequest{BucketName: bucketName, ContentType: "image/png", Content: []byte("png")})
+			return err
+		},
+		"DeleteObject": func(ctx context.Context) error {
+			_, err := s.DeleteObject(ctx, &mediabase_v1.DeleteObjectRequest{BucketName: bucketName, ObjectKey: "a.png"})
+			return err
+		},
+		"CopyObject": func(ctx context.Context) error {
+			_, err := s.CopyObject(ctx, &mediabase_v1.CopyObjectRequest{BucketName: bucketName, SourceKey: "a.png", DestinationKey: "b.png"})
+			return err
+		},
+		"MoveObject to": func(ctx context.Context) error {
+			_, err := s.MoveObject(ctx, &mediabase_v1.MoveObjectRequest{SourceKey: "a.png", DestinationBucket: bucketName, DestinationKey: "c.png"})
+			return err
+		},
+		"GetObjectMetadata": func(ctx context.Context) error {
+			_, err := s.GetObjectMetadata(ctx, &mediabase_v1.GetObjectMetadataRequest{BucketName: bucketName, ObjectKey: "a.png"})
+			return err
+		},
+		"GetObjectTags": func(ctx context.Context) error {
+			_, err := s.GetObjectTags(ctx, &mediabase_v1.GetObjectTagsRequest{BucketName: bucketName, ObjectKey: "a.png"})
+			return err
+		},
+		"SetBucketVersioning": func(ctx context.Context) error {
+			_, err := s.SetBucketVersioning(ctx, &mediabase_v1.SetBucketVersioningRequest{BucketName: bucketName, Enabled: true})
+			return err
+		},
+		"SetBucketLifecycle": func(ctx context.Context) error {
+			_, err := s.SetBucketLifecycle(ctx, &mediabase_v1.SetBucketLifecycleRequest{BucketName: bucketName, Prefix: "tmp/", ExpirationDays: 1})
+			return err
+		},
+		"GetBucketStats": func(ctx context.Context) error {
+			_, err := s.GetBucketStats(ctx, &mediabase_v1.GetBucketStatsRequest{BucketName: bucketName})
+			return err
+		},
+	}
+}
+
+func TestAllowedBucketsRejectsOtherBuckets(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media", "other")
+	cfg := testConfig()
+	cfg.AllowedBuckets = []string{"media", "uploads"}
+	s := newTestService(t, cfg, fake)
+
+	for name, call := range bucketRPCs(s, "other") {
+		err := call(ctx)
+		if status.Code(err) != codes.PermissionDenied || !strings.Contains(err.Error(), "not allowed") {
+			t.Errorf("%s: error = %v, want PERMISSION_DENIED for a bucket off the list", name, err)
+		}
+	}
+	if len(fake.calls) != 0 {
+		t.Errorf("requests for a disallowed bucket reached storage: %v", fake.calls)
+	}
+
+	// Allowed buckets get past the check, whatever happens to them afterwards
+	for name, call := range bucketRPCs(s, "uploads") {
+		if err := call(ctx); status.Code(err) == codes.PermissionDenied {
+			t.Errorf("%s on an allowed bucket: %v", name, err)
+		}
+	}
+}
+
+func TestAllowedBucketsUnsetAllowsAnyBucket(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t, testConfig(), newFakeStorage("media"))
+
+	if _, err := s.CreateBucket(ctx, &mediabase_v1.CreateBucketRequest{BucketName: "anything"}); err != nil {
+		t.Errorf("CreateBucket without an allow-list: %v", err)
+	}
+}
+
+func TestAllowedBucketsConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		allowed []string
+		ok      bool
+	}{
+		{"default bucket listed", []string{"media", "uploads"}, true},
+		{"default bucket missing", []string{"uploads"}, false},
+		{"invalid name", []string{"media", "Bad_Bucket"}, false},
+	} {
+		cfg := testConfig()
+		cfg.AllowedBuckets = tc.allowed
+		if err := cfg.Validate(); (err == nil) != tc.ok {
+			t.Errorf("%s: Validate() = %v, want ok %v", tc.name, err, tc.ok)
+		}
+	}
+}
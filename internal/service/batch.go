@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultMaxPresignBatchSize is used when MaxPresignBatchSize is not set
+const defaultMaxPresignBatchSize = 100
+
+// PresignUploadBatch presigns several uploads at once. Every upload is validated and presigned
+// on its own, so one failing upload is reported in its result without failing the others.
+func (s *Service) PresignUploadBatch(ctx context.Context, req *mediabase_v1.PresignUploadBatchRequest) (*mediabase_v1.PresignUploadBatchResponse, error) {
+	ctx = withLogFields(ctx, req.BucketName, "")
+	logDebug(ctx, "PresignUploadBatch request received, bucket: %s, uploads: %d", req.BucketName, len(req.Uploads))
+
+	if len(req.Uploads) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "at least one upload is required")
+	}
+	if len(req.Uploads) > s.maxPresignBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch contains %d uploads, at most %d are allowed", len(req.Uploads), s.maxPresignBatchSize)
+	}
+
+	resp := &mediabase_v1.PresignUploadBatchResponse{
+		Results: make([]*mediabase_v1.PresignUploadResult, 0, len(req.Uploads)),
+	}
+	failed := 0
+	for _, upload := range req.Uploads {
+		if upload.BucketName == "" {
+			upload.BucketName = req.BucketName
+		}
+
+		result, err := s.PresignUpload(ctx, upload)
+		if err != nil {
+			st := status.Convert(err)
+			resp.Results = append(resp.Results, &mediabase_v1.PresignUploadResult{
+				ErrorCode:    int32(st.Code()),
+				ErrorMessage: st.Message(),
+			})
+			failed++
+			continue
+		}
+		resp.Results = append(resp.Results, &mediabase_v1.PresignUploadResult{
+			Upload: result,
+		})
+	}
+
+	logDebug(ctx, "Presigned upload batch processed, uploads: %d, failed: %d", len(req.Uploads), failed)
+
+	return resp, nil
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/mediabase/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// bucketCache remembers buckets known to exist, so repeated uploads skip the existence check.
+// Entries expire after the TTL, which bounds how long a bucket deleted outside the service is
+// still assumed to exist.
+type bucketCache struct {
+	mu    sync.Mutex
+	ttl   time.Duration
+	known map[string]time.Time
+	now   func() time.Time
+}
+
+func newBucketCache(ttl time.Duration) *bucketCache {
+	if ttl == 0 {
+		ttl = defaultBucketCacheTTL
+	}
+	return &bucketCache{
+		ttl:   ttl,
+		known: make(map[string]time.Time),
+		now:   time.Now,
+	}
+}
+
+// exists reports whether the bucket was seen within the TTL
+func (c *bucketCache) exists(bucketName string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	seen, ok := c.known[bucketName]
+	if !ok {
+		return false
+	}
+	if c.now().Sub(seen) > c.ttl {
+		delete(c.known, bucketName)
+		return false
+	}
+	return true
+}
+
+// add records that the bucket exists
+func (c *bucketCache) add(bucketName string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.known[bucketName] = c.now()
+}
+
+// forget drops the bucket, so the next request checks storage again
+func (c *bucketCache) forget(bucketName string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.known, bucketName)
+}
+
+// ensureBucket checks that the bucket exists, creating it if auto-creation is enabled.
+// Buckets found or created are cached, so later uploads skip the check against storage.
+func (s *Service) ensureBucket(ctx context.Context, bucketName string) error {
+	if s.buckets.exists(bucketName) {
+		return nil
+	}
+
+	exists, err := s.storage.BucketExists(ctx, bucketName)
+	if err != nil {
+		logger.Error(ctx, "Failed to check bucket existence: %v", err)
+		return storageError("failed to check bucket existence", err)
+	}
+
+	if !exists {
+		if !s.autoCreateBucket {
+			return status.Errorf(codes.NotFound, "bucket not found: %s", bucketName)
+		}
+		if err := s.storage.CreateBucket(ctx, bucketName, storage.BucketOptions{}); err != nil {
+			logger.Error(ctx, "Failed to auto-create bucket %s: %v", bucketName, err)
+			return storageError("failed to create bucket", err)
+		}
+		logDebug(ctx, "Bucket auto-created for uploads: %s", bucketName)
+	}
+
+	s.buckets.add(bucketName)
+	return nil
+}
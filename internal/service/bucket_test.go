@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"github.com/gofreego/mediabase/internal/policy"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateBucketPolicies(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage()
+	fake.caps.BucketPolicy = true
+	s := newTestService(t, testConfig(), fake)
+
+	for _, tc := range []struct {
+		req      *mediabase_v1.CreateBucketRequest
+		readable string
+		private  string
+	}{
+		{&mediabase_v1.CreateBucketRequest{BucketName: "legacy-public", IsPublic: true}, "a.png", ""},
+		{&mediabase_v1.CreateBucketRequest{BucketName: "read-write", Policy: mediabase_v1.BucketPolicy_BUCKET_POLICY_READ_WRITE}, "a.png", ""},
+		{&mediabase_v1.CreateBucketRequest{BucketName: "prefixed", Policy: mediabase_v1.BucketPolicy_BUCKET_POLICY_READ_ONLY_PREFIX, PolicyPrefix: "public/"}, "public/a.png", "private/a.png"},
+	} {
+		if _, err := s.CreateBucket(ctx, tc.req); err != nil {
+			t.Errorf("%s: %v", tc.req.BucketName, err)
+			continue
+		}
+		bucketPolicy := fake.policies[tc.req.BucketName]
+		if ok, err := policy.AllowsAnonymousRead(bucketPolicy, tc.req.BucketName, tc.readable); !ok || err != nil {
+			t.Errorf("%s: %s not readable under %s", tc.req.BucketName, tc.readable, bucketPolicy)
+		}
+		if tc.private != "" {
+			if ok, _ := policy.AllowsAnonymousRead(bucketPolicy, tc.req.BucketName, tc.private); ok {
+				t.Errorf("%s: %s readable", tc.req.BucketName, tc.private)
+			}
+		}
+	}
+
+	if _, err := s.CreateBucket(ctx, &mediabase_v1.CreateBucketRequest{BucketName: "private"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := fake.policies["private"]; ok {
+		t.Error("bucket without a policy got one")
+	}
+}
+
+func TestCreateBucketRejectsPolicyBeforeCreating(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage()
+	fake.caps.BucketPolicy = true
+	s := newTestService(t, testConfig(), fake)
+
+	_, err := s.CreateBucket(ctx, &mediabase_v1.CreateBucketRequest{BucketName: "prefixed", Policy: mediabase_v1.BucketPolicy_BUCKET_POLICY_READ_ONLY_PREFIX})
+	if status.Code(err) != codes.InvalidArgument || !strings.Contains(err.Error(), "prefix") {
+		t.Errorf("read-only-prefix without prefix: error = %v, want INVALID_ARGUMENT", err)
+	}
+	if got := fake.callCount("CreateBucket"); got != 0 {
+		t.Errorf("bucket created %d times despite the invalid policy", got)
+	}
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateBucketRejectsUnsupportedPolicyBeforeCreating(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage()
+	s := newTestService(t, testConfig(), fake)
+
+	_, err := s.CreateBucket(ctx, &mediabase_v1.CreateBucketRequest{BucketName: "public", IsPublic: true})
+	if status.Code(err) != codes.Unimplemented {
+		t.Errorf("public bucket without policy support: error = %v, want UNIMPLEMENTED", err)
+	}
+	if got := fake.callCount("CreateBucket") + fake.callCount("SetBucketPolicy"); got != 0 {
+		t.Errorf("storage called %d times for an unsupported request", got)
+	}
+
+	// Buckets without a policy need no capability
+	if _, err := s.CreateBucket(ctx, &mediabase_v1.CreateBucketRequest{BucketName: "private"}); err != nil {
+		t.Errorf("private bucket: %v", err)
+	}
+}
+
+func TestCreateBucketSetsPolicyWhenSupported(t *testing.T) {
+	fake := newFakeStorage()
+	fake.caps.BucketPolicy = true
+	s := newTestService(t, testConfig(), fake)
+
+	if _, err := s.CreateBucket(context.Background(), &mediabase_v1.CreateBucketRequest{BucketName: "public", IsPublic: true}); err != nil {
+		t.Fatalf("CreateBucket: %v", err)
+	}
+	if fake.policies["public"] == "" {
+		t.Error("public bucket got no policy")
+	}
+}
+
+func TestRequireCapability(t *testing.T) {
+	s := newTestService(t, testConfig(), newFakeStorage())
+
+	if err := s.requireCapability(true, "object tags"); err != nil {
+		t.Errorf("supported feature: %v", err)
+	}
+	err := s.requireCapability(false, "object tags")
+	if status.Code(err) != codes.Unimplemented {
+		t.Errorf("unsupported feature: error = %v, want UNIMPLEMENTED", err)
+	}
+}
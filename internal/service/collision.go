@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/gofreego/goutils/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Strategies for caller-supplied file names that are already taken, selectable through Config.CollisionStrategy
+const (
+	// CollisionOverwrite lets the upload replace the existing object
+	CollisionOverwrite = "overwrite"
+	// CollisionFail rejects the upload with ALREADY_EXISTS
+	CollisionFail = "fail"
+	// CollisionAutoSuffix appends -1, -2, ... to the file name until a free key is found
+	CollisionAutoSuffix = "auto-suffix"
+)
+
+// maxCollisionSuffix bounds the number of suffixes tried before giving up
+const maxCollisionSuffix = 100
+
+// validateCollisionStrategy checks a strategy name; empty selects overwrite
+func validateCollisionStrategy(strategy string) error {
+	switch strategy {
+	case "", CollisionOverwrite, CollisionFail, CollisionAutoSuffix:
+		return nil
+	}
+	return fmt.Errorf("unknown collision strategy: %s", strategy)
+}
+
+// resolveCollision applies the collision strategy to the key of an exact file name and returns
+// the key to upload to. The check and the later upload are not atomic, so two concurrent uploads
+// of the same name can still both get it.
+func (s *Service) resolveCollision(ctx context.Context, bucketName, objectKey string) (string, error) {
+	if s.collisionStrategy == "" || s.collisionStrategy == CollisionOverwrite {
+		return objectKey, nil
+	}
+
+	candidate := objectKey
+	for i := 0; i <= maxCollisionSuffix; i++ {
+		if i > 0 {
+			candidate = suffixedKey(objectKey, i)
+			if err := s.validateObjectKey(candidate); err != nil {
+				return "", err
+			}
+		}
+
+		exists, err := s.storage.ObjectExists(ctx, bucketName, candidate)
+		if err != nil {
+			logger.Error(ctx, "Failed to check object existence: %v", err)
+			return "", storageError("failed to check object existence", err)
+		}
+		if !exists {
+			return candidate, nil
+		}
+		if s.collisionStrategy == CollisionFail {
+			return "", status.Errorf(codes.AlreadyExists, "object %s already exists in bucket: %s", objectKey, bucketName)
+		}
+	}
+	return "", status.Errorf(codes.AlreadyExists, "object %s and its %d suffixed names already exist in bucket: %s", objectKey, maxCollisionSuffix, bucketName)
+}
+
+// suffixedKey inserts -n before the extension of the file name, e.g. a/photo.jpg becomes a/photo-2.jpg
+func suffixedKey(objectKey string, n int) string {
+	dir, name := path.Split(objectKey)
+	ext := path.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+	if base == "" {
+		// Names like .env have no base to suffix
+		base, ext = name, ""
+	}
+	return fmt.Sprintf("%s%s-%d%s", dir, base, n, ext)
+}
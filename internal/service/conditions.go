@@ -0,0 +1,59 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RequiredMetadataConfig is application metadata every presigned upload must carry
+type RequiredMetadataConfig struct {
+	// Key is the metadata key, without the x-amz-meta- prefix (e.g., owner)
+	Key string `yaml:"Key"`
+	// Prefix, when set, is what the value must start with (e.g., team-)
+	Prefix string `yaml:"Prefix"`
+}
+
+// validateRequiredMetadataConfig checks that the required keys could be supplied by clients
+func validateRequiredMetadataConfig(rules []RequiredMetadataConfig) error {
+	for _, rule := range rules {
+		if !metadataKeyPattern.MatchString(rule.Key) || reservedMetadataKeys[rule.Key] {
+			return fmt.Errorf("RequiredMetadata key %q must be a valid, unreserved metadata key", rule.Key)
+		}
+	}
+	return nil
+}
+
+// validateMetadataConditions checks the metadata of a presigned upload: starts-with values are only
+// expressible in POST policies, and every required key must be locked to an exact value or a prefix
+// that satisfies its rule, so storage rejects uploads without it
+func (s *Service) validateMetadataConditions(req *mediabase_v1.PresignUploadRequest) error {
+	if len(req.MetadataStartsWith) > 0 && req.Method == mediabase_v1.UploadMethod_UPLOAD_METHOD_PUT {
+		return status.Errorf(codes.InvalidArgument, "metadata_starts_with is only available for POST uploads")
+	}
+	for k := range req.MetadataStartsWith {
+		if _, ok := req.Metadata[k]; ok {
+			return status.Errorf(codes.InvalidArgument, "metadata key %q cannot be in both metadata and metadata_starts_with", k)
+		}
+	}
+
+	for _, rule := range s.requiredMetadata {
+		if value, ok := req.Metadata[rule.Key]; ok {
+			if !strings.HasPrefix(value, rule.Prefix) {
+				return status.Errorf(codes.InvalidArgument, "metadata %s must start with %q", rule.Key, rule.Prefix)
+			}
+			continue
+		}
+		if prefix, ok := req.MetadataStartsWith[rule.Key]; ok {
+			if !strings.HasPrefix(prefix, rule.Prefix) {
+				return status.Errorf(codes.InvalidArgument, "metadata_starts_with %s must start with %q", rule.Key, rule.Prefix)
+			}
+			continue
+		}
+		return status.Errorf(codes.InvalidArgument, "metadata %s is required", rule.Key)
+	}
+	return nil
+}
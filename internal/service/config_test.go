@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestConfigValidate(t *testing.T) {
+	if cfg := testConfig(); cfg.Validate() != nil {
+		t.Fatalf("test config invalid: %v", cfg.Validate())
+	}
+
+	for _, tc := range []struct {
+		name   string
+		modify func(*Config)
+		want   string
+	}{
+		{"missing max size", func(c *Config) { c.MaxFileSize = 0 }, "MaxFileSize"},
+		{"negative max size", func(c *Config) { c.MaxFileSize = -1 }, "MaxFileSize"},
+		{"negative min size", func(c *Config) { c.MinFileSize = -1 }, "MinFileSize"},
+		{"min above max", func(c *Config) { c.MinFileSize = c.MaxFileSize + 1 }, "MinFileSize"},
+		{"no content types", func(c *Config) { c.AllowedContentTypes = nil }, "AllowedContentTypes"},
+		{"invalid content type", func(c *Config) { c.AllowedContentTypes = []string{"image/png; ="} }, "AllowedContentTypes"},
+		{"default type not allowed", func(c *Config) { c.DefaultContentType = "video/mp4" }, "DefaultContentType"},
+		{"negative pixels", func(c *Config) { c.Image.MaxPixels = -1 }, "Image.MaxPixels"},
+		{"key template with strategy", func(c *Config) { c.KeyTemplate, c.KeyStrategy = "{{.UUID}}", KeyStrategyUUID }, "KeyTemplate"},
+		{"key prefix with slash", func(c *Config) { c.KeyPrefix = "/tenant" }, "KeyPrefix"},
+		{"negative bucket cache", func(c *Config) { c.BucketCacheTTL = -1 }, "BucketCacheTTL"},
+		{"zero quota", func(c *Config) { c.Quota.Buckets = map[string]int64{"media": 0} }, "Quota.Buckets"},
+		{"relative CDN URL", func(c *Config) { c.CDNBaseURL = "cdn.example.com" }, "CDNBaseURL"},
+		{"trash prefix without slash", func(c *Config) { c.SoftDelete.TrashPrefix = "trash" }, "SoftDelete.TrashPrefix"},
+	} {
+		cfg := testConfig()
+		tc.modify(&cfg)
+		err := cfg.Validate()
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%s: Validate() = %v, want an error naming %s", tc.name, err, tc.want)
+		}
+	}
+}
+
+func TestNewServiceRejectsInvalidConfig(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("NewService accepted a config without AllowedContentTypes")
+		}
+	}()
+	cfg := testConfig()
+	cfg.AllowedContentTypes = nil
+	NewService(context.Background(), &cfg, newFakeStorage("media"))
+}
@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofreego/mediabase/internal/storage"
+)
+
+// defaultReadAfterWriteInterval is used when retries are enabled without an interval
+const defaultReadAfterWriteInterval = 200 * time.Millisecond
+
+// ReadAfterWriteConfig controls how long a freshly uploaded object may take to become visible
+// on storage that is only eventually consistent
+type ReadAfterWriteConfig struct {
+	// Attempts is how many more times a missing object is looked up before it is reported
+	// as not found (0 disables retries)
+	Attempts int `yaml:"Attempts"`
+	// Interval is the delay between lookups (defaults to 200ms)
+	Interval time.Duration `yaml:"Interval"`
+}
+
+// statObject stats an object, retrying a not-found answer up to the configured number of
+// times since an upload that just finished may not be visible yet
+func (s *Service) statObject(ctx context.Context, bucketName, objectKey string) (*storage.ObjectInfo, error) {
+	for attempt := 0; ; attempt++ {
+		info, err := s.storage.StatObject(ctx, bucketName, objectKey)
+		if err == nil || !errors.Is(err, storage.ErrObjectNotFound) || attempt >= s.readAfterWrite.Attempts {
+			return info, err
+		}
+
+		logDebug(ctx, "Object %s not found in bucket: %s, retrying (%d/%d)", objectKey, bucketName, attempt+1, s.readAfterWrite.Attempts)
+		timer := time.NewTimer(s.readAfterWrite.Interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+)
+
+// GetUploadConstraints returns the upload limits configured on the server
+func (s *Service) GetUploadConstraints(ctx context.Context, req *mediabase_v1.GetUploadConstraintsRequest) (*mediabase_v1.GetUploadConstraintsResponse, error) {
+	logDebug(ctx, "GetUploadConstraints request received")
+
+	contentTypes := make([]string, 0, len(s.allowedContentTypes))
+	for contentType := range s.allowedContentTypes {
+		contentTypes = append(contentTypes, contentType)
+	}
+	sort.Strings(contentTypes)
+
+	// Only report overrides that actually tighten the global limit
+	sizeByContentType := make(map[string]int64)
+	for contentType := range s.maxFileSizeByContentType {
+		if limit := s.maxFileSizeFor(contentType); limit < s.maxFileSize {
+			sizeByContentType[contentType] = limit
+		}
+	}
+
+	return &mediabase_v1.GetUploadConstraintsResponse{
+		AllowedContentTypes:      contentTypes,
+		MaxFileSize:              s.maxFileSize,
+		MinFileSize:              s.minFileSize,
+		MaxFileSizeByContentType: sizeByContentType,
+		UploadExpiresIn:          int32(min(defaultUploadExpiry, s.maxPresignExpiry).Seconds()),
+		DownloadExpiresIn:        int32(min(defaultDownloadExpiry, s.maxPresignExpiry).Seconds()),
+		MaxPresignBatchSize:      int32(s.maxPresignBatchSize),
+		MaxExpiresIn:             int32(s.maxPresignExpiry.Seconds()),
+	}, nil
+}
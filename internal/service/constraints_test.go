@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// sizeTestService caps PNGs at 1000 bytes and JPEGs at 2000 below the global 1MB
+func sizeTestService(t *testing.T, fake *fakeStorage) *Service {
+	cfg := testConfig()
+	cfg.AllowedContentTypes = append(cfg.AllowedContentTypes, "image/*")
+	cfg.MaxFileSizeByContentType = map[string]int64{"image/png": 1000, "image/jpeg": 2000, "text/plain": 1 << 30}
+	return newTestService(t, cfg, fake)
+}
+
+func TestPresignUploadUsesTightestSizeLimit(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	s := sizeTestService(t, fake)
+
+	for _, tc := range []struct {
+		name string
+		req  *mediabase_v1.PresignUploadRequest
+		want int64
+	}{
+		{"override", &mediabase_v1.PresignUploadRequest{BucketName: "media", ContentType: "image/png"}, 1000},
+		{"requested below override", &mediabase_v1.PresignUploadRequest{BucketName: "media", ContentType: "image/png", MaxFileSize: 500}, 500},
+		{"override above global", &mediabase_v1.PresignUploadRequest{BucketName: "media", ContentType: "text/plain"}, 1 << 20},
+		{"prefix", &mediabase_v1.PresignUploadRequest{BucketName: "media", ContentTypePrefix: "image/"}, 1000},
+	} {
+		resp, err := s.PresignUpload(ctx, tc.req)
+		if err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+			continue
+		}
+		if got := fake.maxSizes[resp.ObjectKey]; got != tc.want {
+			t.Errorf("%s: content length limit = %d, want %d", tc.name, got, tc.want)
+		}
+	}
+
+	_, err := s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{BucketName: "media", ContentType: "image/png", MaxFileSize: 1001})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("request above the override: error = %v, want INVALID_ARGUMENT", err)
+	}
+	if _, err := s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{BucketName: "media", ContentType: "image/jpeg", MaxFileSize: 1500}); err != nil {
+		t.Errorf("request within the JPEG override: %v", err)
+	}
+}
+
+func TestPutObjectEnforcesContentTypeLimit(t *testing.T) {
+	ctx := context.Background()
+	s := sizeTestService(t, newFakeStorage("media"))
+
+	_, err := s.PutObject(ctx, &mediabase_v1.PutObjectRequest{BucketName: "media", ContentType: "image/png", Content: make([]byte, 1001)})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("PNG above its limit: error = %v, want INVALID_ARGUMENT", err)
+	}
+	if _, err := s.PutObject(ctx, &mediabase_v1.PutObjectRequest{BucketName: "media", ContentType: "image/jpeg", Content: make([]byte, 1001)}); err != nil {
+		t.Errorf("JPEG within its limit: %v", err)
+	}
+}
+
+func TestGetUploadConstraintsReportsOverrides(t *testing.T) {
+	s := sizeTestService(t, newFakeStorage("media"))
+
+	resp, err := s.GetUploadConstraints(context.Background(), &mediabase_v1.GetUploadConstraintsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]int64{"image/png": 1000, "image/jpeg": 2000}
+	if len(resp.MaxFileSizeByContentType) != len(want) {
+		t.Errorf("overrides = %v, want %v", resp.MaxFileSizeByContentType, want)
+	}
+	for contentType, size := range want {
+		if resp.MaxFileSizeByContentType[contentType] != size {
+			t.Errorf("override of %s = %d, want %d", contentType, resp.MaxFileSizeByContentType[contentType], size)
+		}
+	}
+}
+
+func TestValidateRejectsInvalidSizeOverrides(t *testing.T) {
+	for name, overrides := range map[string]map[string]int64{
+		"zero":          {"image/png": 0},
+		"negative":      {"image/png": -1},
+		"below minimum": {"image/png": 5},
+	} {
+		cfg := testConfig()
+		cfg.MinFileSize = 10
+		cfg.MaxFileSizeByContentType = overrides
+		if err := cfg.Validate(); err == nil {
+			t.Errorf("%s override accepted", name)
+		}
+	}
+}
+
+func TestPresignUploadValidatesRequestedSizes(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	s := newTestService(t, testConfig(), fake)
+
+	resp, err := s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{ContentType: "image/png"})
+	if err != nil {
+		t.Fatalf("unset max_file_size: %v", err)
+	}
+	if got := fake.maxSizes[resp.ObjectKey]; got != 1<<20 {
+		t.Errorf("unset max_file_size: content length limit = %d, want the server maximum", got)
+	}
+	resp, err = s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{ContentType: "image/png", MaxFileSize: 1 << 20})
+	if err != nil {
+		t.Fatalf("max_file_size at the server maximum: %v", err)
+	}
+	if got := fake.maxSizes[resp.ObjectKey]; got != 1<<20 {
+		t.Errorf("max_file_size at the server maximum: content length limit = %d", got)
+	}
+
+	presigned := fake.callCount("GeneratePresignedUploadURL") + fake.callCount("GeneratePresignedPutURL")
+	for _, tc := range []struct {
+		name string
+		req  *mediabase_v1.PresignUploadRequest
+	}{
+		{"negative max", &mediabase_v1.PresignUploadRequest{ContentType: "image/png", MaxFileSize: -1}},
+		{"negative min", &mediabase_v1.PresignUploadRequest{ContentType: "image/png", MinFileSize: -1}},
+		{"above the server maximum", &mediabase_v1.PresignUploadRequest{ContentType: "image/png", MaxFileSize: 1<<20 + 1}},
+		{"min above max", &mediabase_v1.PresignUploadRequest{ContentType: "image/png", MinFileSize: 200, MaxFileSize: 100}},
+		{"put without a size", &mediabase_v1.PresignUploadRequest{ContentType: "image/png", Method: mediabase_v1.UploadMethod_UPLOAD_METHOD_PUT}},
+	} {
+		if _, err := s.PresignUpload(ctx, tc.req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: error = %v, want INVALID_ARGUMENT", tc.name, err)
+		}
+	}
+	if got := fake.callCount("GeneratePresignedUploadURL") + fake.callCount("GeneratePresignedPutURL"); got != presigned {
+		t.Errorf("rejected requests presigned %d URLs", got-presigned)
+	}
+}
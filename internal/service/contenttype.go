@@ -0,0 +1,109 @@
+package service
+
+import (
+	"fmt"
+	"mime"
+	"regexp"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultContentTypeAliases maps non-standard content types that clients commonly send onto
+// their registered form. Config.ContentTypeAliases extends and overrides it.
+var defaultContentTypeAliases = map[string]string{
+	"image/jpg":   "image/jpeg",
+	"image/pjpeg": "image/jpeg",
+	"image/x-png": "image/png",
+}
+
+// validateContentTypeAliases checks that aliases and their targets are media types without
+// parameters, and that no target is itself an alias
+func validateContentTypeAliases(aliases map[string]string) error {
+	for alias, target := range aliases {
+		for _, contentType := range []string{alias, target} {
+			mediaType, params, err := mime.ParseMediaType(contentType)
+			if err != nil {
+				return fmt.Errorf("ContentTypeAliases contains an invalid content type %q: %w", contentType, err)
+			}
+			if mediaType != contentType || len(params) > 0 {
+				return fmt.Errorf("ContentTypeAliases entry %q must be a lower-case media type without parameters", contentType)
+			}
+		}
+		if _, chained := aliases[target]; chained {
+			return fmt.Errorf("ContentTypeAliases target %s of %s is itself an alias", target, alias)
+		}
+	}
+	return nil
+}
+
+// contentTypeAliases merges the configured aliases over the defaults
+func contentTypeAliases(configured map[string]string) map[string]string {
+	aliases := make(map[string]string, len(defaultContentTypeAliases)+len(configured))
+	for alias, target := range defaultContentTypeAliases {
+		aliases[alias] = target
+	}
+	for alias, target := range configured {
+		aliases[alias] = target
+	}
+	return aliases
+}
+
+// resolveContentType applies the configured default to an empty content type and normalizes
+// the result. An empty content type without a default is rejected.
+func (s *Service) resolveContentType(contentType string) (string, error) {
+	if contentType == "" {
+		if s.defaultContentType == "" {
+			return "", status.Errorf(codes.InvalidArgument, "content_type is required")
+		}
+		contentType = s.defaultContentType
+	}
+	return s.normalizeContentType(contentType), nil
+}
+
+// normalizeContentType reduces a declared content type to its canonical media type: parameters
+// such as charset are dropped, the type is lower-cased and aliases are resolved. Content types
+// that do not parse are returned unchanged, for the allow-list check to reject.
+func (s *Service) normalizeContentType(contentType string) string {
+	return canonicalContentType(contentType, s.contentTypeAliases)
+}
+
+// canonicalContentType normalizes a content type with the given aliases
+func canonicalContentType(contentType string, aliases map[string]string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return contentType
+	}
+	if canonical, ok := aliases[mediaType]; ok {
+		return canonical
+	}
+	return mediaType
+}
+
+// normalizeContentTypes normalizes a configured list of content types, so entries written as
+// aliases or with parameters still match normalized requests
+func normalizeContentTypes(contentTypes []string, aliases map[string]string) []string {
+	normalized := make([]string, len(contentTypes))
+	for i, contentType := range contentTypes {
+		normalized[i] = canonicalContentType(contentType, aliases)
+	}
+	return normalized
+}
+
+// contentTypePrefixPattern matches a lower-case media type, optionally cut short within its subtype
+var contentTypePrefixPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9!#$&^_.+-]*/[a-z0-9!#$&^_.+-]*$`)
+
+// checkContentTypePrefix validates a content type prefix of a presigned POST upload. It must
+// include the slash after the type, and the allowed content types must hold the wildcard entry
+// of that type, e.g. "image/*" for "image/" or "image/x-".
+func (s *Service) checkContentTypePrefix(prefix string) error {
+	if !contentTypePrefixPattern.MatchString(prefix) {
+		return status.Errorf(codes.InvalidArgument, "content_type_prefix %q must be a lower-case media type prefix including the slash, e.g. image/", prefix)
+	}
+	mediaType, _, _ := strings.Cut(prefix, "/")
+	if !s.allowedContentTypes[mediaType+"/*"] {
+		return status.Errorf(codes.InvalidArgument, "invalid content type prefix: %s, allowed content types have no %s/* entry", prefix, mediaType)
+	}
+	return nil
+}
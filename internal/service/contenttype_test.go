@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPresignUploadNormalizesContentTypes(t *testing.T) {
+	ctx := context.Background()
+	cfg := testConfig()
+	cfg.AllowedContentTypes = append(cfg.AllowedContentTypes, "image/vnd.microsoft.icon")
+	cfg.ContentTypeAliases = map[string]string{"image/x-icon": "image/vnd.microsoft.icon"}
+	s := newTestService(t, cfg, newFakeStorage("media"))
+
+	for _, tc := range []struct {
+		declared, want, extension string
+	}{
+		{"image/jpg", "image/jpeg", ".jpg"},
+		{"image/pjpeg", "image/jpeg", ".jpg"},
+		{"image/x-png", "image/png", ".png"},
+		{"IMAGE/PNG", "image/png", ".png"},
+		{"text/plain; charset=utf-8", "text/plain", ""},
+		{`image/jpeg; name="a b.jpg"`, "image/jpeg", ".jpg"},
+		{"image/x-icon", "image/vnd.microsoft.icon", ""},
+	} {
+		resp, err := s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{ContentType: tc.declared})
+		if err != nil {
+			t.Errorf("%q: %v", tc.declared, err)
+			continue
+		}
+		if got := resp.FormData["Content-Type"]; got != tc.want {
+			t.Errorf("%q signed as %q, want %q", tc.declared, got, tc.want)
+		}
+		if tc.extension != "" && !strings.HasSuffix(resp.ObjectKey, tc.extension) {
+			t.Errorf("%q got key %q, want the %s extension of %s", tc.declared, resp.ObjectKey, tc.extension, tc.want)
+		}
+	}
+
+	for _, declared := range []string{"image/gif", "image/", "not a type"} {
+		_, err := s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{ContentType: declared})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%q: error = %v, want INVALID_ARGUMENT", declared, err)
+		}
+	}
+}
+
+func TestAllowedContentTypesAreNormalized(t *testing.T) {
+	cfg := testConfig()
+	cfg.AllowedContentTypes = []string{"image/jpg", "Text/Plain; charset=utf-8"}
+	s := newTestService(t, cfg, newFakeStorage("media"))
+
+	for _, declared := range []string{"image/jpeg", "image/jpg", "text/plain"} {
+		if _, err := s.PresignUpload(context.Background(), &mediabase_v1.PresignUploadRequest{ContentType: declared}); err != nil {
+			t.Errorf("%q against an allow-list written with aliases: %v", declared, err)
+		}
+	}
+}
+
+func TestContentTypeAliasesConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		aliases map[string]string
+		ok      bool
+	}{
+		{"none", nil, true},
+		{"extra alias", map[string]string{"image/x-icon": "image/vnd.microsoft.icon"}, true},
+		{"override default", map[string]string{"image/jpg": "image/png"}, true},
+		{"parameters", map[string]string{"text/x-plain": "text/plain; charset=utf-8"}, false},
+		{"upper case", map[string]string{"Image/X-Icon": "image/vnd.microsoft.icon"}, false},
+		{"chained", map[string]string{"image/x-jpg": "image/jpg"}, false},
+		{"invalid", map[string]string{"image/": "image/jpeg"}, false},
+	} {
+		cfg := testConfig()
+		cfg.ContentTypeAliases = tc.aliases
+		if err := cfg.Validate(); (err == nil) != tc.ok {
+			t.Errorf("%s: Validate() = %v, want ok %v", tc.name, err, tc.ok)
+		}
+	}
+}
+
+func TestEmptyContentTypeWithoutDefault(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t, testConfig(), newFakeStorage("media"))
+
+	_, err := s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{})
+	if status.Code(err) != codes.InvalidArgument || !strings.Contains(err.Error(), "content_type is required") {
+		t.Errorf("PresignUpload: error = %v, want INVALID_ARGUMENT asking for content_type", err)
+	}
+	_, err = s.PutObject(ctx, &mediabase_v1.PutObjectRequest{Content: []byte("png")})
+	if status.Code(err) != codes.InvalidArgument || !strings.Contains(err.Error(), "content_type is required") {
+		t.Errorf("PutObject: error = %v, want INVALID_ARGUMENT asking for content_type", err)
+	}
+}
+
+func TestEmptyContentTypeUsesDefault(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	cfg := testConfig()
+	// Written as an alias, so the default is normalized like a declared type
+	cfg.DefaultContentType = "image/jpg"
+	s := newTestService(t, cfg, fake)
+
+	resp, err := s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{})
+	if err != nil {
+		t.Fatalf("PresignUpload: %v", err)
+	}
+	if resp.FormData["Content-Type"] != "image/jpeg" || !strings.HasSuffix(resp.ObjectKey, ".jpg") {
+		t.Errorf("signed %q as %q, want a .jpg key signed as image/jpeg", resp.ObjectKey, resp.FormData["Content-Type"])
+	}
+
+	put, err := s.PutObject(ctx, &mediabase_v1.PutObjectRequest{Content: []byte("jpeg")})
+	if err != nil {
+		t.Fatalf("PutObject: %v", err)
+	}
+	if stored, err := fake.object("media", put.ObjectKey); err != nil || stored.contentType != "image/jpeg" {
+		t.Errorf("stored %s with %v, want image/jpeg", put.ObjectKey, err)
+	}
+
+	// A declared type still wins over the default
+	resp, err = s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{ContentType: "image/png"})
+	if err != nil || resp.FormData["Content-Type"] != "image/png" {
+		t.Errorf("declared image/png signed as %q, %v", resp.GetFormData()["Content-Type"], err)
+	}
+}
+
+func TestDefaultContentTypeMustBeAllowed(t *testing.T) {
+	for _, tc := range []struct {
+		defaultType string
+		ok          bool
+	}{
+		{"", true},
+		{"image/png", true},
+		{"image/x-png", true},
+		{"application/pdf", false},
+	} {
+		cfg := testConfig()
+		cfg.DefaultContentType = tc.defaultType
+		if err := cfg.Validate(); (err == nil) != tc.ok {
+			t.Errorf("DefaultContentType %q: Validate() = %v, want ok %v", tc.defaultType, err, tc.ok)
+		}
+	}
+}
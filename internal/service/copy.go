@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"github.com/gofreego/mediabase/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CopyObject copies an object within a bucket on the storage side. When the content type,
+// cache control or metadata is overridden, all attributes of the copy are replaced, so the
+// values that are not overridden are carried over from the source. Retries carrying the
+// idempotency key of an earlier copy get its response.
+func (s *Service) CopyObject(ctx context.Context, req *mediabase_v1.CopyObjectRequest) (*mediabase_v1.CopyObjectResponse, error) {
+	return idempotent(ctx, s, "CopyObject", req.IdempotencyKey, req, &mediabase_v1.CopyObjectResponse{}, func() (*mediabase_v1.CopyObjectResponse, error) {
+		return s.copyObject(ctx, req)
+	})
+}
+
+func (s *Service) copyObject(ctx context.Context, req *mediabase_v1.CopyObjectRequest) (*mediabase_v1.CopyObjectResponse, error) {
+	ctx = withLogFields(ctx, req.BucketName, req.SourceKey)
+	logDebug(ctx, "CopyObject request received, bucket: %s, source_key: %s, destination_key: %s, content_type: %s", req.BucketName, req.SourceKey, req.DestinationKey, req.ContentType)
+
+	if err := s.resolveBucket(&req.BucketName); err != nil {
+		return nil, err
+	}
+
+	if req.SourceKey == "" || req.DestinationKey == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "source_key and destination_key are required")
+	}
+	if err := s.validateObjectKey(req.DestinationKey); err != nil {
+		return nil, err
+	}
+
+	replace := req.ContentType != "" || req.CacheControl != "" || len(req.Metadata) > 0
+	if req.SourceKey == req.DestinationKey && !replace {
+		return nil, status.Errorf(codes.InvalidArgument, "copying an object onto itself requires an override")
+	}
+
+	if req.ContentType != "" && !s.isValidContentType(req.ContentType) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid content type: %s", req.ContentType)
+	}
+
+	if req.CacheControl != "" {
+		if err := validateCacheControl(req.CacheControl); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cache control: %v", err)
+		}
+	}
+
+	info, err := s.StatObject(ctx, req.BucketName, req.SourceKey)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := storage.CopyOptions{ReplaceMetadata: replace}
+	contentType := info.ContentType
+	if replace {
+		if req.ContentType != "" {
+			contentType = req.ContentType
+			if limit := s.maxFileSizeFor(contentType); info.Size > limit {
+				return nil, status.Errorf(codes.InvalidArgument, "object size %d exceeds server maximum allowed size %d for %s", info.Size, limit, contentType)
+			}
+		}
+
+		// Keep the attributes the service recorded itself, and the source application metadata unless replaced
+		opts.Metadata = make(map[string]string, len(info.UserMetadata)+len(req.Metadata))
+		recorded := 0
+		for k, v := range info.UserMetadata {
+			if reservedMetadataKeys[k] {
+				opts.Metadata[k] = v
+				recorded += len(k) + len(v)
+			} else if len(req.Metadata) == 0 {
+				opts.Metadata[k] = v
+			}
+		}
+		if req.CacheControl != "" {
+			recorded += len(req.CacheControl) - len(opts.Metadata[storage.CacheControlMetadataKey])
+			opts.Metadata[storage.CacheControlMetadataKey] = req.CacheControl
+		}
+		if len(req.Metadata) > 0 {
+			if err := validateMetadata(req.Metadata, recorded); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid metadata: %v", err)
+			}
+			for k, v := range req.Metadata {
+				opts.Metadata[k] = v
+			}
+		}
+
+		opts.ContentType = contentType
+		opts.CacheControl = opts.Metadata[storage.CacheControlMetadataKey]
+	}
+
+	if err := s.storage.CopyObject(ctx, req.BucketName, req.SourceKey, req.DestinationKey, opts); err != nil {
+		logger.Error(ctx, "Failed to copy object: %v", err)
+		return nil, storageError("failed to copy object", err)
+	}
+	if err := s.confirmCopy(ctx, req.BucketName, req.SourceKey, req.BucketName, req.DestinationKey); err != nil {
+		logger.Error(ctx, "Failed to mark copy %s as confirmed: %v", req.DestinationKey, err)
+		return nil, storageError("failed to copy object", err)
+	}
+
+	logDebug(ctx, "Object copied successfully: %s to %s in bucket: %s, replaced metadata: %v", req.SourceKey, req.DestinationKey, req.BucketName, replace)
+
+	return &mediabase_v1.CopyObjectResponse{
+		ObjectKey:   req.DestinationKey,
+		Size:        info.Size,
+		ContentType: contentType,
+	}, nil
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"github.com/gofreego/mediabase/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// StatObject returns the attributes of an object so callers can prepare a download,
+// or a NotFound error if it does not exist
+func (s *Service) StatObject(ctx context.Context, bucketName, objectKey string) (*storage.ObjectInfo, error) {
+	ctx = withLogFields(ctx, bucketName, objectKey)
+	logDebug(ctx, "StatObject request received, bucket: %s, object_key: %s", bucketName, objectKey)
+
+	if err := s.resolveBucket(&bucketName); err != nil {
+		return nil, err
+	}
+
+	info, err := s.statObject(ctx, bucketName, objectKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrBucketNotFound) {
+			s.buckets.forget(bucketName)
+		}
+		logger.Error(ctx, "Failed to stat object: %v", err)
+		return nil, storageError("failed to stat object", err)
+	}
+
+	return info, nil
+}
+
+// DownloadObject streams length bytes of an object starting at offset into the given writer.
+// A negative length streams the rest of the object; only the requested bytes are read from
+// storage. Cancelling ctx aborts the transfer.
+// Objects under one of the configured auto-delete prefixes are removed from storage
+// once the whole object has been written; a failed, aborted or partial copy leaves them in place.
+func (s *Service) DownloadObject(ctx context.Context, bucketName, objectKey string, offset, length int64, w io.Writer) error {
+	ctx = withLogFields(ctx, bucketName, objectKey)
+	logDebug(ctx, "DownloadObject request received, bucket: %s, object_key: %s, offset: %d, length: %d", bucketName, objectKey, offset, length)
+
+	if err := s.resolveBucket(&bucketName); err != nil {
+		return err
+	}
+
+	if offset < 0 {
+		return status.Errorf(codes.InvalidArgument, "offset must not be negative")
+	}
+
+	defer s.trackStream()()
+
+	var reader io.ReadCloser
+	var err error
+	if offset > 0 || length >= 0 {
+		reader, _, err = s.storage.GetObjectRange(ctx, bucketName, objectKey, offset, length)
+	} else {
+		reader, err = s.storage.GetObject(ctx, bucketName, objectKey)
+	}
+	if err != nil {
+		logger.Error(ctx, "Failed to get object: %v", err)
+		return storageError("failed to get object", err)
+	}
+	defer reader.Close()
+
+	written, err := storage.CopyStream(w, reader)
+	if err == nil {
+		// Buffered writers must deliver everything before the object counts as downloaded
+		if f, ok := w.(interface{ Flush() error }); ok {
+			err = f.Flush()
+		}
+	}
+	if err != nil {
+		logger.Error(ctx, "Failed to stream object %s after %d bytes: %v", objectKey, written, err)
+		return storageError("failed to stream object", err)
+	}
+
+	logDebug(ctx, "Object streamed successfully: %s, bytes: %d", objectKey, written)
+	s.logAccess(ctx, AccessDownload, bucketName, objectKey, "", written)
+
+	if offset == 0 && length < 0 && s.isAutoDeleteOnDownload(objectKey) {
+		// The response has already been sent, so a failed delete is only logged
+		if err := s.storage.DeleteObject(ctx, bucketName, objectKey); err != nil {
+			logger.Error(ctx, "Failed to auto-delete object %s after download: %v", objectKey, err)
+		} else {
+			logDebug(ctx, "Object auto-deleted after download: %s", objectKey)
+			s.logAccess(ctx, AccessDelete, bucketName, objectKey, "", 0)
+		}
+	}
+
+	return nil
+}
+
+// GetObject streams an object to gRPC clients in chunks of the configured size, after a first
+// message with its attributes. Cancelling the stream stops the storage read.
+func (s *Service) GetObject(req *mediabase_v1.GetObjectRequest, stream mediabase_v1.MediabaseService_GetObjectServer) error {
+	ctx := withLogFields(stream.Context(), req.BucketName, req.ObjectKey)
+	logDebug(ctx, "GetObject request received, bucket: %s, object_key: %s, offset: %d, length: %d", req.BucketName, req.ObjectKey, req.Offset, req.Length)
+
+	if err := s.resolveBucket(&req.BucketName); err != nil {
+		return err
+	}
+	if req.Offset < 0 || req.Length < 0 {
+		return status.Errorf(codes.InvalidArgument, "offset and length must not be negative")
+	}
+
+	info, err := s.StatObject(ctx, req.BucketName, req.ObjectKey)
+	if err != nil {
+		return err
+	}
+	if req.Offset > info.Size {
+		return status.Errorf(codes.OutOfRange, "offset %d is beyond the end of the object, size is %d", req.Offset, info.Size)
+	}
+
+	length := info.Size - req.Offset
+	if req.Length > 0 && req.Length < length {
+		length = req.Length
+	}
+
+	err = stream.Send(&mediabase_v1.GetObjectResponse{
+		Data: &mediabase_v1.GetObjectResponse_Metadata{
+			Metadata: &mediabase_v1.GetObjectMetadata{
+				ContentType:  info.ContentType,
+				Size:         info.Size,
+				Length:       length,
+				Etag:         info.ETag,
+				LastModified: timestamppb.New(info.LastModified),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if length == 0 && req.Offset > 0 {
+		// Nothing is left to send after the offset
+		return nil
+	}
+
+	// A whole object is streamed to the end so auto-delete can tell it was fully sent
+	streamLength := int64(-1)
+	if req.Offset > 0 || length < info.Size {
+		streamLength = length
+	}
+	chunks := bufio.NewWriterSize(&chunkSender{stream: stream, size: s.downloadChunkSize}, s.downloadChunkSize)
+	return s.DownloadObject(ctx, req.BucketName, req.ObjectKey, req.Offset, streamLength, chunks)
+}
+
+// chunkSender sends content written to it as chunk messages of at most size bytes
+type chunkSender struct {
+	stream mediabase_v1.MediabaseService_GetObjectServer
+	size   int
+}
+
+func (c *chunkSender) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := min(len(p), c.size)
+		if err := c.stream.Send(&mediabase_v1.GetObjectResponse{Data: &mediabase_v1.GetObjectResponse_Chunk{Chunk: p[:n]}}); err != nil {
+			return written, err
+		}
+		written += n
+		p = p[n:]
+	}
+	return written, nil
+}
+
+// isAutoDeleteOnDownload checks if the object key falls under one of the configured auto-delete prefixes
+func (s *Service) isAutoDeleteOnDownload(objectKey string) bool {
+	for _, prefix := range s.autoDeleteOnDownloadPrefixes {
+		if strings.HasPrefix(s.normalizeKey(objectKey), prefix) {
+			return true
+		}
+	}
+	return false
+}
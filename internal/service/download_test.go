@@ -0,0 +1,114 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+)
+
+// failingWriter accepts limit bytes and then fails, like a client that went away
+type failingWriter struct {
+	limit int
+	buf   bytes.Buffer
+}
+
+func (f *failingWriter) Write(p []byte) (int, error) {
+	if f.buf.Len()+len(p) > f.limit {
+		n := f.limit - f.buf.Len()
+		f.buf.Write(p[:n])
+		return n, errors.New("connection reset by peer")
+	}
+	return f.buf.Write(p)
+}
+
+// flushFailingWriter buffers everything and fails to deliver it
+type flushFailingWriter struct {
+	bytes.Buffer
+}
+
+func (f *flushFailingWriter) Flush() error {
+	return errors.New("broken pipe")
+}
+
+func autoDeleteTestService(t *testing.T, fake *fakeStorage) *Service {
+	cfg := testConfig()
+	cfg.AutoDeleteOnDownloadPrefixes = []string{"once/"}
+	return newTestService(t, cfg, fake)
+}
+
+func exists(fake *fakeStorage, objectKey string) bool {
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	_, ok := fake.buckets["media"][objectKey]
+	return ok
+}
+
+func TestDownloadObjectAutoDeletesAfterFullDownload(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	fake.put("media", "once/a.png", []byte("ephemeral"), "image/png", nil)
+	fake.put("media", "keep/a.png", []byte("durable"), "image/png", nil)
+	s := autoDeleteTestService(t, fake)
+
+	var buf bytes.Buffer
+	if err := s.DownloadObject(ctx, "media", "once/a.png", 0, -1, &buf); err != nil {
+		t.Fatalf("DownloadObject: %v", err)
+	}
+	if buf.String() != "ephemeral" {
+		t.Errorf("downloaded %q", buf.String())
+	}
+	if exists(fake, "once/a.png") {
+		t.Error("object under the prefix kept after a full download")
+	}
+
+	if err := s.DownloadObject(ctx, "media", "keep/a.png", 0, -1, &bytes.Buffer{}); err != nil {
+		t.Fatal(err)
+	}
+	if !exists(fake, "keep/a.png") {
+		t.Error("object outside the prefix deleted")
+	}
+}
+
+func TestDownloadObjectKeepsObjectAfterIncompleteDownload(t *testing.T) {
+	ctx := context.Background()
+	data := bytes.Repeat([]byte("x"), 1000)
+
+	for _, tc := range []struct {
+		name           string
+		offset, length int64
+		w              io.Writer
+		wantErr        bool
+	}{
+		{"aborted", 0, -1, &failingWriter{limit: 10}, true},
+		{"undelivered", 0, -1, &flushFailingWriter{}, true},
+		{"leading range", 0, 100, &bytes.Buffer{}, false},
+		{"trailing range", 900, -1, &bytes.Buffer{}, false},
+		{"whole length as range", 0, 1000, &bytes.Buffer{}, false},
+	} {
+		fake := newFakeStorage("media")
+		fake.put("media", "once/a.bin", data, "text/plain", nil)
+		s := autoDeleteTestService(t, fake)
+
+		err := s.DownloadObject(ctx, "media", "once/a.bin", tc.offset, tc.length, tc.w)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("%s: error = %v, want error %v", tc.name, err, tc.wantErr)
+		}
+		if !exists(fake, "once/a.bin") {
+			t.Errorf("%s: object deleted", tc.name)
+		}
+	}
+}
+
+func TestDownloadObjectAutoDeleteFailureIsNotReported(t *testing.T) {
+	fake := newFakeStorage("media")
+	fake.put("media", "once/a.png", []byte("png"), "image/png", nil)
+	fake.failWith("DeleteObject", errors.New("storage unavailable"))
+	s := autoDeleteTestService(t, fake)
+
+	// The client already has the bytes, so the download itself succeeded
+	if err := s.DownloadObject(context.Background(), "media", "once/a.png", 0, -1, &bytes.Buffer{}); err != nil {
+		t.Errorf("DownloadObject: %v", err)
+	}
+}
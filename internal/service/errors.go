@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gofreego/mediabase/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// storageErrorCodes maps storage sentinel errors to the gRPC codes reported to clients
+var storageErrorCodes = []struct {
+	err  error
+	code codes.Code
+}{
+	{storage.ErrObjectNotFound, codes.NotFound},
+	{storage.ErrBucketNotFound, codes.NotFound},
+	{storage.ErrBucketNotEmpty, codes.FailedPrecondition},
+	{storage.ErrAccessDenied, codes.PermissionDenied},
+	{storage.ErrQuotaExceeded, codes.ResourceExhausted},
+	{storage.ErrChecksumMismatch, codes.InvalidArgument},
+	{storage.ErrNotSupported, codes.Unimplemented},
+	{context.Canceled, codes.Canceled},
+	{context.DeadlineExceeded, codes.DeadlineExceeded},
+}
+
+// storageError wraps a storage failure with a message and converts known failures into gRPC
+// statuses, so the gateway answers with a matching HTTP status instead of 500.
+// Errors that already carry a status keep their code; others stay plain errors, which clients see as UNKNOWN.
+func storageError(msg string, err error) error {
+	if st, ok := status.FromError(err); ok {
+		return status.Errorf(st.Code(), "%s: %s", msg, st.Message())
+	}
+	for _, m := range storageErrorCodes {
+		if errors.Is(err, m.err) {
+			return status.Errorf(m.code, "%s: %v", msg, err)
+		}
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
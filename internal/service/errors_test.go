@@ -0,0 +1,103 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"github.com/gofreego/mediabase/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMissingObjectsAndBucketsAreNotFound(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t, testConfig(), newFakeStorage("media"))
+
+	for name, call := range map[string]func() error{
+		"StatObject": func() error {
+			_, err := s.StatObject(ctx, "media", "missing.png")
+			return err
+		},
+		"DownloadObject": func() error {
+			return s.DownloadObject(ctx, "media", "missing.png", 0, -1, &bytes.Buffer{})
+		},
+		"GetObjectMetadata": func() error {
+			_, err := s.GetObjectMetadata(ctx, &mediabase_v1.GetObjectMetadataRequest{BucketName: "media", ObjectKey: "missing.png"})
+			return err
+		},
+		"PresignDownload": func() error {
+			_, err := s.PresignDownload(ctx, &mediabase_v1.PresignDownloadRequest{BucketName: "media", ObjectKey: "missing.png"})
+			return err
+		},
+		"missing bucket": func() error {
+			_, err := s.StatObject(ctx, "other", "a.png")
+			return err
+		},
+	} {
+		if err := call(); status.Code(err) != codes.NotFound {
+			t.Errorf("%s: error = %v, want NOT_FOUND", name, err)
+		}
+	}
+}
+
+func TestStorageError(t *testing.T) {
+	for _, tc := range []struct {
+		err  error
+		want codes.Code
+	}{
+		{fmt.Errorf("stat: %w", storage.ErrObjectNotFound), codes.NotFound},
+		{fmt.Errorf("stat: %w", storage.ErrBucketNotFound), codes.NotFound},
+		{storage.ErrBucketNotEmpty, codes.FailedPrecondition},
+		{fmt.Errorf("put: %w", storage.ErrAccessDenied), codes.PermissionDenied},
+		{storage.ErrQuotaExceeded, codes.ResourceExhausted},
+		{storage.ErrChecksumMismatch, codes.InvalidArgument},
+		{storage.ErrNotSupported, codes.Unimplemented},
+		{context.Canceled, codes.Canceled},
+		{fmt.Errorf("list: %w", context.DeadlineExceeded), codes.DeadlineExceeded},
+		{status.Error(codes.Aborted, "conflict"), codes.Aborted},
+		{errors.New("connection reset"), codes.Unknown},
+	} {
+		err := storageError("failed to do it", tc.err)
+		if got := status.Code(err); got != tc.want {
+			t.Errorf("%v mapped to %s, want %s", tc.err, got, tc.want)
+		}
+		if !strings.HasPrefix(status.Convert(err).Message(), "failed to do it: ") {
+			t.Errorf("%v: message %q lost the context", tc.err, status.Convert(err).Message())
+		}
+	}
+
+	// Unmapped errors stay inspectable by callers
+	cause := errors.New("connection reset")
+	if err := storageError("failed", cause); !errors.Is(err, cause) {
+		t.Errorf("unmapped error %v does not wrap its cause", err)
+	}
+}
+
+func TestRPCsReportMappedStatuses(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	fake.put("media", "a.png", []byte("png"), "image/png", nil)
+	s := newTestService(t, testConfig(), fake)
+
+	fake.failWith("DeleteObject", fmt.Errorf("delete: %w", storage.ErrAccessDenied))
+	_, err := s.DeleteObject(ctx, &mediabase_v1.DeleteObjectRequest{BucketName: "media", ObjectKey: "a.png"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("DeleteObject denied by storage: error = %v, want PERMISSION_DENIED", err)
+	}
+
+	fake.failWith("PutObject", storage.ErrQuotaExceeded)
+	_, err = s.PutObject(ctx, &mediabase_v1.PutObjectRequest{BucketName: "media", ContentType: "image/png", Content: []byte("png")})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("PutObject into a full store: error = %v, want RESOURCE_EXHAUSTED", err)
+	}
+
+	_, err = s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{BucketName: "media", ContentType: "application/x-msdownload"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("PresignUpload of a disallowed type: error = %v, want INVALID_ARGUMENT", err)
+	}
+}
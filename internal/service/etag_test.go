@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+)
+
+func TestETagIsReturnedAndStable(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	s := newTestService(t, testConfig(), fake)
+
+	put, err := s.PutObject(ctx, &mediabase_v1.PutObjectRequest{ContentType: "text/plain", Content: []byte("first")})
+	if err != nil {
+		t.Fatalf("PutObject: %v", err)
+	}
+	if put.Etag == "" {
+		t.Fatal("PutObject returned no ETag")
+	}
+
+	for range 2 {
+		confirmed, err := s.ConfirmUpload(ctx, &mediabase_v1.ConfirmUploadRequest{ObjectKey: put.ObjectKey})
+		if err != nil {
+			t.Fatalf("ConfirmUpload: %v", err)
+		}
+		metadata, err := s.GetObjectMetadata(ctx, &mediabase_v1.GetObjectMetadataRequest{ObjectKey: put.ObjectKey})
+		if err != nil {
+			t.Fatalf("GetObjectMetadata: %v", err)
+		}
+		if confirmed.Etag != put.Etag || metadata.Etag != put.Etag {
+			t.Errorf("ETags put %q, confirm %q, metadata %q, want one value for an unchanged object", put.Etag, confirmed.Etag, metadata.Etag)
+		}
+	}
+
+	// Overwrite the object behind the service's back, as a second upload to the key would
+	fake.put("media", put.ObjectKey, []byte("second"), "text/plain", nil)
+	metadata, err := s.GetObjectMetadata(ctx, &mediabase_v1.GetObjectMetadataRequest{ObjectKey: put.ObjectKey})
+	if err != nil {
+		t.Fatalf("GetObjectMetadata: %v", err)
+	}
+	if metadata.Etag == put.Etag {
+		t.Error("ETag unchanged after the content changed")
+	}
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultMaxExistsBatchSize is used when MaxExistsBatchSize is not set
+	defaultMaxExistsBatchSize = 1000
+	// defaultExistsBatchWorkers is used when ExistsBatchWorkers is not set
+	defaultExistsBatchWorkers = 8
+)
+
+// BatchObjectExists checks which of several objects exist. The keys are checked concurrently
+// by a bounded number of workers, and a failed check is reported in its result rather than as
+// a missing object, so callers never mistake an outage for a free key.
+func (s *Service) BatchObjectExists(ctx context.Context, req *mediabase_v1.BatchObjectExistsRequest) (*mediabase_v1.BatchObjectExistsResponse, error) {
+	ctx = withLogFields(ctx, req.BucketName, "")
+	logDebug(ctx, "BatchObjectExists request received, bucket: %s, object_keys: %d", req.BucketName, len(req.ObjectKeys))
+
+	if err := s.resolveBucket(&req.BucketName); err != nil {
+		return nil, err
+	}
+	if len(req.ObjectKeys) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "at least one object key is required")
+	}
+	if len(req.ObjectKeys) > s.maxExistsBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch contains %d object keys, at most %d are allowed", len(req.ObjectKeys), s.maxExistsBatchSize)
+	}
+
+	results := make([]*mediabase_v1.ObjectExistsResult, len(req.ObjectKeys))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range min(s.existsBatchWorkers, len(req.ObjectKeys)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i] = s.objectExists(ctx, req.BucketName, req.ObjectKeys[i])
+			}
+		}()
+	}
+	for i := range req.ObjectKeys {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	// A cancelled request fails as a whole instead of reporting every remaining key as failed
+	if err := ctx.Err(); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+
+	existing, failed := 0, 0
+	for _, result := range results {
+		if result.ErrorCode != 0 {
+			failed++
+		} else if result.Exists {
+			existing++
+		}
+	}
+	logDebug(ctx, "Object existence batch processed, object_keys: %d, existing: %d, failed: %d", len(req.ObjectKeys), existing, failed)
+
+	return &mediabase_v1.BatchObjectExistsResponse{Results: results}, nil
+}
+
+// objectExists checks one key of a batch
+func (s *Service) objectExists(ctx context.Context, bucketName, objectKey string) *mediabase_v1.ObjectExistsResult {
+	result := &mediabase_v1.ObjectExistsResult{ObjectKey: objectKey}
+
+	err := s.validateObjectKey(objectKey)
+	if err == nil && objectKey == "" {
+		err = status.Errorf(codes.InvalidArgument, "object key must not be empty")
+	}
+	if err == nil {
+		result.Exists, err = s.storage.ObjectExists(ctx, bucketName, objectKey)
+		if err != nil {
+			logger.Error(ctx, "Failed to check if object %s exists: %v", objectKey, err)
+			err = storageError("failed to check object existence", err)
+		}
+	}
+
+	if err != nil {
+		st := status.Convert(err)
+		result.ErrorCode = int32(st.Code())
+		result.ErrorMessage = st.Message()
+	}
+	return result
+}
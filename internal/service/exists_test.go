@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"github.com/gofreego/mediabase/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// existsStorage records how many existence checks run at once and fails those of keys under
+// broken/, as an unreachable storage node would
+type existsStorage struct {
+	*fakeStorage
+	inFlight atomic.Int64
+	peak     atomic.Int64
+}
+
+func (e *existsStorage) ObjectExists(ctx context.Context, bucketName, objectKey string) (bool, error) {
+	n := e.inFlight.Add(1)
+	defer e.inFlight.Add(-1)
+	for {
+		peak := e.peak.Load()
+		if n <= peak || e.peak.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+	time.Sleep(2 * time.Millisecond)
+	if strings.HasPrefix(objectKey, "broken/") {
+		return false, storage.ErrAccessDenied
+	}
+	return e.fakeStorage.ObjectExists(ctx, bucketName, objectKey)
+}
+
+func existsTestService(t *testing.T, workers, maxBatch int) (*Service, *existsStorage) {
+	st := &existsStorage{fakeStorage: newFakeStorage("media")}
+	cfg := testConfig()
+	cfg.ExistsBatchWorkers = workers
+	cfg.MaxExistsBatchSize = maxBatch
+	return newTestService(t, cfg, st), st
+}
+
+func TestBatchObjectExistsResults(t *testing.T) {
+	s, st := existsTestService(t, 4, 0)
+	st.put("media", "a.png", []byte("png"), "image/png", nil)
+	st.put("media", "c.png", []byte("png"), "image/png", nil)
+
+	keys := []string{"a.png", "b.png", "c.png", "broken/d.png", "", "a.png"}
+	resp, err := s.BatchObjectExists(context.Background(), &mediabase_v1.BatchObjectExistsRequest{ObjectKeys: keys})
+	if err != nil {
+		t.Fatalf("BatchObjectExists: %v", err)
+	}
+	if len(resp.Results) != len(keys) {
+		t.Fatalf("%d results for %d keys", len(resp.Results), len(keys))
+	}
+
+	for i, want := range []struct {
+		exists bool
+		code   codes.Code
+	}{
+		{true, codes.OK},
+		{false, codes.OK},
+		{true, codes.OK},
+		// A failed check is an error, never a missing object
+		{false, codes.PermissionDenied},
+		{false, codes.InvalidArgument},
+		{true, codes.OK},
+	} {
+		result := resp.Results[i]
+		if result.ObjectKey != keys[i] {
+			t.Errorf("result %d is for %q, want %q in request order", i, result.ObjectKey, keys[i])
+		}
+		if result.Exists != want.exists || codes.Code(result.ErrorCode) != want.code {
+			t.Errorf("%q: exists %v, error %s %q, want exists %v, error %s", keys[i], result.Exists, codes.Code(result.ErrorCode), result.ErrorMessage, want.exists, want.code)
+		}
+		if want.code != codes.OK && result.ErrorMessage == "" {
+			t.Errorf("%q: failed without a message", keys[i])
+		}
+	}
+}
+
+func TestBatchObjectExistsBoundsConcurrency(t *testing.T) {
+	s, st := existsTestService(t, 3, 0)
+	keys := make([]string, 30)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("%02d.png", i)
+		if i%2 == 0 {
+			st.put("media", keys[i], []byte("png"), "image/png", nil)
+		}
+	}
+
+	resp, err := s.BatchObjectExists(context.Background(), &mediabase_v1.BatchObjectExistsRequest{ObjectKeys: keys})
+	if err != nil {
+		t.Fatalf("BatchObjectExists: %v", err)
+	}
+	if peak := st.peak.Load(); peak > 3 || peak < 2 {
+		t.Errorf("%d checks ran at once, want them concurrent and at most 3", peak)
+	}
+	for i, result := range resp.Results {
+		if result.Exists != (i%2 == 0) || result.ErrorCode != 0 {
+			t.Errorf("%q: exists %v, error %d", result.ObjectKey, result.Exists, result.ErrorCode)
+		}
+	}
+}
+
+func TestBatchObjectExistsRejectsBadBatches(t *testing.T) {
+	ctx := context.Background()
+	s, st := existsTestService(t, 2, 5)
+
+	for name, keys := range map[string][]string{
+		"empty":     nil,
+		"too large": {"1", "2", "3", "4", "5", "6"},
+	} {
+		_, err := s.BatchObjectExists(ctx, &mediabase_v1.BatchObjectExistsRequest{ObjectKeys: keys})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s batch: error = %v, want INVALID_ARGUMENT", name, err)
+		}
+	}
+	if st.peak.Load() != 0 {
+		t.Error("rejected batches reached storage")
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err := s.BatchObjectExists(cancelled, &mediabase_v1.BatchObjectExistsRequest{ObjectKeys: []string{"a.png"}})
+	if status.Code(err) != codes.Canceled {
+		t.Errorf("cancelled request: error = %v, want CANCELED", err)
+	}
+}
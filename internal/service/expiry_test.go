@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const sevenDays = int32(7 * 24 * 60 * 60)
+
+func TestPresignExpiryBoundary(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	fake.put("media", "a.png", []byte("png"), "image/png", nil)
+	s := newTestService(t, testConfig(), fake)
+
+	for _, tc := range []struct {
+		expiresIn int32
+		want      codes.Code
+	}{
+		{sevenDays, codes.OK},
+		{sevenDays + 1, codes.InvalidArgument},
+		{1, codes.OK},
+		{-1, codes.InvalidArgument},
+	} {
+		upload, err := s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{ContentType: "image/png", ExpiresIn: tc.expiresIn})
+		if status.Code(err) != tc.want {
+			t.Errorf("upload expiring in %ds: error = %v, want %s", tc.expiresIn, err, tc.want)
+		} else if err == nil && upload.ExpiresIn != tc.expiresIn {
+			t.Errorf("upload expires_in = %d, want %d", upload.ExpiresIn, tc.expiresIn)
+		}
+
+		download, err := s.PresignDownload(ctx, &mediabase_v1.PresignDownloadRequest{ObjectKey: "a.png", ExpiresIn: tc.expiresIn})
+		if status.Code(err) != tc.want {
+			t.Errorf("download expiring in %ds: error = %v, want %s", tc.expiresIn, err, tc.want)
+		} else if err == nil && download.ExpiresIn != tc.expiresIn {
+			t.Errorf("download expires_in = %d, want %d", download.ExpiresIn, tc.expiresIn)
+		}
+
+		_, err = s.PresignHead(ctx, &mediabase_v1.PresignHeadRequest{ObjectKey: "a.png", ExpiresIn: tc.expiresIn})
+		if status.Code(err) != tc.want {
+			t.Errorf("head expiring in %ds: error = %v, want %s", tc.expiresIn, err, tc.want)
+		}
+	}
+	if got := fake.callCount("GeneratePresignedUploadURL"); got != 2 {
+		t.Errorf("%d upload URLs signed, want only those within the limit", got)
+	}
+}
+
+func TestPresignExpiryConfiguredMaximum(t *testing.T) {
+	ctx := context.Background()
+	cfg := testConfig()
+	cfg.MaxPresignExpiry = time.Minute
+	s := newTestService(t, cfg, newFakeStorage("media"))
+
+	if _, err := s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{ContentType: "image/png", ExpiresIn: 60}); err != nil {
+		t.Errorf("expiry at the configured maximum: %v", err)
+	}
+	if _, err := s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{ContentType: "image/png", ExpiresIn: 61}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expiry over the configured maximum: error = %v, want INVALID_ARGUMENT", err)
+	}
+
+	// The default expiry is shortened to fit under the maximum
+	resp, err := s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{ContentType: "image/png"})
+	if err != nil {
+		t.Fatalf("PresignUpload: %v", err)
+	}
+	if resp.ExpiresIn != 60 {
+		t.Errorf("default expires_in = %d, want it capped at 60", resp.ExpiresIn)
+	}
+
+	constraints, err := s.GetUploadConstraints(ctx, &mediabase_v1.GetUploadConstraintsRequest{})
+	if err != nil {
+		t.Fatalf("GetUploadConstraints: %v", err)
+	}
+	if constraints.MaxExpiresIn != 60 {
+		t.Errorf("max_expires_in = %d, want 60", constraints.MaxExpiresIn)
+	}
+}
+
+func TestMaxPresignExpiryConfig(t *testing.T) {
+	for _, tc := range []struct {
+		expiry time.Duration
+		ok     bool
+	}{
+		{0, true},
+		{time.Second, true},
+		{time.Millisecond, false},
+		{-time.Second, false},
+	} {
+		cfg := testConfig()
+		cfg.MaxPresignExpiry = tc.expiry
+		if err := cfg.Validate(); (err == nil) != tc.ok {
+			t.Errorf("MaxPresignExpiry %s: Validate() = %v, want ok %v", tc.expiry, err, tc.ok)
+		}
+	}
+}
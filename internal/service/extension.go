@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/gofreego/goutils/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Handling of file names whose extension contradicts the declared content type, selectable
+// through Config.ExtensionMismatch
+const (
+	// ExtensionMismatchReject rejects the upload with INVALID_ARGUMENT
+	ExtensionMismatchReject = "reject"
+	// ExtensionMismatchWarn logs a warning and lets the upload through
+	ExtensionMismatchWarn = "warn"
+)
+
+// contentTypeExtensions lists the file extensions of known content types, canonical one first
+var contentTypeExtensions = map[string][]string{
+	"image/jpeg": {".jpg", ".jpeg", ".jpe"},
+	"image/png":  {".png"},
+	"image/webp": {".webp"},
+}
+
+// validateExtensionMismatch checks a mismatch handling name; empty selects reject
+func validateExtensionMismatch(mode string) error {
+	switch mode {
+	case "", ExtensionMismatchReject, ExtensionMismatchWarn:
+		return nil
+	}
+	return fmt.Errorf("unknown extension mismatch handling: %s", mode)
+}
+
+// checkFileExtension compares the extension of a caller-supplied file name with the declared
+// content type. Only pairs the extension map knows about can mismatch: a known extension of
+// another content type, or an unknown extension for a known content type. File names without
+// an extension are accepted.
+func (s *Service) checkFileExtension(ctx context.Context, fileName, contentType string) error {
+	ext := strings.ToLower(path.Ext(fileName))
+	if ext == "" || extensionMatches(ext, contentType) {
+		return nil
+	}
+
+	if s.extensionMismatch == ExtensionMismatchWarn {
+		logger.Warn(ctx, "File name %s does not match content type %s", fileName, contentType)
+		return nil
+	}
+	return status.Errorf(codes.InvalidArgument, "file name extension %s does not match content type %s", ext, contentType)
+}
+
+// extensionMatches reports whether ext is consistent with contentType according to the extension map
+func extensionMatches(ext, contentType string) bool {
+	if exts, ok := contentTypeExtensions[contentType]; ok {
+		return slices.Contains(exts, ext)
+	}
+	for _, exts := range contentTypeExtensions {
+		if slices.Contains(exts, ext) {
+			return false
+		}
+	}
+	return true
+}
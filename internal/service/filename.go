@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Handling of caller-supplied file names that break the configured rules, selectable through
+// FileNameConfig.Mode
+const (
+	// FileNameReject rejects the upload with INVALID_ARGUMENT
+	FileNameReject = "reject"
+	// FileNameSanitize replaces disallowed characters and shortens the name, keeping its extension
+	FileNameSanitize = "sanitize"
+)
+
+const (
+	// defaultMaxFileNameLength is used when FileNameConfig.MaxLength is not set
+	defaultMaxFileNameLength = 255
+	// fileNameReplacement replaces disallowed characters when sanitizing
+	fileNameReplacement = "_"
+)
+
+// FileNameConfig restricts the file names callers may choose for their uploads
+type FileNameConfig struct {
+	// MaxLength caps file names at a number of UTF-8 bytes (defaults to 255)
+	MaxLength int `yaml:"MaxLength"`
+	// AllowedCharacters is a regular expression matching a single allowed character, e.g.
+	// "[A-Za-z0-9._-]". Empty allows every character except control characters, which are
+	// never allowed.
+	AllowedCharacters string `yaml:"AllowedCharacters"`
+	// Mode decides what happens to a file name breaking the rules: reject (default) or sanitize
+	Mode string `yaml:"Mode"`
+}
+
+// validate rejects rules the service cannot apply
+func (c FileNameConfig) validate() error {
+	if c.MaxLength < 0 {
+		return errors.New("FileName.MaxLength must not be negative")
+	}
+	if c.AllowedCharacters != "" {
+		if _, err := regexp.Compile(c.AllowedCharacters); err != nil {
+			return fmt.Errorf("FileName.AllowedCharacters is not a valid regular expression: %w", err)
+		}
+		if c.Mode == FileNameSanitize && !regexp.MustCompile(`^(?:`+c.AllowedCharacters+`)$`).MatchString(fileNameReplacement) {
+			return fmt.Errorf("FileName.AllowedCharacters must allow %q, which replaces disallowed characters", fileNameReplacement)
+		}
+	}
+	switch c.Mode {
+	case "", FileNameReject, FileNameSanitize:
+		return nil
+	}
+	return fmt.Errorf("unknown file name mode: %s", c.Mode)
+}
+
+// fileNamePolicy applies a FileNameConfig to caller-supplied file names
+type fileNamePolicy struct {
+	maxLength int
+	allowed   *regexp.Regexp
+	sanitize  bool
+}
+
+// newFileNamePolicy compiles a validated config
+func newFileNamePolicy(cfg FileNameConfig) fileNamePolicy {
+	p := fileNamePolicy{
+		maxLength: cfg.MaxLength,
+		sanitize:  cfg.Mode == FileNameSanitize,
+	}
+	if p.maxLength == 0 {
+		p.maxLength = defaultMaxFileNameLength
+	}
+	if cfg.AllowedCharacters != "" {
+		p.allowed = regexp.MustCompile(`^(?:` + cfg.AllowedCharacters + `)$`)
+	}
+	return p
+}
+
+// allows reports whether a single character may appear in a file name
+func (p fileNamePolicy) allows(r rune) bool {
+	if r == utf8.RuneError || unicode.IsControl(r) {
+		return false
+	}
+	return p.allowed == nil || p.allowed.MatchString(string(r))
+}
+
+// checkFileName applies the file name rules to a caller-supplied name and returns the name to
+// use. In sanitize mode, disallowed characters are replaced and an overlong name is shortened
+// before its extension; otherwise a name breaking the rules is rejected.
+func (s *Service) checkFileName(ctx context.Context, fileName string) (string, error) {
+	p := s.fileNames
+	if !p.sanitize {
+		for _, r := range fileName {
+			if !p.allows(r) {
+				return "", status.Errorf(codes.InvalidArgument, "file name contains the disallowed character %q", r)
+			}
+		}
+		if len(fileName) > p.maxLength {
+			return "", status.Errorf(codes.InvalidArgument, "file name is %d bytes long, at most %d are allowed", len(fileName), p.maxLength)
+		}
+		return fileName, nil
+	}
+
+	var b strings.Builder
+	for _, r := range fileName {
+		if p.allows(r) {
+			b.WriteRune(r)
+		} else {
+			b.WriteString(fileNameReplacement)
+		}
+	}
+	sanitized := b.String()
+
+	if len(sanitized) > p.maxLength {
+		ext := path.Ext(sanitized)
+		if len(ext) >= p.maxLength {
+			return "", status.Errorf(codes.InvalidArgument, "file name extension is %d bytes long, at most %d are allowed", len(ext), p.maxLength-1)
+		}
+		base := strings.TrimSuffix(sanitized, ext)
+		sanitized = truncateUTF8(base, p.maxLength-len(ext)) + ext
+	}
+
+	if sanitized != fileName {
+		logDebug(ctx, "File name %q sanitized to %q", fileName, sanitized)
+	}
+	return sanitized, nil
+}
+
+// truncateUTF8 shortens s to at most n bytes without splitting a character
+func truncateUTF8(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func fileNameTestService(t *testing.T, fileNames FileNameConfig) *Service {
+	cfg := testConfig()
+	cfg.FileName = fileNames
+	return newTestService(t, cfg, newFakeStorage("media"))
+}
+
+func TestFileNamesRejected(t *testing.T) {
+	ctx := context.Background()
+	defaults := fileNameTestService(t, FileNameConfig{})
+	ascii := fileNameTestService(t, FileNameConfig{AllowedCharacters: "[A-Za-z0-9._-]", MaxLength: 16})
+
+	for _, tc := range []struct {
+		name     string
+		s        *Service
+		fileName string
+		ok       bool
+	}{
+		{"plain", defaults, "photo.png", true},
+		{"unicode and emoji", defaults, "été 😀.png", true},
+		{"null byte", defaults, "a\x00b.png", false},
+		{"newline", defaults, "a\nb.png", false},
+		{"escape sequence", defaults, "\x1b[31mred.png", false},
+		{"invalid UTF-8", defaults, "a\xffb.png", false},
+		{"at the default length", defaults, strings.Repeat("a", 251) + ".png", true},
+		{"over the default length", defaults, strings.Repeat("a", 252) + ".png", false},
+		{"allowed characters", ascii, "my-photo_1.png", true},
+		{"space", ascii, "my photo.png", false},
+		{"emoji", ascii, "😀.png", false},
+		{"over the configured length", ascii, "abcdefghijkl.png1", false},
+	} {
+		got, err := tc.s.checkFileName(ctx, tc.fileName)
+		if tc.ok && (err != nil || got != tc.fileName) {
+			t.Errorf("%s: checkFileName(%q) = %q, %v, want it unchanged", tc.name, tc.fileName, got, err)
+		}
+		if !tc.ok && status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: checkFileName(%q) = %q, %v, want INVALID_ARGUMENT", tc.name, tc.fileName, got, err)
+		}
+	}
+}
+
+func TestFileNamesSanitized(t *testing.T) {
+	ctx := context.Background()
+	ascii := fileNameTestService(t, FileNameConfig{AllowedCharacters: "[A-Za-z0-9._-]", MaxLength: 16, Mode: FileNameSanitize})
+	unrestricted := fileNameTestService(t, FileNameConfig{MaxLength: 12, Mode: FileNameSanitize})
+
+	for _, tc := range []struct {
+		name     string
+		s        *Service
+		fileName string
+		want     string
+	}{
+		{"clean", ascii, "photo.png", "photo.png"},
+		{"spaces and emoji", ascii, "my 😀 pic.png", "my___pic.png"},
+		{"control characters", unrestricted, "a\x00\tb.png", "a__b.png"},
+		{"overlong keeps the extension", ascii, "a-very-long-photo-name.jpeg", "a-very-long.jpeg"},
+		{"no character split", unrestricted, "ééééééé.png", "éééé.png"},
+	} {
+		got, err := tc.s.checkFileName(ctx, tc.fileName)
+		if err != nil || got != tc.want {
+			t.Errorf("%s: checkFileName(%q) = %q, %v, want %q", tc.name, tc.fileName, got, err, tc.want)
+		}
+		if !utf8.ValidString(got) {
+			t.Errorf("%s: sanitized name %q is not valid UTF-8", tc.name, got)
+		}
+	}
+
+	// An extension filling the whole length leaves nothing to shorten
+	if _, err := ascii.checkFileName(ctx, "a.abcdefghijklmnopq"); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("overlong extension: error = %v, want INVALID_ARGUMENT", err)
+	}
+}
+
+func TestPresignUploadReturnsSanitizedFileName(t *testing.T) {
+	s := fileNameTestService(t, FileNameConfig{AllowedCharacters: "[A-Za-z0-9._-]", Mode: FileNameSanitize})
+
+	resp, err := s.PresignUpload(context.Background(), &mediabase_v1.PresignUploadRequest{ContentType: "image/png", Path: "users", FileName: "my photo.png"})
+	if err != nil {
+		t.Fatalf("PresignUpload: %v", err)
+	}
+	if resp.FileName != "my_photo.png" {
+		t.Errorf("file_name = %q, want the sanitized name", resp.FileName)
+	}
+	if resp.ObjectKey != "users/my_photo.png" {
+		t.Errorf("object key %q, want it to end in the sanitized name", resp.ObjectKey)
+	}
+
+	resp, err = s.PresignUpload(context.Background(), &mediabase_v1.PresignUploadRequest{ContentType: "image/png"})
+	if err != nil || resp.FileName != "" {
+		t.Errorf("generated name: file_name = %q, %v, want it empty", resp.GetFileName(), err)
+	}
+}
+
+func TestFileNameConfigValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		cfg  FileNameConfig
+		ok   bool
+	}{
+		{"defaults", FileNameConfig{}, true},
+		{"sanitize", FileNameConfig{AllowedCharacters: "[a-z_.]", Mode: FileNameSanitize}, true},
+		{"negative length", FileNameConfig{MaxLength: -1}, false},
+		{"bad pattern", FileNameConfig{AllowedCharacters: "[a-z"}, false},
+		{"replacement disallowed", FileNameConfig{AllowedCharacters: "[a-z.]", Mode: FileNameSanitize}, false},
+		{"unknown mode", FileNameConfig{Mode: "truncate"}, false},
+	} {
+		if err := tc.cfg.validate(); (err == nil) != tc.ok {
+			t.Errorf("%s: validate() = %v, want ok %v", tc.name, err, tc.ok)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PresignHead generates a presigned URL for a HEAD request, so clients can read the size and
+// content type of an object from storage without downloading it or asking the server
+func (s *Service) PresignHead(ctx context.Context, req *mediabase_v1.PresignHeadRequest) (*mediabase_v1.PresignHeadResponse, error) {
+	ctx = withLogFields(ctx, req.BucketName, req.ObjectKey)
+	logDebug(ctx, "PresignHead request received, bucket: %s, object_key: %s, version_id: %s", req.BucketName, req.ObjectKey, req.VersionId)
+
+	if err := s.resolveBucket(&req.BucketName); err != nil {
+		return nil, err
+	}
+
+	expiry, err := s.presignExpiry(req.ExpiresIn, defaultDownloadExpiry)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.VersionId != "" {
+		if err := s.requireVersion(ctx, req.BucketName, req.ObjectKey, req.VersionId); err != nil {
+			return nil, err
+		}
+	} else if _, err := s.StatObject(ctx, req.BucketName, req.ObjectKey); err != nil {
+		return nil, err
+	}
+
+	presignedURL, err := s.storage.GeneratePresignedHeadURL(ctx, req.BucketName, req.ObjectKey, expiry, req.VersionId)
+	if err != nil {
+		logger.Error(ctx, "Failed to generate presigned head URL: %v", err)
+		return nil, storageError("failed to generate presigned head URL", err)
+	}
+	if s.cdnBaseURL != "" {
+		presignedURL, err = rebaseURL(presignedURL, s.cdnBaseURL)
+		if err != nil {
+			logger.Error(ctx, "Failed to rebase presigned head URL: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to generate presigned head URL: %v", err)
+		}
+	} else {
+		presignedURL, err = s.publicPresignedURL(presignedURL, req.BucketName)
+		if err != nil {
+			logger.Error(ctx, "Failed to move presigned head URL to the public endpoint: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to generate presigned head URL: %v", err)
+		}
+	}
+
+	logDebug(ctx, "Presigned head URL generated successfully for object: %s", req.ObjectKey)
+
+	return &mediabase_v1.PresignHeadResponse{
+		PresignedUrl: presignedURL,
+		ExpiresIn:    int32(expiry.Seconds()),
+	}, nil
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/gofreego/goutils/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// defaultIdempotencyWindow is used when IdempotencyWindow is not set
+	defaultIdempotencyWindow = 24 * time.Hour
+	// idempotencyLease is how long a running request holds its key. A request that died
+	// without finishing stops blocking retries once it expires.
+	idempotencyLease = 5 * time.Minute
+	// idempotencyKeyPrefix namespaces idempotency records in the key-value store
+	idempotencyKeyPrefix = "idempotency:"
+	// maxIdempotencyKeyLength caps caller-supplied idempotency keys
+	maxIdempotencyKeyLength = 256
+)
+
+// idempotencyRecord is what the key-value store holds for an idempotency key
+type idempotencyRecord struct {
+	// Request is the SHA-256 of the request, so a key reused for another request is detected
+	Request string `json:"request"`
+	// Response is the protojson response of the first request; empty while it is running
+	Response json.RawMessage `json:"response,omitempty"`
+}
+
+// idempotent runs op at most once per idempotency key within the configured window. A retry with
+// the same key gets the recorded response of the first request, written into cached, instead of
+// running op again. A failed op is not recorded, so the retry runs it again, and neither is one
+// that outlives its lease. Without a key, op simply runs.
+func idempotent[Req, Resp proto.Message](ctx context.Context, s *Service, operation, key string, req Req, cached Resp, op func() (Resp, error)) (Resp, error) {
+	var zero Resp
+	if key == "" {
+		return op()
+	}
+	if len(key) > maxIdempotencyKeyLength {
+		return zero, status.Errorf(codes.InvalidArgument, "idempotency_key must be at most %d bytes", maxIdempotencyKeyLength)
+	}
+
+	fingerprint, err := requestFingerprint(req)
+	if err != nil {
+		return zero, status.Errorf(codes.Internal, "failed to fingerprint request: %v", err)
+	}
+	storeKey := idempotencyKeyPrefix + operation + ":" + key
+	pending, err := json.Marshal(idempotencyRecord{Request: fingerprint})
+	if err != nil {
+		return zero, status.Errorf(codes.Internal, "failed to encode idempotency record: %v", err)
+	}
+
+	// Only the response is kept for the whole window
+	claimed, err := s.kv.SetNX(ctx, storeKey, string(pending), min(idempotencyLease, s.idempotencyWindow))
+	if err != nil {
+		logger.Error(ctx, "Failed to record idempotency key: %v", err)
+		return zero, status.Errorf(codes.Unavailable, "failed to record idempotency key: %v", err)
+	}
+	if !claimed {
+		return replay(ctx, s, storeKey, fingerprint, cached)
+	}
+
+	resp, err := op()
+	if err != nil {
+		// Release the key so the retry can try again
+		if _, delErr := s.kv.Delete(ctx, storeKey); delErr != nil {
+			logger.Error(ctx, "Failed to release idempotency key %s: %v", key, delErr)
+		}
+		return zero, err
+	}
+
+	body, err := protojson.Marshal(resp)
+	if err == nil {
+		var record []byte
+		record, err = json.Marshal(idempotencyRecord{Request: fingerprint, Response: body})
+		if err == nil {
+			err = s.kv.Set(ctx, storeKey, string(record), s.idempotencyWindow)
+		}
+	}
+	if err != nil {
+		// The operation succeeded, so its response is returned regardless
+		logger.Error(ctx, "Failed to record response for idempotency key %s: %v", key, err)
+	}
+	return resp, nil
+}
+
+// replay returns the recorded response of an idempotency key claimed by an earlier request
+func replay[Resp proto.Message](ctx context.Context, s *Service, storeKey, fingerprint string, cached Resp) (Resp, error) {
+	var zero Resp
+	value, ok, err := s.kv.Get(ctx, storeKey)
+	if err != nil {
+		logger.Error(ctx, "Failed to read idempotency key: %v", err)
+		return zero, status.Errorf(codes.Unavailable, "failed to read idempotency key: %v", err)
+	}
+	if !ok {
+		// The first request failed or the record expired in between
+		return zero, status.Errorf(codes.Aborted, "a request with this idempotency key has just finished, retry it")
+	}
+
+	var record idempotencyRecord
+	if err := json.Unmarshal([]byte(value), &record); err != nil {
+		return zero, status.Errorf(codes.Internal, "invalid idempotency record: %v", err)
+	}
+	if record.Request != fingerprint {
+		return zero, status.Errorf(codes.InvalidArgument, "idempotency_key was already used for a different request")
+	}
+	if len(record.Response) == 0 {
+		return zero, status.Errorf(codes.Aborted, "a request with this idempotency key is still in progress")
+	}
+	if err := protojson.Unmarshal(record.Response, cached); err != nil {
+		return zero, status.Errorf(codes.Internal, "invalid idempotency record: %v", err)
+	}
+
+	logDebug(ctx, "Replaying recorded response for idempotency key")
+	return cached, nil
+}
+
+// requestFingerprint hashes the deterministic encoding of a request
+func requestFingerprint(req proto.Message) (string, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"github.com/gofreego/mediabase/internal/kvstore/memory"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ttlRecordingStore records the TTL of every write
+type ttlRecordingStore struct {
+	*memory.Store
+
+	mu   sync.Mutex
+	ttls []time.Duration
+}
+
+func (r *ttlRecordingStore) Set(ctx context.Context, key, value string, ttl time.Duration) error {
+	r.record(ttl)
+	return r.Store.Set(ctx, key, value, ttl)
+}
+
+func (r *ttlRecordingStore) SetNX(ctx context.Context, key, value string, ttl time.Duration) (bool, error) {
+	r.record(ttl)
+	return r.Store.SetNX(ctx, key, value, ttl)
+}
+
+func (r *ttlRecordingStore) record(ttl time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ttls = append(r.ttls, ttl)
+}
+
+func TestIdempotentDeleteReplaysResponse(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	fake.put("media", "a.png", []byte("png"), "image/png", nil)
+	kv := &ttlRecordingStore{Store: memory.New()}
+	s := newTestService(t, testConfig(), fake, WithKVStore(kv))
+	req := func() *mediabase_v1.DeleteObjectRequest {
+		return &mediabase_v1.DeleteObjectRequest{ObjectKey: "a.png", IdempotencyKey: "delete-1"}
+	}
+
+	for i := range 3 {
+		resp, err := s.DeleteObject(ctx, req())
+		if err != nil || !resp.Success {
+			t.Fatalf("attempt %d: %v, %v", i, resp, err)
+		}
+	}
+	if got := fake.callCount("DeleteObject"); got != 1 {
+		t.Errorf("storage deleted %d times, want once", got)
+	}
+
+	// The running request holds a short lease; only the response is kept for the window
+	if len(kv.ttls) < 2 || kv.ttls[0] != idempotencyLease || kv.ttls[1] != defaultIdempotencyWindow {
+		t.Errorf("record TTLs = %v, want the lease %s then the window %s", kv.ttls, idempotencyLease, defaultIdempotencyWindow)
+	}
+}
+
+func TestIdempotencyKeyReusedForOtherRequest(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	s := newTestService(t, testConfig(), fake)
+
+	if _, err := s.DeleteObject(ctx, &mediabase_v1.DeleteObjectRequest{ObjectKey: "a.png", IdempotencyKey: "k"}); err != nil {
+		t.Fatal(err)
+	}
+	_, err := s.DeleteObject(ctx, &mediabase_v1.DeleteObjectRequest{ObjectKey: "b.png", IdempotencyKey: "k"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("key reused for another object: error = %v, want INVALID_ARGUMENT", err)
+	}
+
+	// Keys are scoped to the operation
+	_, err = s.CopyObject(ctx, &mediabase_v1.CopyObjectRequest{SourceKey: "c.png", DestinationKey: "d.png", IdempotencyKey: "k"})
+	if status.Code(err) == codes.InvalidArgument {
+		t.Errorf("a delete key blocked a copy: %v", err)
+	}
+}
+
+func TestIdempotencyFailedRequestIsRetried(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	fake.failWith("DeleteObject", errors.New("connection reset"))
+	s := newTestService(t, testConfig(), fake)
+	req := func() *mediabase_v1.DeleteObjectRequest {
+		return &mediabase_v1.DeleteObjectRequest{ObjectKey: "a.png", IdempotencyKey: "k"}
+	}
+
+	if _, err := s.DeleteObject(ctx, req()); err == nil {
+		t.Fatal("delete succeeded despite the storage error")
+	}
+	fake.failWith("DeleteObject", nil)
+	if _, err := s.DeleteObject(ctx, req()); err != nil {
+		t.Fatalf("retry after a failure: %v", err)
+	}
+	if got := fake.callCount("DeleteObject"); got != 2 {
+		t.Errorf("storage deleted %d times, want the retry to run again", got)
+	}
+}
+
+func TestIdempotencyRunningRequestBlocksRetries(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	s := newTestService(t, testConfig(), fake)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		_, err := idempotent(ctx, s, "Test", "k", &mediabase_v1.DeleteObjectRequest{ObjectKey: "a"}, &mediabase_v1.DeleteObjectResponse{}, func() (*mediabase_v1.DeleteObjectResponse, error) {
+			close(started)
+			<-release
+			return &mediabase_v1.DeleteObjectResponse{Success: true}, nil
+		})
+		done <- err
+	}()
+	<-started
+
+	ran := false
+	_, err := idempotent(ctx, s, "Test", "k", &mediabase_v1.DeleteObjectRequest{ObjectKey: "a"}, &mediabase_v1.DeleteObjectResponse{}, func() (*mediabase_v1.DeleteObjectResponse, error) {
+		ran = true
+		return &mediabase_v1.DeleteObjectResponse{Success: true}, nil
+	})
+	if status.Code(err) != codes.Aborted || ran {
+		t.Errorf("retry during the first request: ran = %v, error = %v, want ABORTED", ran, err)
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	resp, err := idempotent(ctx, s, "Test", "k", &mediabase_v1.DeleteObjectRequest{ObjectKey: "a"}, &mediabase_v1.DeleteObjectResponse{}, func() (*mediabase_v1.DeleteObjectResponse, error) {
+		ran = true
+		return nil, errors.New("must not run")
+	})
+	if err != nil || !resp.Success || ran {
+		t.Errorf("retry after the first request: resp = %v, error = %v, ran = %v", resp, err, ran)
+	}
+}
+
+func TestIdempotencyKeyTooLong(t *testing.T) {
+	s := newTestService(t, testConfig(), newFakeStorage("media"))
+	key := string(make([]byte, maxIdempotencyKeyLength+1))
+
+	_, err := s.DeleteObject(context.Background(), &mediabase_v1.DeleteObjectRequest{ObjectKey: "a.png", IdempotencyKey: key})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("error = %v, want INVALID_ARGUMENT", err)
+	}
+}
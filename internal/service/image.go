@@ -0,0 +1,203 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"github.com/gofreego/mediabase/internal/imaging"
+	"github.com/gofreego/mediabase/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ConvertImage transcodes a stored image into another format and stores it under a new key
+func (s *Service) ConvertImage(ctx context.Context, req *mediabase_v1.ConvertImageRequest) (*mediabase_v1.ConvertImageResponse, error) {
+	ctx = withLogFields(ctx, req.BucketName, req.ObjectKey)
+	logDebug(ctx, "ConvertImage request received, bucket: %s, object_key: %s, target_format: %s, quality: %d", req.BucketName, req.ObjectKey, req.TargetFormat, req.Quality)
+
+	if err := s.resolveBucket(&req.BucketName); err != nil {
+		return nil, err
+	}
+
+	// Validate target format against server configuration
+	target, err := imaging.ParseFormat(req.TargetFormat)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if !s.conversionTargetTypes[target.ContentType()] {
+		return nil, status.Errorf(codes.InvalidArgument, "conversion to %s is not allowed", target.ContentType())
+	}
+
+	// Validate quality parameter
+	if req.Quality < 0 || req.Quality > 100 {
+		return nil, status.Errorf(codes.InvalidArgument, "quality must be between 1 and 100, got %d", req.Quality)
+	}
+
+	// Resolve and validate destination key
+	destinationKey := req.DestinationKey
+	if destinationKey == "" {
+		destinationKey = strings.TrimSuffix(req.ObjectKey, path.Ext(req.ObjectKey)) + target.Extension()
+	}
+	if destinationKey == req.ObjectKey {
+		return nil, status.Errorf(codes.InvalidArgument, "destination key must differ from the source key: %s", req.ObjectKey)
+	}
+	if err := s.validateObjectKey(destinationKey); err != nil {
+		return nil, err
+	}
+
+	info, err := s.StatObject(ctx, req.BucketName, req.ObjectKey)
+	if err != nil {
+		return nil, err
+	}
+	img, source, err := s.loadImage(ctx, req.BucketName, req.ObjectKey)
+	if err != nil {
+		return nil, err
+	}
+	if !s.conversionSourceTypes[source.ContentType()] {
+		return nil, status.Errorf(codes.InvalidArgument, "conversion from %s is not allowed", source.ContentType())
+	}
+
+	var buf bytes.Buffer
+	if err := imaging.Encode(&buf, img, target, int(req.Quality)); err != nil {
+		logger.Error(ctx, "Failed to encode image as %s: %v", target, err)
+		return nil, fmt.Errorf("failed to encode image: %w", err)
+	}
+
+	size := int64(buf.Len())
+	if err := s.storeImage(ctx, req.BucketName, req.ObjectKey, destinationKey, buf.Bytes(), target.ContentType(), info); err != nil {
+		logger.Error(ctx, "Failed to store converted image: %v", err)
+		return nil, err
+	}
+
+	logDebug(ctx, "Image converted successfully: %s (%s) -> %s (%s), bytes: %d", req.ObjectKey, source, destinationKey, target, size)
+
+	return &mediabase_v1.ConvertImageResponse{
+		ObjectKey:   destinationKey,
+		ContentType: target.ContentType(),
+		Size:        size,
+	}, nil
+}
+
+// loadImage downloads and decodes an image, bounded by the server's max file size and pixel limits
+func (s *Service) loadImage(ctx context.Context, bucketName, objectKey string) (image.Image, imaging.Format, error) {
+	data, err := s.readObject(ctx, bucketName, objectKey)
+	if err != nil {
+		return nil, "", err
+	}
+
+	img, format, err := imaging.Decode(data, s.maxImagePixels)
+	if err != nil {
+		return nil, "", status.Errorf(codes.InvalidArgument, "invalid source image %s: %v", objectKey, err)
+	}
+	return img, format, nil
+}
+
+// readObject reads a whole object into memory, refusing objects larger than the server's max file size
+func (s *Service) readObject(ctx context.Context, bucketName, objectKey string) ([]byte, error) {
+	reader, err := s.storage.GetObject(ctx, bucketName, objectKey)
+	if err != nil {
+		logger.Error(ctx, "Failed to get object: %v", err)
+		return nil, storageError("failed to get object", err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(io.LimitReader(reader, s.maxFileSize+1))
+	if err != nil {
+		logger.Error(ctx, "Failed to read object: %v", err)
+		return nil, storageError("failed to read object", err)
+	}
+	if int64(len(data)) > s.maxFileSize {
+		return nil, status.Errorf(codes.InvalidArgument, "object %s exceeds server maximum allowed size %d", objectKey, s.maxFileSize)
+	}
+	return data, nil
+}
+
+// SanitizeImage strips EXIF/GPS and other metadata from a stored JPEG or TIFF image in place
+func (s *Service) SanitizeImage(ctx context.Context, req *mediabase_v1.SanitizeImageRequest) (*mediabase_v1.SanitizeImageResponse, error) {
+	ctx = withLogFields(ctx, req.BucketName, req.ObjectKey)
+	logDebug(ctx, "SanitizeImage request received, bucket: %s, object_key: %s", req.BucketName, req.ObjectKey)
+
+	if err := s.resolveBucket(&req.BucketName); err != nil {
+		return nil, err
+	}
+
+	info, err := s.StatObject(ctx, req.BucketName, req.ObjectKey)
+	if err != nil {
+		return nil, err
+	}
+	data, err := s.readObject(ctx, req.BucketName, req.ObjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// The original is only overwritten once the stripped image has been verified
+	sanitized, format, err := imaging.StripMetadata(data, s.maxImagePixels)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to sanitize image %s: %v", req.ObjectKey, err)
+	}
+
+	size := int64(len(sanitized))
+	if err := s.storeImage(ctx, req.BucketName, req.ObjectKey, req.ObjectKey, sanitized, format.ContentType(), info); err != nil {
+		logger.Error(ctx, "Failed to store sanitized image: %v", err)
+		return nil, err
+	}
+
+	logDebug(ctx, "Image sanitized successfully: %s, bytes: %d -> %d", req.ObjectKey, len(data), size)
+
+	return &mediabase_v1.SanitizeImageResponse{
+		ContentType:  format.ContentType(),
+		OriginalSize: int64(len(data)),
+		Size:         size,
+	}, nil
+}
+
+// storeImage writes an image derived from the source object, keeping the source's metadata,
+// tags, cache control and encryption key. Like direct uploads, it waits for an upload slot and
+// counts towards the bucket quota; only growth is checked, so sanitizing in a full bucket works.
+func (s *Service) storeImage(ctx context.Context, bucketName, sourceKey, objectKey string, data []byte, contentType string, source *storage.ObjectInfo) error {
+	opts := storage.UploadOptions{
+		CacheControl: source.UserMetadata[storage.CacheControlMetadataKey],
+		Metadata:     make(map[string]string, len(source.UserMetadata)),
+		KMSKeyID:     source.KMSKeyID,
+	}
+	for k, v := range source.UserMetadata {
+		// The recorded checksum was for the source content
+		if k != storage.ChecksumSHA256MetadataKey {
+			opts.Metadata[k] = v
+		}
+	}
+	if s.storage.Capabilities().ObjectTagging {
+		tags, err := s.storage.GetObjectTags(ctx, bucketName, sourceKey)
+		if err != nil {
+			return storageError("failed to get object tags", err)
+		}
+		opts.Tags = s.markConfirmed(bucketName, objectKey, tags)
+	}
+
+	size := int64(len(data))
+	replaced := s.replacedSize(ctx, bucketName, objectKey)
+	if err := s.quotas.check(ctx, bucketName, size-replaced); err != nil {
+		return err
+	}
+
+	release, err := s.puts.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = s.storage.PutObject(ctx, bucketName, objectKey, bytes.NewReader(data), size, contentType, opts)
+	release()
+	if err != nil {
+		return storageError("failed to store image", err)
+	}
+
+	s.quotas.record(bucketName, objectKey, size, replaced, time.Now())
+	return nil
+}
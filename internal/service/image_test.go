@@ -0,0 +1,163 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/jpeg"
+	"maps"
+	"testing"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"github.com/gofreego/mediabase/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// jpegWithComment encodes a small JPEG carrying a comment segment after SOI
+func jpegWithComment(t *testing.T, comment string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, image.NewGray(image.Rect(0, 0, 4, 4)), nil); err != nil {
+		t.Fatal(err)
+	}
+	plain := buf.Bytes()
+	segment := append([]byte{0xFF, 0xFE, 0, byte(len(comment) + 2)}, comment...)
+	return append(append(append([]byte{}, plain[:2]...), segment...), plain[2:]...)
+}
+
+func TestSanitizeImageOverwritesWithStrippedImage(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	original := jpegWithComment(t, "GPS 52.5N 13.4E")
+	fake.put("media", "photo.jpg", original, "image/jpeg", nil)
+	s := newTestService(t, testConfig(), fake)
+
+	resp, err := s.SanitizeImage(ctx, &mediabase_v1.SanitizeImageRequest{BucketName: "media", ObjectKey: "photo.jpg"})
+	if err != nil {
+		t.Fatalf("SanitizeImage: %v", err)
+	}
+	stored := fake.buckets["media"]["photo.jpg"].data
+	if bytes.Contains(stored, []byte("GPS")) {
+		t.Error("stored image still carries the metadata")
+	}
+	if resp.OriginalSize != int64(len(original)) || resp.Size != int64(len(stored)) || resp.ContentType != "image/jpeg" {
+		t.Errorf("response = %v, want sizes %d -> %d", resp, len(original), len(stored))
+	}
+}
+
+func TestSanitizeImageLeavesUnsupportedObjects(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	fake.put("media", "note.txt", []byte("not an image"), "text/plain", nil)
+	s := newTestService(t, testConfig(), fake)
+
+	_, err := s.SanitizeImage(ctx, &mediabase_v1.SanitizeImageRequest{BucketName: "media", ObjectKey: "note.txt"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("error = %v, want INVALID_ARGUMENT", err)
+	}
+	if got := fake.callCount("PutObject"); got != 0 {
+		t.Errorf("object overwritten %d times", got)
+	}
+}
+
+func TestImageRewritesKeepObjectAttributes(t *testing.T) {
+	tests := map[string]struct {
+		rewrite func(s *Service) error
+		key     string
+	}{
+		"sanitize": {
+			rewrite: func(s *Service) error {
+				_, err := s.SanitizeImage(context.Background(), &mediabase_v1.SanitizeImageRequest{ObjectKey: "photo.jpg"})
+				return err
+			},
+			key: "photo.jpg",
+		},
+		"convert": {
+			rewrite: func(s *Service) error {
+				_, err := s.ConvertImage(context.Background(), &mediabase_v1.ConvertImageRequest{ObjectKey: "photo.jpg", TargetFormat: "png"})
+				return err
+			},
+			key: "photo.png",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			fake := newFakeStorage("media")
+			fake.put("media", "photo.jpg", jpegWithComment(t, "GPS"), "image/jpeg", map[string]string{
+				"owner":                           "alice",
+				storage.CacheControlMetadataKey:   "max-age=60",
+				storage.ChecksumSHA256MetadataKey: "0123",
+			})
+			source := fake.buckets["media"]["photo.jpg"]
+			source.tags = map[string]string{"team": "web", confirmedTagKey: "true"}
+			source.kmsKeyID = "key-1"
+			cfg := testConfig()
+			cfg.Image.ConversionSourceTypes = []string{"image/jpeg"}
+			cfg.Image.ConversionTargetTypes = []string{"image/png"}
+			s := newTestService(t, cfg, fake)
+
+			if err := tt.rewrite(s); err != nil {
+				t.Fatalf("rewrite: %v", err)
+			}
+			stored := fake.buckets["media"][tt.key]
+			wantMetadata := map[string]string{"owner": "alice", storage.CacheControlMetadataKey: "max-age=60"}
+			if !maps.Equal(stored.metadata, wantMetadata) {
+				t.Errorf("metadata = %v, want %v", stored.metadata, wantMetadata)
+			}
+			if !maps.Equal(stored.tags, source.tags) {
+				t.Errorf("tags = %v, want %v", stored.tags, source.tags)
+			}
+			if stored.kmsKeyID != "key-1" {
+				t.Errorf("KMS key = %q, want key-1", stored.kmsKeyID)
+			}
+		})
+	}
+}
+
+func TestImageRewritesCountTowardsQuota(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	original := jpegWithComment(t, "GPS")
+	fake.put("media", "photo.jpg", original, "image/jpeg", nil)
+	cfg := testConfig()
+	cfg.Image.ConversionSourceTypes = []string{"image/jpeg"}
+	cfg.Image.ConversionTargetTypes = []string{"image/png"}
+	cfg.Quota = QuotaConfig{Buckets: map[string]int64{"media": int64(len(original))}}
+	s := newTestService(t, cfg, fake)
+
+	// Sanitizing shrinks the image, so it fits even though the bucket is full
+	resp, err := s.SanitizeImage(ctx, &mediabase_v1.SanitizeImageRequest{ObjectKey: "photo.jpg"})
+	if err != nil {
+		t.Fatalf("SanitizeImage: %v", err)
+	}
+	if got := usage(t, s.quotas, "media"); got != resp.Size {
+		t.Errorf("usage after sanitizing = %d, want %d", got, resp.Size)
+	}
+
+	_, err = s.ConvertImage(ctx, &mediabase_v1.ConvertImageRequest{ObjectKey: "photo.jpg", TargetFormat: "png"})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("ConvertImage error = %v, want RESOURCE_EXHAUSTED", err)
+	}
+}
+
+func TestImageRewritesWaitForUploadSlot(t *testing.T) {
+	st := newGatedStorage()
+	st.put("media", "photo.jpg", jpegWithComment(t, "GPS"), "image/jpeg", nil)
+	s := putLimitTestService(t, st, PutConcurrencyConfig{MaxInFlight: 1})
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := s.SanitizeImage(context.Background(), &mediabase_v1.SanitizeImageRequest{ObjectKey: "photo.jpg"})
+		done <- err
+	}()
+	<-st.started
+
+	if err := putPNG(s, context.Background(), "other.png"); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("upload while sanitizing: error = %v, want RESOURCE_EXHAUSTED", err)
+	}
+	close(st.gate)
+	if err := <-done; err != nil {
+		t.Errorf("SanitizeImage: %v", err)
+	}
+}
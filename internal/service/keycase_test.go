@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"github.com/gofreego/mediabase/internal/storage/keycase"
+)
+
+func TestKeyCaseNormalizesGeneratedKeys(t *testing.T) {
+	for _, tc := range []struct {
+		mode, want string
+	}{
+		{"", "Avatars/Photo.JPG"},
+		{keycase.Lower, "avatars/photo.jpg"},
+		{keycase.Extension, "Avatars/Photo.jpg"},
+	} {
+		fake := newFakeStorage("media")
+		cfg := testConfig()
+		cfg.KeyCase = tc.mode
+		s := newTestService(t, cfg, fake)
+
+		put, err := s.PutObject(context.Background(), &mediabase_v1.PutObjectRequest{ContentType: "image/jpeg", Path: "Avatars", FileName: "Photo.JPG", Content: []byte("jpg")})
+		if err != nil {
+			t.Fatalf("KeyCase %q: PutObject: %v", tc.mode, err)
+		}
+		if put.ObjectKey != tc.want {
+			t.Errorf("KeyCase %q: returned key %q, want %q", tc.mode, put.ObjectKey, tc.want)
+		}
+		if _, err := fake.object("media", tc.want); err != nil {
+			t.Errorf("KeyCase %q: object not stored as %q: %v", tc.mode, tc.want, err)
+		}
+	}
+}
+
+func TestKeyCaseFindsObjectsByMixedCaseKeys(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	fake.put("media", "avatars/photo.jpg", []byte("jpg"), "image/jpeg", nil)
+	cfg := testConfig()
+	cfg.KeyCase = keycase.Lower
+	s := newTestService(t, cfg, fake)
+
+	for _, key := range []string{"avatars/photo.jpg", "Avatars/Photo.JPG", "AVATARS/PHOTO.jpg"} {
+		if _, err := s.GetObjectMetadata(ctx, &mediabase_v1.GetObjectMetadataRequest{ObjectKey: key}); err != nil {
+			t.Errorf("GetObjectMetadata(%q): %v", key, err)
+		}
+	}
+	if _, err := s.DeleteObject(ctx, &mediabase_v1.DeleteObjectRequest{ObjectKey: "Avatars/Photo.JPG"}); err != nil {
+		t.Fatalf("DeleteObject: %v", err)
+	}
+	if _, err := fake.object("media", "avatars/photo.jpg"); err == nil {
+		t.Error("object kept after a delete by its mixed-case key")
+	}
+}
+
+func TestKeyCaseValidation(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		modify func(*Config)
+		ok     bool
+	}{
+		{"off", func(*Config) {}, true},
+		{"lower", func(c *Config) { c.KeyCase = keycase.Lower }, true},
+		{"extension", func(c *Config) { c.KeyCase = keycase.Extension }, true},
+		{"unknown", func(c *Config) { c.KeyCase = "upper" }, false},
+		{"upper-case prefix while lower", func(c *Config) {
+			c.KeyCase, c.AutoDeleteOnDownloadPrefixes = keycase.Lower, []string{"Once/"}
+		}, false},
+		{"upper-case prefix with extension", func(c *Config) {
+			c.KeyCase, c.AutoDeleteOnDownloadPrefixes = keycase.Extension, []string{"Once/"}
+		}, true},
+	} {
+		cfg := testConfig()
+		tc.modify(&cfg)
+		if err := cfg.Validate(); (err == nil) != tc.ok {
+			t.Errorf("%s: Validate() = %v, want ok %v", tc.name, err, tc.ok)
+		}
+	}
+}
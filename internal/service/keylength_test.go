@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func keyLengthTestService(t *testing.T, maxLength int, keyPrefix string) *Service {
+	cfg := testConfig()
+	cfg.MaxObjectKeyLength = maxLength
+	cfg.KeyPrefix = keyPrefix
+	return newTestService(t, cfg, newFakeStorage("media"))
+}
+
+// multibyteName returns a file name of n two-byte characters and the .png extension
+func multibyteName(n int) string {
+	return strings.Repeat("é", n) + ".png"
+}
+
+func TestObjectKeyLengthCountsBytes(t *testing.T) {
+	ctx := context.Background()
+	s := keyLengthTestService(t, 24, "")
+
+	// 10 two-byte characters and the extension fill the 24 bytes exactly
+	resp, err := s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{BucketName: "media", ContentType: "image/png", FileName: multibyteName(10)})
+	if err != nil {
+		t.Fatalf("key at the limit: %v", err)
+	}
+	if len(resp.ObjectKey) != 24 {
+		t.Errorf("key %q is %d bytes, want 24", resp.ObjectKey, len(resp.ObjectKey))
+	}
+
+	// One more character is 15 characters but 26 bytes
+	_, err = s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{BucketName: "media", ContentType: "image/png", FileName: multibyteName(11)})
+	if status.Code(err) != codes.InvalidArgument || !strings.Contains(err.Error(), "26 bytes") {
+		t.Errorf("key over the limit: error = %v, want INVALID_ARGUMENT with its length", err)
+	}
+
+	_, err = s.PutObject(ctx, &mediabase_v1.PutObjectRequest{BucketName: "media", ContentType: "image/png", FileName: multibyteName(11), Content: []byte("png")})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("PutObject over the limit: error = %v, want INVALID_ARGUMENT", err)
+	}
+}
+
+func TestObjectKeyLengthIncludesPathAndPrefix(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := keyLengthTestService(t, 24, "t/").PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{BucketName: "media", ContentType: "image/png", FileName: multibyteName(10)})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("key prefix pushing the key over: error = %v, want INVALID_ARGUMENT", err)
+	}
+
+	// A generated name is a 36 character UUID, so a long path is what breaks the limit
+	s := keyLengthTestService(t, 64, "")
+	if _, err := s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{BucketName: "media", ContentType: "image/png", Path: "short"}); err != nil {
+		t.Errorf("generated key with a short path: %v", err)
+	}
+	_, err = s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{BucketName: "media", ContentType: "image/png", Path: strings.Repeat("ü", 12)})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("generated key with a long path: error = %v, want INVALID_ARGUMENT", err)
+	}
+}
+
+func TestValidateMaxObjectKeyLength(t *testing.T) {
+	for length, ok := range map[int]bool{0: true, 1024: true, -1: false, 1025: false} {
+		cfg := testConfig()
+		cfg.MaxObjectKeyLength = length
+		if err := cfg.Validate(); (err == nil) != ok {
+			t.Errorf("MaxObjectKeyLength %d: Validate() = %v, want ok %v", length, err, ok)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestKeyPrefixIsAppliedToStoredObjects(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	cfg := testConfig()
+	cfg.KeyPrefix = "staging/"
+	s := newTestService(t, cfg, fake)
+
+	put, err := s.PutObject(ctx, &mediabase_v1.PutObjectRequest{ContentType: "image/png", Path: "avatars", FileName: "a.png", Content: []byte("png")})
+	if err != nil {
+		t.Fatalf("PutObject: %v", err)
+	}
+	if put.ObjectKey != "avatars/a.png" {
+		t.Errorf("returned key %q, want it without the deployment prefix", put.ObjectKey)
+	}
+	if _, err := fake.object("media", "staging/avatars/a.png"); err != nil {
+		t.Errorf("object not stored under the prefix: %v", err)
+	}
+
+	presigned, err := s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{ContentType: "image/png", FileName: "b.png"})
+	if err != nil {
+		t.Fatalf("PresignUpload: %v", err)
+	}
+	if presigned.ObjectKey != "b.png" || presigned.FormData["key"] != "staging/b.png" {
+		t.Errorf("presigned key %q signed as %q, want b.png signed as staging/b.png", presigned.ObjectKey, presigned.FormData["key"])
+	}
+
+	// Clients address the object by the key they were given
+	meta, err := s.GetObjectMetadata(ctx, &mediabase_v1.GetObjectMetadataRequest{ObjectKey: put.ObjectKey})
+	if err != nil {
+		t.Fatalf("GetObjectMetadata: %v", err)
+	}
+	if meta.ObjectKey != "avatars/a.png" {
+		t.Errorf("metadata key %q, want it without the prefix", meta.ObjectKey)
+	}
+}
+
+func TestKeyPrefixIsolatesDeployments(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	fake.put("media", "prod/a.png", []byte("prod"), "image/png", nil)
+	cfg := testConfig()
+	cfg.KeyPrefix = "staging/"
+	s := newTestService(t, cfg, fake)
+
+	for _, key := range []string{"a.png", "prod/a.png", "../prod/a.png"} {
+		_, err := s.GetObjectMetadata(ctx, &mediabase_v1.GetObjectMetadataRequest{ObjectKey: key})
+		if status.Code(err) != codes.NotFound && status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: error = %v, want another deployment's object to stay out of reach", key, err)
+		}
+	}
+
+	if _, err := s.DeleteObject(ctx, &mediabase_v1.DeleteObjectRequest{ObjectKey: "a.png"}); err != nil {
+		t.Fatalf("DeleteObject: %v", err)
+	}
+	if _, err := s.DeleteObject(ctx, &mediabase_v1.DeleteObjectRequest{ObjectKey: "prod/a.png"}); err != nil {
+		t.Fatalf("DeleteObject: %v", err)
+	}
+	if _, err := fake.object("media", "prod/a.png"); err != nil {
+		t.Error("a delete removed an object of another deployment")
+	}
+	if _, err := s.DeleteObject(ctx, &mediabase_v1.DeleteObjectRequest{ObjectKey: "a.png", FailIfMissing: true}); status.Code(err) != codes.NotFound {
+		t.Errorf("delete outside the prefix with fail_if_missing: error = %v, want NOT_FOUND", err)
+	}
+}
+
+func TestKeyPrefixValidation(t *testing.T) {
+	for _, tc := range []struct {
+		prefix string
+		ok     bool
+	}{
+		{"", true},
+		{"staging/", true},
+		{"/staging/", false},
+		{"\xff/", false},
+		{strings.Repeat("p", 1024), false},
+	} {
+		cfg := testConfig()
+		cfg.KeyPrefix = tc.prefix
+		if err := cfg.Validate(); (err == nil) != tc.ok {
+			t.Errorf("KeyPrefix %.20q: Validate() = %v, want ok %v", tc.prefix, err, tc.ok)
+		}
+	}
+}
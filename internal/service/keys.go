@@ -0,0 +1,253 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Object key naming strategies selectable through Config.KeyStrategy
+const (
+	// KeyStrategyUUID names objects <uuid>.<ext>
+	KeyStrategyUUID = "uuid"
+	// KeyStrategyDate names objects <yyyy>/<mm>/<dd>/<uuid>.<ext> so lifecycle rules can target days
+	KeyStrategyDate = "date"
+	// KeyStrategyContentHash names objects <sha256>.<ext> so identical content shares a key
+	KeyStrategyContentHash = "content-hash"
+)
+
+// KeyInput holds what a KeyGenerator may use to name an object
+type KeyInput struct {
+	// Path is the folder the object is placed under
+	Path string
+	// FileName is a caller-supplied exact name; when set it is used instead of a generated one
+	FileName string
+	// ContentType selects the file extension
+	ContentType string
+	// ContentSHA256 is the hex-encoded SHA-256 of the content, when known
+	ContentSHA256 string
+	// IdempotencyKey is a caller-supplied key; retries with the same one must get the same name
+	IdempotencyKey string
+	// Tenant is the common name of the caller's client certificate, empty without mutual TLS
+	Tenant string
+}
+
+// KeyGenerator names new objects
+type KeyGenerator interface {
+	// GenerateKey returns the object key for an upload
+	GenerateKey(in KeyInput) (string, error)
+}
+
+// defaultKeyNamespace is the UUID namespace of keys derived from idempotency keys when
+// Config.KeyNamespace is not set
+var defaultKeyNamespace = uuid.MustParse("6f1c3a52-8d7e-4b3a-9a51-2f0e6c4d8b17")
+
+// newKeyGenerator returns the built-in generator for a strategy name; empty selects UUID.
+// UUIDs for idempotency keys are derived in the given namespace.
+func newKeyGenerator(strategy string, namespace uuid.UUID) (KeyGenerator, error) {
+	switch strategy {
+	case "", KeyStrategyUUID:
+		return uuidKeyGenerator{namespace: namespace}, nil
+	case KeyStrategyDate:
+		return dateKeyGenerator{now: time.Now, namespace: namespace}, nil
+	case KeyStrategyContentHash:
+		return contentHashKeyGenerator{}, nil
+	}
+	return nil, fmt.Errorf("unknown key strategy: %s", strategy)
+}
+
+// keyTemplateData holds the variables a key template can use
+type keyTemplateData struct {
+	// Tenant is the common name of the caller's client certificate, empty without mutual TLS
+	Tenant string
+	// Year, Month and Day are the current UTC date, zero-padded
+	Year, Month, Day string
+	// UUID is random, or derived from the idempotency key
+	UUID string
+	// FileName is the caller-supplied file name without its extension, empty if none was given
+	FileName string
+	// Ext is the extension of the file name, or else the one of the content type, with its leading dot
+	Ext string
+	// Path is the folder the caller asked for, empty if none was given
+	Path string
+}
+
+// Sample values a key template is rendered with at startup, recognizable in the rendered key
+const (
+	sampleTemplateTenant = "mediabase-sample-tenant"
+	sampleTemplatePath   = "mediabase-sample-path"
+)
+
+// newTemplateKeyGenerator parses a text/template key layout and checks it by rendering sample
+// values. A template placing keys under the tenant must also place the path, since a path put in
+// front of the tenant could name another tenant's folder.
+func newTemplateKeyGenerator(text string, namespace uuid.UUID) (KeyGenerator, error) {
+	tmpl, err := template.New("key").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key template: %w", err)
+	}
+	g := templateKeyGenerator{tmpl: tmpl, now: time.Now, namespace: namespace}
+	sample, err := g.render(KeyInput{FileName: "sample.jpg", Tenant: sampleTemplateTenant, Path: sampleTemplatePath})
+	if err != nil {
+		return nil, err
+	}
+	g.placesPath = strings.Contains(sample, sampleTemplatePath)
+	if strings.Contains(sample, sampleTemplateTenant) && !g.placesPath {
+		return nil, errors.New("a key template using .Tenant must also place .Path")
+	}
+	return g, nil
+}
+
+// templateKeyGenerator names objects by rendering a configured template. Unlike the other
+// generators it also names objects with a caller-supplied file name, which the template receives.
+type templateKeyGenerator struct {
+	tmpl      *template.Template
+	now       func() time.Time
+	namespace uuid.UUID
+	// placesPath is set when the template renders the path itself; otherwise it is prepended
+	placesPath bool
+}
+
+func (g templateKeyGenerator) GenerateKey(in KeyInput) (string, error) {
+	// The path is checked on its own, since the template may place it anywhere in the key
+	folder, err := cleanKeySegments(in.Path)
+	if err != nil {
+		return "", fmt.Errorf("path contains %w", err)
+	}
+	in.Path = folder
+	key, err := g.render(in)
+	if err != nil {
+		return "", err
+	}
+	if !g.placesPath && folder != "" {
+		key = folder + "/" + key
+	}
+	return key, nil
+}
+
+// render executes the template, rejecting rendered keys that could escape their prefix
+func (g templateKeyGenerator) render(in KeyInput) (string, error) {
+	now := g.now().UTC()
+	data := keyTemplateData{
+		Tenant: in.Tenant,
+		Year:   now.Format("2006"),
+		Month:  now.Format("01"),
+		Day:    now.Format("02"),
+		UUID:   keyUUID(g.namespace, in.IdempotencyKey),
+		Ext:    extensionFor(in.ContentType),
+		Path:   in.Path,
+	}
+	if in.FileName != "" {
+		ext := path.Ext(in.FileName)
+		data.FileName = strings.TrimSuffix(in.FileName, ext)
+		if ext != "" {
+			data.Ext = ext
+		}
+	}
+
+	var b strings.Builder
+	if err := g.tmpl.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("failed to render key template: %w", err)
+	}
+	key, err := cleanKeySegments(b.String())
+	if err != nil {
+		return "", fmt.Errorf("key template rendered %w", err)
+	}
+	if key == "" {
+		return "", errors.New("key template rendered an empty key")
+	}
+	return key, nil
+}
+
+// cleanKeySegments drops empty segments, such as those left by unset template variables, and
+// rejects keys that could escape their prefix
+func cleanKeySegments(key string) (string, error) {
+	if strings.Contains(key, "\\") {
+		return "", errors.New("a backslash")
+	}
+	segments := make([]string, 0, strings.Count(key, "/")+1)
+	for _, segment := range strings.Split(key, "/") {
+		switch segment {
+		case "":
+			continue
+		case ".", "..":
+			return "", fmt.Errorf("a %q path segment", segment)
+		}
+		segments = append(segments, segment)
+	}
+	return strings.Join(segments, "/"), nil
+}
+
+// uuidKeyGenerator names objects with a random UUID, or one derived from the idempotency key
+type uuidKeyGenerator struct {
+	namespace uuid.UUID
+}
+
+func (g uuidKeyGenerator) GenerateKey(in KeyInput) (string, error) {
+	if in.FileName != "" {
+		return joinKey(in.Path, in.FileName), nil
+	}
+	return joinKey(in.Path, keyUUID(g.namespace, in.IdempotencyKey)+extensionFor(in.ContentType)), nil
+}
+
+// dateKeyGenerator places objects with a random UUID, or one derived from the idempotency key,
+// under the current UTC date
+type dateKeyGenerator struct {
+	now       func() time.Time
+	namespace uuid.UUID
+}
+
+func (g dateKeyGenerator) GenerateKey(in KeyInput) (string, error) {
+	if in.FileName != "" {
+		return joinKey(in.Path, in.FileName), nil
+	}
+	date := g.now().UTC().Format("2006/01/02")
+	return joinKey(in.Path, filepath.Join(date, keyUUID(g.namespace, in.IdempotencyKey)+extensionFor(in.ContentType))), nil
+}
+
+// keyUUID returns a random UUID, or without randomness the UUIDv5 of an idempotency key
+func keyUUID(namespace uuid.UUID, idempotencyKey string) string {
+	if idempotencyKey != "" {
+		return uuid.NewSHA1(namespace, []byte(idempotencyKey)).String()
+	}
+	return uuid.New().String()
+}
+
+// contentHashKeyGenerator names objects after the SHA-256 of their content
+type contentHashKeyGenerator struct{}
+
+func (contentHashKeyGenerator) GenerateKey(in KeyInput) (string, error) {
+	if in.FileName != "" {
+		return joinKey(in.Path, in.FileName), nil
+	}
+	if in.ContentSHA256 == "" {
+		return "", fmt.Errorf("the %s key strategy requires the SHA-256 of the content", KeyStrategyContentHash)
+	}
+	return joinKey(in.Path, strings.ToLower(in.ContentSHA256)+extensionFor(in.ContentType)), nil
+}
+
+// joinKey places a name under an optional path
+func joinKey(path, name string) string {
+	if path != "" {
+		return filepath.Join(path, name)
+	}
+	return name
+}
+
+// extensionFor determines the file extension based on content type
+func extensionFor(contentType string) string {
+	// Uploads restricted by a content type prefix do not know their type yet
+	if contentType == "" {
+		return ""
+	}
+	if exts, ok := contentTypeExtensions[contentType]; ok {
+		return exts[0]
+	}
+	return ".bin"
+}
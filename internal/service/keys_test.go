@@ -0,0 +1,307 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fixedNow is the clock of generators in tests
+func fixedNow() time.Time {
+	return time.Date(2024, 3, 7, 12, 0, 0, 0, time.UTC)
+}
+
+func newTestTemplateGenerator(t *testing.T, text string) templateKeyGenerator {
+	t.Helper()
+	g, err := newTemplateKeyGenerator(text, defaultKeyNamespace)
+	if err != nil {
+		t.Fatalf("newTemplateKeyGenerator(%q): %v", text, err)
+	}
+	tg := g.(templateKeyGenerator)
+	tg.now = fixedNow
+	return tg
+}
+
+func TestTemplateKeyGenerator(t *testing.T) {
+	g := newTestTemplateGenerator(t, "{{.Tenant}}/{{.Path}}/{{.Year}}/{{.Month}}/{{.Day}}/{{if .FileName}}{{.FileName}}{{else}}{{.UUID}}{{end}}{{.Ext}}")
+
+	for _, tc := range []struct {
+		name string
+		in   KeyInput
+		want string
+	}{
+		{"file name", KeyInput{Tenant: "alice", Path: "avatars", FileName: "me.PNG", ContentType: "image/png"}, "alice/avatars/2024/03/07/me.PNG"},
+		{"unset variables", KeyInput{FileName: "me.png"}, "2024/03/07/me.png"},
+		{"nested path", KeyInput{Tenant: "alice", Path: "/a//b/", FileName: "x.png"}, "alice/a/b/2024/03/07/x.png"},
+	} {
+		got, err := g.GenerateKey(tc.in)
+		if err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("%s: key = %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestTemplateKeyGeneratorRejectsEscapingPaths(t *testing.T) {
+	placed := newTestTemplateGenerator(t, "{{.Tenant}}/{{.Path}}/{{.UUID}}{{.Ext}}")
+	prepended := newTestTemplateGenerator(t, "{{.Year}}/{{.UUID}}{{.Ext}}")
+
+	for _, path := range []string{"..", "../bob", "a/../../bob", "./x", `a\..\bob`} {
+		for name, g := range map[string]templateKeyGenerator{"placed": placed, "prepended": prepended} {
+			key, err := g.GenerateKey(KeyInput{Tenant: "alice", Path: path, ContentType: "image/png"})
+			if err == nil {
+				t.Errorf("%s: path %q gave key %q, want an error", name, path, key)
+			}
+		}
+	}
+
+	// A path cannot put the key under another tenant's folder
+	key, err := placed.GenerateKey(KeyInput{Tenant: "alice", Path: "bob", ContentType: "image/png"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(key, "alice/bob/") {
+		t.Errorf("key %q does not start with the tenant", key)
+	}
+}
+
+func TestTemplateKeyGeneratorRejectsEscapingFileNames(t *testing.T) {
+	g := newTestTemplateGenerator(t, "{{.Path}}/{{.FileName}}{{.Ext}}")
+
+	for _, fileName := range []string{"../x.png", `..\x.png`, "a/./x.png"} {
+		if key, err := g.GenerateKey(KeyInput{Path: "uploads", FileName: fileName}); err == nil {
+			t.Errorf("file name %q gave key %q, want an error", fileName, key)
+		}
+	}
+}
+
+func TestTemplateKeyGeneratorPrependsPath(t *testing.T) {
+	g := newTestTemplateGenerator(t, "{{.Year}}/{{.FileName}}{{.Ext}}")
+
+	key, err := g.GenerateKey(KeyInput{Path: "pending/avatars", FileName: "a.png"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key != "pending/avatars/2024/a.png" {
+		t.Errorf("key = %q, want the path in front", key)
+	}
+}
+
+func TestNewTemplateKeyGeneratorChecksTemplate(t *testing.T) {
+	for _, text := range []string{
+		"{{.Tenant}}/{{.UUID}}{{.Ext}}",
+		"{{.Unknown}}",
+		"{{.UUID",
+		"../{{.UUID}}",
+		"{{if false}}x{{end}}",
+	} {
+		if _, err := newTemplateKeyGenerator(text, defaultKeyNamespace); err == nil {
+			t.Errorf("template %q was accepted", text)
+		}
+	}
+}
+
+func TestIdempotencyKeysDeriveStableUUIDs(t *testing.T) {
+	namespace := uuid.MustParse("0b8d2a4e-1f3c-4e5a-9b7d-6c2e8f1a3d5b")
+	g := uuidKeyGenerator{namespace: namespace}
+	in := KeyInput{ContentType: "image/png", IdempotencyKey: "order-42"}
+
+	first, _ := g.GenerateKey(in)
+	second, _ := g.GenerateKey(in)
+	if first != second {
+		t.Errorf("retries got %q and %q, want the same key", first, second)
+	}
+	want := uuid.NewSHA1(namespace, []byte("order-42")).String() + ".png"
+	if first != want {
+		t.Errorf("key = %q, want the UUIDv5 %q", first, want)
+	}
+
+	other, _ := uuidKeyGenerator{namespace: defaultKeyNamespace}.GenerateKey(in)
+	if other == first {
+		t.Error("different namespaces derived the same key")
+	}
+	random, _ := g.GenerateKey(KeyInput{ContentType: "image/png"})
+	if random == first {
+		t.Error("a key without idempotency key matched the derived one")
+	}
+}
+
+func TestPresignUploadDerivesKeysFromIdempotencyKeys(t *testing.T) {
+	ctx := context.Background()
+	const namespace = "0b8d2a4e-1f3c-4e5a-9b7d-6c2e8f1a3d5b"
+	cfg := testConfig()
+	cfg.KeyNamespace = namespace
+	s := newTestService(t, cfg, newFakeStorage("media"))
+
+	presign := func(s *Service, idempotencyKey string) string {
+		t.Helper()
+		resp, err := s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{ContentType: "image/png", Path: "orders", IdempotencyKey: idempotencyKey})
+		if err != nil {
+			t.Fatalf("PresignUpload(%q): %v", idempotencyKey, err)
+		}
+		return resp.ObjectKey
+	}
+
+	first := presign(s, "order-42")
+	if want := "orders/" + uuid.NewSHA1(uuid.MustParse(namespace), []byte("order-42")).String() + ".png"; first != want {
+		t.Errorf("key = %q, want the UUIDv5 %q", first, want)
+	}
+	if retry := presign(s, "order-42"); retry != first {
+		t.Errorf("retry got %q, want %q", retry, first)
+	}
+	if other := presign(s, "order-43"); other == first {
+		t.Error("different idempotency keys derived the same key")
+	}
+	if presign(s, "") == presign(s, "") {
+		t.Error("requests without idempotency keys got the same key")
+	}
+	// Another deployment's namespace keeps its keys apart
+	if other := presign(newTestService(t, testConfig(), newFakeStorage("media")), "order-42"); other == first {
+		t.Error("different namespaces derived the same key")
+	}
+
+	for _, req := range []*mediabase_v1.PresignUploadRequest{
+		{ContentType: "image/png", IdempotencyKey: "order-42", FileName: "a.png"},
+		{ContentType: "image/png", IdempotencyKey: "order-42", Deduplicate: true, ChecksumSha256: strings.Repeat("a", 64)},
+	} {
+		if _, err := s.PresignUpload(ctx, req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("idempotency key with file_name %q, deduplicate %v: error = %v, want INVALID_ARGUMENT", req.FileName, req.Deduplicate, err)
+		}
+	}
+}
+
+func TestKeyNamespaceValidation(t *testing.T) {
+	for _, tc := range []struct {
+		namespace string
+		ok        bool
+	}{
+		{"", true},
+		{"0b8d2a4e-1f3c-4e5a-9b7d-6c2e8f1a3d5b", true},
+		{"orders", false},
+	} {
+		cfg := testConfig()
+		cfg.KeyNamespace = tc.namespace
+		if err := cfg.Validate(); (err == nil) != tc.ok {
+			t.Errorf("KeyNamespace %q: Validate() = %v, want ok %v", tc.namespace, err, tc.ok)
+		}
+	}
+}
+
+func TestDateKeyGenerator(t *testing.T) {
+	g := dateKeyGenerator{now: fixedNow, namespace: defaultKeyNamespace}
+
+	key, err := g.GenerateKey(KeyInput{Path: "photos", ContentType: "image/jpeg", IdempotencyKey: "k"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "photos/2024/03/07/" + uuid.NewSHA1(defaultKeyNamespace, []byte("k")).String() + ".jpg"
+	if key != want {
+		t.Errorf("key = %q, want %q", key, want)
+	}
+}
+
+func TestNewKeyGenerator(t *testing.T) {
+	for strategy, want := range map[string]KeyGenerator{
+		"":                     uuidKeyGenerator{},
+		KeyStrategyUUID:        uuidKeyGenerator{},
+		KeyStrategyDate:        dateKeyGenerator{},
+		KeyStrategyContentHash: contentHashKeyGenerator{},
+	} {
+		g, err := newKeyGenerator(strategy, defaultKeyNamespace)
+		if err != nil {
+			t.Errorf("strategy %q: %v", strategy, err)
+			continue
+		}
+		if fmt.Sprintf("%T", g) != fmt.Sprintf("%T", want) {
+			t.Errorf("strategy %q gave %T, want %T", strategy, g, want)
+		}
+	}
+	if _, err := newKeyGenerator("sequential", defaultKeyNamespace); err == nil {
+		t.Error("unknown strategy accepted")
+	}
+}
+
+func TestUUIDKeyGenerator(t *testing.T) {
+	g := uuidKeyGenerator{namespace: defaultKeyNamespace}
+
+	first, _ := g.GenerateKey(KeyInput{Path: "avatars", ContentType: "image/jpeg"})
+	second, _ := g.GenerateKey(KeyInput{Path: "avatars", ContentType: "image/jpeg"})
+	if first == second {
+		t.Errorf("two uploads got the same key %q", first)
+	}
+	name, ok := strings.CutPrefix(first, "avatars/")
+	if !ok || !strings.HasSuffix(name, ".jpg") {
+		t.Fatalf("key = %q, want avatars/<uuid>.jpg", first)
+	}
+	if _, err := uuid.Parse(strings.TrimSuffix(name, ".jpg")); err != nil {
+		t.Errorf("key %q does not hold a UUID: %v", first, err)
+	}
+
+	if key, _ := g.GenerateKey(KeyInput{Path: "docs", FileName: "report.pdf"}); key != "docs/report.pdf" {
+		t.Errorf("file name key = %q, want docs/report.pdf", key)
+	}
+}
+
+func TestContentHashKeyGenerator(t *testing.T) {
+	g := contentHashKeyGenerator{}
+	const sum = "9F86D081884C7D659A2FEAA0C55AD015A3BF4F1B2B0B822CD15D6C15B0F00A08"
+
+	key, err := g.GenerateKey(KeyInput{Path: "blobs", ContentType: "image/png", ContentSHA256: sum})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key != "blobs/"+strings.ToLower(sum)+".png" {
+		t.Errorf("key = %q, want the lowercase hash", key)
+	}
+	if _, err := g.GenerateKey(KeyInput{ContentType: "image/png"}); err == nil {
+		t.Error("key generated without the content hash")
+	}
+}
+
+// fixedKeyGenerator names every object the same, standing in for an embedder's strategy
+type fixedKeyGenerator string
+
+func (g fixedKeyGenerator) GenerateKey(in KeyInput) (string, error) {
+	return joinKey(in.Path, string(g)), nil
+}
+
+func TestPresignUploadUsesKeyStrategy(t *testing.T) {
+	ctx := context.Background()
+
+	injected := newTestService(t, testConfig(), newFakeStorage("media"), WithKeyGenerator(fixedKeyGenerator("fixed.png")))
+	resp, err := injected.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{ContentType: "image/png", Path: "a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.ObjectKey != "a/fixed.png" {
+		t.Errorf("injected generator: key = %q, want a/fixed.png", resp.ObjectKey)
+	}
+
+	cfg := testConfig()
+	cfg.KeyStrategy = KeyStrategyDate
+	dated := newTestService(t, cfg, newFakeStorage("media"))
+	before := time.Now().UTC().Format("2006/01/02/")
+	resp, err = dated.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{ContentType: "image/png"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	after := time.Now().UTC().Format("2006/01/02/")
+	if !strings.HasPrefix(resp.ObjectKey, before) && !strings.HasPrefix(resp.ObjectKey, after) {
+		t.Errorf("date strategy: key = %q, want it under today's date", resp.ObjectKey)
+	}
+
+	cfg.KeyStrategy = "sequential"
+	if err := cfg.Validate(); err == nil {
+		t.Error("unknown KeyStrategy passed validation")
+	}
+}
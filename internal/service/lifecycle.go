@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxLifecyclePrefixLength keeps the derived lifecycle rule ID within the 255 character S3 limit
+const maxLifecyclePrefixLength = 200
+
+// SetBucketLifecycle expires objects under a prefix after a number of days
+func (s *Service) SetBucketLifecycle(ctx context.Context, req *mediabase_v1.SetBucketLifecycleRequest) (*mediabase_v1.SetBucketLifecycleResponse, error) {
+	ctx = withLogFields(ctx, req.BucketName, "")
+	logDebug(ctx, "SetBucketLifecycle request received, bucket: %s, prefix: %s, expiration_days: %d", req.BucketName, req.Prefix, req.ExpirationDays)
+
+	if err := s.validateBucket(req.BucketName); err != nil {
+		return nil, err
+	}
+
+	if err := s.requireCapability(s.storage.Capabilities().Lifecycle, "lifecycle rules"); err != nil {
+		return nil, err
+	}
+
+	// An empty prefix would expire every object in the bucket
+	if req.Prefix == "" || len(req.Prefix) > maxLifecyclePrefixLength {
+		return nil, status.Errorf(codes.InvalidArgument, "prefix must be between 1 and %d characters", maxLifecyclePrefixLength)
+	}
+
+	if req.ExpirationDays <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "expiration_days must be greater than zero")
+	}
+
+	err := s.storage.SetBucketLifecycle(ctx, req.BucketName, req.Prefix, int(req.ExpirationDays))
+	if err != nil {
+		logger.Error(ctx, "Failed to set bucket lifecycle: %v", err)
+		return nil, storageError("failed to set bucket lifecycle", err)
+	}
+
+	logDebug(ctx, "Bucket lifecycle set: %s, prefix: %s, expiration_days: %d", req.BucketName, req.Prefix, req.ExpirationDays)
+
+	return &mediabase_v1.SetBucketLifecycleResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"path"
+	"runtime"
+	"strconv"
+
+	"github.com/gofreego/goutils/logger"
+)
+
+// Log field names added by LogFieldsMiddleLayer
+const (
+	bucketLogField    = "bucket"
+	objectKeyLogField = "objectKey"
+	debugLogField     = "debug"
+	sourceLogField    = "source"
+)
+
+// logFieldsKey is the context key of the bucket and object key a request works on
+type logFieldsKey struct{}
+
+// logFields are the request attributes attached to every log line written with a context
+type logFields struct {
+	bucket    string
+	objectKey string
+}
+
+// withLogFields returns a context whose log lines carry the bucket and object key of a request.
+// An empty value keeps the one already in ctx, so keys generated later can be added.
+func withLogFields(ctx context.Context, bucket, objectKey string) context.Context {
+	fields, _ := ctx.Value(logFieldsKey{}).(logFields)
+	if bucket != "" {
+		fields.bucket = bucket
+	}
+	if objectKey != "" {
+		fields.objectKey = objectKey
+	}
+	return context.WithValue(ctx, logFieldsKey{}, fields)
+}
+
+// LogFieldsMiddleLayer adds the bucket and object key of the current request to log lines, the
+// source of debug lines, and marks the lines of requests with debug logging.
+// Register it with logger.AddMiddleLayers next to logger.RequestMiddleLayer, which adds the request ID.
+func LogFieldsMiddleLayer(ctx context.Context, msg string, fields *logger.Fields) (context.Context, string, *logger.Fields) {
+	if lf, ok := ctx.Value(logFieldsKey{}).(logFields); ok {
+		if lf.bucket != "" {
+			fields.AddField(bucketLogField, lf.bucket)
+		}
+		if lf.objectKey != "" {
+			fields.AddField(objectKeyLogField, lf.objectKey)
+		}
+	}
+	if source, ok := ctx.Value(logSourceKey{}).(string); ok {
+		fields.AddField(sourceLogField, source)
+	}
+	if debugLogging(ctx) {
+		fields.AddField(debugLogField, true)
+	}
+	return ctx, msg, fields
+}
+
+// DebugHeader is the HTTP header and gRPC metadata key that turns on debug logging for a single
+// request, e.g. "x-mediabase-debug: true"
+const DebugHeader = "x-mediabase-debug"
+
+// debugLoggingKey is the context key marking a request for debug logging
+type debugLoggingKey struct{}
+
+// logSourceKey is the context key of the file and line a debug log line was written from
+type logSourceKey struct{}
+
+// WithRequestDebug turns on debug logging for the request of ctx when the value of its
+// DebugHeader is true and Config.AllowDebugHeader permits it. Servers call it for every request.
+func (s *Service) WithRequestDebug(ctx context.Context, headerValue string) context.Context {
+	if !s.allowDebugHeader || headerValue == "" {
+		return ctx
+	}
+	if enabled, err := strconv.ParseBool(headerValue); err != nil || !enabled {
+		return ctx
+	}
+	return context.WithValue(ctx, debugLoggingKey{}, true)
+}
+
+// debugLogging reports whether the request of ctx asked for debug logging
+func debugLogging(ctx context.Context) bool {
+	enabled, _ := ctx.Value(debugLoggingKey{}).(bool)
+	return enabled
+}
+
+// logDebug writes a debug log line together with the place it was written from, which the
+// caller of the log line would otherwise show as this function. For requests with debug logging
+// the line is written at info level, so it passes a global level that hides debug lines.
+func logDebug(ctx context.Context, format string, a ...any) {
+	if _, file, line, ok := runtime.Caller(1); ok {
+		ctx = context.WithValue(ctx, logSourceKey{}, path.Base(path.Dir(file))+"/"+path.Base(file)+":"+strconv.Itoa(line))
+	}
+	if debugLogging(ctx) {
+		logger.Info(ctx, format, a...)
+		return
+	}
+	logger.Debug(ctx, format, a...)
+}
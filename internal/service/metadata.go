@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"github.com/gofreego/mediabase/internal/storage"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetObjectMetadata returns the attributes and application metadata of an object.
+// Metadata the service records for its own use is left out, except for the cache control value.
+func (s *Service) GetObjectMetadata(ctx context.Context, req *mediabase_v1.GetObjectMetadataRequest) (*mediabase_v1.GetObjectMetadataResponse, error) {
+	ctx = withLogFields(ctx, req.BucketName, req.ObjectKey)
+	logDebug(ctx, "GetObjectMetadata request received, bucket: %s, object_key: %s", req.BucketName, req.ObjectKey)
+
+	info, err := s.StatObject(ctx, req.BucketName, req.ObjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := make(map[string]string, len(info.UserMetadata))
+	for k, v := range info.UserMetadata {
+		if !reservedMetadataKeys[k] {
+			metadata[k] = v
+		}
+	}
+
+	return &mediabase_v1.GetObjectMetadataResponse{
+		ObjectKey:    req.ObjectKey,
+		Size:         info.Size,
+		ContentType:  info.ContentType,
+		Etag:         info.ETag,
+		LastModified: timestamppb.New(info.LastModified),
+		CacheControl: info.UserMetadata[storage.CacheControlMetadataKey],
+		Metadata:     metadata,
+		ExpiresAt:    expiresAt(info),
+	}, nil
+}
+
+// expiresAt returns the lifecycle expiry of an object, or nil when no rule applies
+func expiresAt(info *storage.ObjectInfo) *timestamppb.Timestamp {
+	if info.Expiration.IsZero() {
+		return nil
+	}
+	return timestamppb.New(info.Expiration)
+}
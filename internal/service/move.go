@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MoveObject moves an object to another key, within its bucket or into another one, by copying
+// it on the storage side with its attributes and deleting the source.
+// An existing object under the destination key is never overwritten.
+func (s *Service) MoveObject(ctx context.Context, req *mediabase_v1.MoveObjectRequest) (*mediabase_v1.MoveObjectResponse, error) {
+	ctx = withLogFields(ctx, req.BucketName, req.SourceKey)
+	logDebug(ctx, "MoveObject request received, bucket: %s, source_key: %s, destination_bucket: %s, destination_key: %s", req.BucketName, req.SourceKey, req.DestinationBucket, req.DestinationKey)
+
+	if err := s.resolveBucket(&req.BucketName); err != nil {
+		return nil, err
+	}
+	dstBucket := req.DestinationBucket
+	if dstBucket == "" {
+		dstBucket = req.BucketName
+	}
+	if err := s.validateBucket(dstBucket); err != nil {
+		return nil, err
+	}
+
+	if req.SourceKey == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "source_key is required")
+	}
+	dstKey := req.DestinationKey
+	if dstKey == "" {
+		dstKey = req.SourceKey
+	}
+	if err := s.validateObjectKey(dstKey); err != nil {
+		return nil, err
+	}
+	if dstBucket == req.BucketName && dstKey == req.SourceKey {
+		return nil, status.Errorf(codes.InvalidArgument, "the destination must differ from the source")
+	}
+
+	info, err := s.StatObject(ctx, req.BucketName, req.SourceKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if dstBucket != req.BucketName {
+		exists, err := s.storage.BucketExists(ctx, dstBucket)
+		if err != nil {
+			logger.Error(ctx, "Failed to check bucket existence: %v", err)
+			return nil, storageError("failed to check bucket existence", err)
+		}
+		if !exists {
+			return nil, status.Errorf(codes.NotFound, "bucket not found: %s", dstBucket)
+		}
+		if err := s.quotas.check(ctx, dstBucket, info.Size); err != nil {
+			return nil, err
+		}
+	}
+
+	exists, err := s.storage.ObjectExists(ctx, dstBucket, dstKey)
+	if err != nil {
+		logger.Error(ctx, "Failed to check object existence: %v", err)
+		return nil, storageError("failed to check object existence", err)
+	}
+	if exists {
+		return nil, status.Errorf(codes.AlreadyExists, "object %s already exists in bucket: %s", dstKey, dstBucket)
+	}
+
+	if err := s.moveObject(ctx, req.BucketName, req.SourceKey, dstBucket, dstKey, info, info.UserMetadata); err != nil {
+		return nil, err
+	}
+
+	logDebug(ctx, "Object moved successfully: %s in bucket: %s to %s in bucket: %s", req.SourceKey, req.BucketName, dstKey, dstBucket)
+	if dstBucket != req.BucketName {
+		s.quotas.record(dstBucket, dstKey, info.Size, 0, time.Now())
+	}
+
+	return &mediabase_v1.MoveObjectResponse{
+		BucketName: dstBucket,
+		ObjectKey:  dstKey,
+		Size:       info.Size,
+	}, nil
+}
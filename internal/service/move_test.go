@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"github.com/gofreego/mediabase/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMoveObjectWithinBucket(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	fake.put("media", "a.png", []byte("png"), "image/png", map[string]string{"owner": "alice"})
+	s := newTestService(t, testConfig(), fake)
+
+	resp, err := s.MoveObject(ctx, &mediabase_v1.MoveObjectRequest{SourceKey: "a.png", DestinationKey: "archive/a.png"})
+	if err != nil {
+		t.Fatalf("MoveObject: %v", err)
+	}
+	if resp.BucketName != "media" || resp.ObjectKey != "archive/a.png" || resp.Size != 3 {
+		t.Errorf("response = %+v, want archive/a.png of 3 bytes in media", resp)
+	}
+	if _, err := fake.object("media", "a.png"); err == nil {
+		t.Error("source kept after the move")
+	}
+	moved, err := fake.object("media", "archive/a.png")
+	if err != nil {
+		t.Fatalf("object not at the destination: %v", err)
+	}
+	if string(moved.data) != "png" || moved.contentType != "image/png" || moved.metadata["owner"] != "alice" {
+		t.Errorf("moved object %q as %s with %v, want its content and attributes", moved.data, moved.contentType, moved.metadata)
+	}
+}
+
+func TestMoveObjectAcrossBuckets(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("hot", "cold")
+	fake.put("hot", "a.png", []byte("png"), "image/png", nil)
+	cfg := testConfig()
+	cfg.DefaultBucket = "hot"
+	s := newTestService(t, cfg, fake)
+
+	// Without a destination key the object keeps its key
+	resp, err := s.MoveObject(ctx, &mediabase_v1.MoveObjectRequest{SourceKey: "a.png", DestinationBucket: "cold"})
+	if err != nil {
+		t.Fatalf("MoveObject: %v", err)
+	}
+	if resp.BucketName != "cold" || resp.ObjectKey != "a.png" {
+		t.Errorf("moved to %s/%s, want cold/a.png", resp.BucketName, resp.ObjectKey)
+	}
+	if _, err := fake.object("hot", "a.png"); err == nil {
+		t.Error("source kept after the move")
+	}
+	if _, err := fake.object("cold", "a.png"); err != nil {
+		t.Errorf("object not in the destination bucket: %v", err)
+	}
+}
+
+func TestMoveObjectErrors(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media", "other")
+	fake.put("media", "a.png", []byte("png"), "image/png", nil)
+	fake.put("media", "taken.png", []byte("taken"), "image/png", nil)
+	cfg := testConfig()
+	cfg.AllowedBuckets = []string{"media", "other", "missing"}
+	s := newTestService(t, cfg, fake)
+
+	for _, tc := range []struct {
+		name string
+		req  *mediabase_v1.MoveObjectRequest
+		want codes.Code
+	}{
+		{"no source", &mediabase_v1.MoveObjectRequest{DestinationKey: "b.png"}, codes.InvalidArgument},
+		{"onto itself", &mediabase_v1.MoveObjectRequest{SourceKey: "a.png"}, codes.InvalidArgument},
+		{"missing source", &mediabase_v1.MoveObjectRequest{SourceKey: "missing.png", DestinationKey: "b.png"}, codes.NotFound},
+		{"missing bucket", &mediabase_v1.MoveObjectRequest{SourceKey: "a.png", DestinationBucket: "missing"}, codes.NotFound},
+		{"bucket off the list", &mediabase_v1.MoveObjectRequest{SourceKey: "a.png", DestinationBucket: "stray"}, codes.PermissionDenied},
+		{"existing destination", &mediabase_v1.MoveObjectRequest{SourceKey: "a.png", DestinationKey: "taken.png"}, codes.AlreadyExists},
+	} {
+		_, err := s.MoveObject(ctx, tc.req)
+		if status.Code(err) != tc.want {
+			t.Errorf("%s: error = %v, want %s", tc.name, err, tc.want)
+		}
+	}
+	if current, _ := fake.object("media", "taken.png"); string(current.data) != "taken" {
+		t.Error("move overwrote an existing object")
+	}
+	if _, err := fake.object("media", "a.png"); err != nil {
+		t.Error("failed moves removed the source")
+	}
+}
+
+func TestMoveObjectToBackendThatCannotCopy(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media", "videos")
+	fake.put("media", "a.png", []byte("png"), "image/png", nil)
+	// As the router reports a destination bucket storing the content type on another backend
+	fake.failWith("CopyObject", fmt.Errorf("%w: bucket videos stores image/png objects on a different backend", storage.ErrNotSupported))
+	s := newTestService(t, testConfig(), fake)
+
+	_, err := s.MoveObject(ctx, &mediabase_v1.MoveObjectRequest{SourceKey: "a.png", DestinationBucket: "videos"})
+	if status.Code(err) != codes.Unimplemented {
+		t.Errorf("error = %v, want UNIMPLEMENTED explaining the backends differ", err)
+	}
+	if _, err := fake.object("media", "a.png"); err != nil {
+		t.Error("source deleted although the copy failed")
+	}
+}
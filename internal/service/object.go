@@ -0,0 +1,234 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"github.com/gofreego/mediabase/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PutObject uploads a file directly through the server, verifying optional checksums
+func (s *Service) PutObject(ctx context.Context, req *mediabase_v1.PutObjectRequest) (*mediabase_v1.PutObjectResponse, error) {
+	ctx = withLogFields(ctx, req.BucketName, "")
+	logDebug(ctx, "PutObject request received, bucket: %s, content_type: %s, size: %d", req.BucketName, req.ContentType, len(req.Content))
+
+	if err := s.resolveBucket(&req.BucketName); err != nil {
+		return nil, err
+	}
+
+	// Validate content type
+	contentType, err := s.resolveContentType(req.ContentType)
+	if err != nil {
+		return nil, err
+	}
+	req.ContentType = contentType
+	if !s.isValidContentType(req.ContentType) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid content type: %s", req.ContentType)
+	}
+
+	// Validate the file name against the naming rules
+	if req.FileName != "" {
+		fileName, err := s.checkFileName(ctx, req.FileName)
+		if err != nil {
+			return nil, err
+		}
+		req.FileName = fileName
+	}
+
+	// Validate size against the server limit for this content type
+	size := int64(len(req.Content))
+	if maxFileSize := s.maxFileSizeFor(req.ContentType); size > maxFileSize {
+		return nil, status.Errorf(codes.InvalidArgument, "file size %d exceeds server maximum allowed size %d for %s", size, maxFileSize, req.ContentType)
+	}
+
+	// Validate cache control directives
+	if req.CacheControl != "" {
+		if err := validateCacheControl(req.CacheControl); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cache control: %v", err)
+		}
+	}
+
+	// Validate expected checksums
+	if req.ContentMd5 != "" {
+		if err := validateHexDigest(req.ContentMd5, md5.Size); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid MD5 checksum: %v", err)
+		}
+	}
+	if req.ChecksumSha256 != "" {
+		if err := validateHexDigest(req.ChecksumSha256, sha256.Size); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid SHA-256 checksum: %v", err)
+		}
+	}
+
+	// Validate tags
+	if len(req.Tags) > 0 {
+		if err := s.requireCapability(s.storage.Capabilities().ObjectTagging, "object tags"); err != nil {
+			return nil, err
+		}
+		if err := validateTags(req.Tags); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid tags: %v", err)
+		}
+	}
+
+	// Validate the encryption key override
+	if req.KmsKeyId != "" {
+		if err := s.requireCapability(s.storage.Capabilities().ServerSideEncryption, "KMS keys"); err != nil {
+			return nil, err
+		}
+	}
+
+	// Generate object key
+	contentSHA256 := req.ChecksumSha256
+	if contentSHA256 == "" {
+		sum := sha256.Sum256(req.Content)
+		contentSHA256 = hex.EncodeToString(sum[:])
+	}
+	objectKey, err := s.generateObjectKey(ctx, KeyInput{
+		Path:          req.Path,
+		FileName:      req.FileName,
+		ContentType:   req.ContentType,
+		ContentSHA256: contentSHA256,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx = withLogFields(ctx, req.BucketName, objectKey)
+
+	// Uploads the orphan cleanup covers are tagged confirmed, which must still fit
+	if len(req.Tags) >= maxObjectTags && s.orphanCleanup.covers(req.BucketName, s.normalizeKey(objectKey)) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tags: at most %d tags are allowed under %s, one is reserved for confirmation", maxObjectTags-1, s.orphanCleanup.cfg.Prefix)
+	}
+
+	if err := s.quotas.check(ctx, req.BucketName, size); err != nil {
+		return nil, err
+	}
+	replaced := s.replacedSize(ctx, req.BucketName, objectKey)
+
+	release, err := s.puts.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	etag, err := s.storage.PutObject(ctx, req.BucketName, objectKey, bytes.NewReader(req.Content), size, req.ContentType, storage.UploadOptions{
+		CacheControl:   req.CacheControl,
+		ChecksumSHA256: req.ChecksumSha256,
+		ContentMD5:     req.ContentMd5,
+		Tags:           s.markConfirmed(req.BucketName, objectKey, req.Tags),
+		KMSKeyID:       req.KmsKeyId,
+	})
+	release()
+	if err != nil {
+		if errors.Is(err, storage.ErrChecksumMismatch) {
+			return nil, status.Errorf(codes.InvalidArgument, "integrity check failed for %s: %v", objectKey, err)
+		}
+		logger.Error(ctx, "Failed to put object: %v", err)
+		return nil, storageError("failed to put object", err)
+	}
+
+	logDebug(ctx, "Object uploaded successfully: %s in bucket: %s, bytes: %d", objectKey, req.BucketName, size)
+	s.quotas.record(req.BucketName, objectKey, size, replaced, time.Now())
+
+	return &mediabase_v1.PutObjectResponse{
+		ObjectKey: objectKey,
+		Size:      size,
+		Etag:      etag,
+	}, nil
+}
+
+// ConfirmUpload verifies that a presigned upload landed in storage and matches its expected checksum
+func (s *Service) ConfirmUpload(ctx context.Context, req *mediabase_v1.ConfirmUploadRequest) (*mediabase_v1.ConfirmUploadResponse, error) {
+	ctx = withLogFields(ctx, req.BucketName, req.ObjectKey)
+	logDebug(ctx, "ConfirmUpload request received, bucket: %s, object_key: %s", req.BucketName, req.ObjectKey)
+
+	if err := s.resolveBucket(&req.BucketName); err != nil {
+		return nil, err
+	}
+
+	// Check that the object exists, giving a fresh upload time to become visible
+	info, err := s.statObject(ctx, req.BucketName, req.ObjectKey)
+	if errors.Is(err, storage.ErrObjectNotFound) {
+		return nil, status.Errorf(codes.NotFound, "object not found: %s in bucket: %s", req.ObjectKey, req.BucketName)
+	}
+	if err != nil {
+		logger.Error(ctx, "Failed to stat object: %v", err)
+		return nil, storageError("failed to stat object", err)
+	}
+
+	// Verify the size and content type of PUT uploads where storage could not
+	if err := s.checkPutUpload(ctx, req.BucketName, req.ObjectKey, info); err != nil {
+		return nil, err
+	}
+
+	// Verify the content against the checksum recorded at presign time
+	expected := info.UserMetadata[storage.ChecksumSHA256MetadataKey]
+	if expected != "" {
+		actual, err := s.objectSHA256(ctx, req.BucketName, req.ObjectKey)
+		if err != nil {
+			return nil, err
+		}
+		if actual != strings.ToLower(expected) {
+			logger.Error(ctx, "Checksum mismatch for %s: expected %s, got %s", req.ObjectKey, expected, actual)
+			if err := s.storage.DeleteObject(ctx, req.BucketName, req.ObjectKey); err != nil {
+				logger.Error(ctx, "Failed to delete corrupted object %s: %v", req.ObjectKey, err)
+			}
+			return nil, status.Errorf(codes.InvalidArgument, "integrity check failed for %s: expected SHA-256 %s, got %s", req.ObjectKey, expected, actual)
+		}
+	}
+
+	// Infected uploads are deleted before anyone can download them
+	if s.scanner != nil {
+		if err := s.scanObject(ctx, req.BucketName, req.ObjectKey); err != nil {
+			return nil, err
+		}
+	}
+
+	// Apply the tags recorded at presign time, marking uploads the orphan cleanup covers as confirmed
+	var tags map[string]string
+	if encoded := info.UserMetadata[storage.TagsMetadataKey]; encoded != "" {
+		tags, err = storage.DecodeTags(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tags recorded for %s: %w", req.ObjectKey, err)
+		}
+	}
+	if applied := s.markConfirmed(req.BucketName, req.ObjectKey, tags); len(applied) > 0 {
+		if err := s.storage.SetObjectTags(ctx, req.BucketName, req.ObjectKey, applied); err != nil {
+			logger.Error(ctx, "Failed to apply tags to %s: %v", req.ObjectKey, err)
+			return nil, storageError("failed to apply tags", err)
+		}
+	}
+
+	s.quotas.record(req.BucketName, req.ObjectKey, info.Size, 0, info.LastModified)
+
+	logDebug(ctx, "Upload confirmed: %s, bytes: %d, checksum verified: %v, malware scanned: %v, tags: %d", req.ObjectKey, info.Size, expected != "", s.scanner != nil, len(tags))
+
+	return &mediabase_v1.ConfirmUploadResponse{
+		ObjectKey:        req.ObjectKey,
+		Size:             info.Size,
+		ContentType:      info.ContentType,
+		ChecksumVerified: expected != "",
+		Tags:             tags,
+		MalwareScanned:   s.scanner != nil,
+		ExpiresAt:        expiresAt(info),
+		Etag:             info.ETag,
+	}, nil
+}
+
+// objectSHA256 streams an object from storage and returns its hex-encoded SHA-256
+func (s *Service) objectSHA256(ctx context.Context, bucketName, objectKey string) (string, error) {
+	h := sha256.New()
+	if _, err := storage.CopyObjectTo(ctx, s.storage, bucketName, objectKey, h); err != nil {
+		logger.Error(ctx, "Failed to read object: %v", err)
+		return "", storageError("failed to read object", err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"github.com/gofreego/mediabase/internal/kvstore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultOneTimeTokenTTL is used when OneTimeDownload.TokenTTL is not set
+	defaultOneTimeTokenTTL = 24 * time.Hour
+	// defaultOneTimeURLExpiry is used when OneTimeDownload.URLExpiry is not set
+	defaultOneTimeURLExpiry = time.Minute
+	// oneTimeTokenBytes is the number of random bytes in a one-time download token
+	oneTimeTokenBytes = 32
+)
+
+// OneTimeDownloadConfig controls one-time download tokens
+type OneTimeDownloadConfig struct {
+	// TokenTTL is the default and longest time a token can be redeemed (defaults to 24h)
+	TokenTTL time.Duration `yaml:"TokenTTL"`
+	// URLExpiry is the expiry of the presigned URL a redeemed token returns (defaults to 1m)
+	URLExpiry time.Duration `yaml:"URLExpiry"`
+}
+
+// DownloadTarget is the object a one-time download token grants access to
+type DownloadTarget struct {
+	Bucket    string
+	Key       string
+	VersionID string
+}
+
+// TokenStore keeps one-time download tokens. Redeem must be atomic, so a token redeemed by
+// several requests at once is handed out to only one of them.
+type TokenStore interface {
+	// Save stores the target of a token until ttl has passed
+	Save(ctx context.Context, token string, target DownloadTarget, ttl time.Duration) error
+	// Redeem returns and removes the target of a token; ok is false if it is unknown or expired
+	Redeem(ctx context.Context, token string) (target DownloadTarget, ok bool, err error)
+}
+
+// WithTokenStore keeps one-time download tokens in a custom store instead of the configured
+// key-value store
+func WithTokenStore(store TokenStore) Option {
+	return func(s *Service) {
+		s.tokens = store
+	}
+}
+
+// CreateOneTimeDownload issues a token that RedeemDownload exchanges for a presigned URL once
+func (s *Service) CreateOneTimeDownload(ctx context.Context, req *mediabase_v1.CreateOneTimeDownloadRequest) (*mediabase_v1.CreateOneTimeDownloadResponse, error) {
+	ctx = withLogFields(ctx, req.BucketName, req.ObjectKey)
+	logDebug(ctx, "CreateOneTimeDownload request received, bucket: %s, object_key: %s, version_id: %s", req.BucketName, req.ObjectKey, req.VersionId)
+
+	if err := s.resolveBucket(&req.BucketName); err != nil {
+		return nil, err
+	}
+
+	ttl := s.oneTimeDownload.TokenTTL
+	if req.ExpiresIn < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "expires_in must not be negative")
+	}
+	if req.ExpiresIn > 0 {
+		requested := time.Duration(req.ExpiresIn) * time.Second
+		if requested > ttl {
+			return nil, status.Errorf(codes.InvalidArgument, "expires_in must not exceed %d seconds", int64(ttl.Seconds()))
+		}
+		ttl = requested
+	}
+
+	// Fail early for objects that do not exist; they are checked again on redemption
+	if req.VersionId != "" {
+		if err := s.requireVersion(ctx, req.BucketName, req.ObjectKey, req.VersionId); err != nil {
+			return nil, err
+		}
+	} else if _, err := s.StatObject(ctx, req.BucketName, req.ObjectKey); err != nil {
+		return nil, err
+	}
+
+	token, err := newOneTimeToken()
+	if err != nil {
+		logger.Error(ctx, "Failed to generate one-time download token: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to generate token: %v", err)
+	}
+
+	target := DownloadTarget{Bucket: req.BucketName, Key: req.ObjectKey, VersionID: req.VersionId}
+	if err := s.tokens.Save(ctx, token, target, ttl); err != nil {
+		logger.Error(ctx, "Failed to save one-time download token: %v", err)
+		return nil, status.Errorf(codes.Unavailable, "failed to save token: %v", err)
+	}
+
+	logDebug(ctx, "One-time download token created for object: %s, expires in: %s", req.ObjectKey, ttl)
+
+	return &mediabase_v1.CreateOneTimeDownloadResponse{
+		Token:     token,
+		ExpiresIn: int32(ttl.Seconds()),
+	}, nil
+}
+
+// RedeemDownload consumes a one-time download token and presigns a short-lived download URL
+// for its object. The token is invalidated before presigning, so it is spent even if that fails.
+func (s *Service) RedeemDownload(ctx context.Context, req *mediabase_v1.RedeemDownloadRequest) (*mediabase_v1.RedeemDownloadResponse, error) {
+	logDebug(ctx, "RedeemDownload request received")
+
+	if req.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "token is required")
+	}
+
+	target, ok, err := s.tokens.Redeem(ctx, req.Token)
+	if err != nil {
+		logger.Error(ctx, "Failed to redeem one-time download token: %v", err)
+		return nil, status.Errorf(codes.Unavailable, "failed to redeem token: %v", err)
+	}
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "token is unknown, expired or already redeemed")
+	}
+	ctx = withLogFields(ctx, target.Bucket, target.Key)
+
+	resp, err := s.PresignDownload(ctx, &mediabase_v1.PresignDownloadRequest{
+		BucketName: target.Bucket,
+		ObjectKey:  target.Key,
+		VersionId:  target.VersionID,
+		ExpiresIn:  int32(s.oneTimeDownload.URLExpiry.Seconds()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logDebug(ctx, "One-time download token redeemed for object: %s", target.Key)
+
+	return &mediabase_v1.RedeemDownloadResponse{
+		PresignedUrl: resp.PresignedUrl,
+		ExpiresIn:    resp.ExpiresIn,
+	}, nil
+}
+
+// newOneTimeToken returns a random URL-safe token
+func newOneTimeToken() (string, error) {
+	b := make([]byte, oneTimeTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// validate rejects durations the service cannot honour
+func (c OneTimeDownloadConfig) validate() error {
+	if c.TokenTTL < 0 {
+		return errors.New("OneTimeDownload.TokenTTL must not be negative")
+	}
+	if c.URLExpiry < 0 || c.URLExpiry != 0 && c.URLExpiry < time.Second {
+		return errors.New("OneTimeDownload.URLExpiry must be at least one second")
+	}
+	return nil
+}
+
+// oneTimeTokenKeyPrefix namespaces one-time download tokens in the key-value store
+const oneTimeTokenKeyPrefix = "onetime:"
+
+// kvTokenStore keeps tokens in the service's key-value store, so with a shared store a token
+// issued by one replica can be redeemed on any other
+type kvTokenStore struct {
+	kv kvstore.KVStore
+}
+
+func (t kvTokenStore) Save(ctx context.Context, token string, target DownloadTarget, ttl time.Duration) error {
+	value, err := json.Marshal(target)
+	if err != nil {
+		return err
+	}
+	return t.kv.Set(ctx, oneTimeTokenKeyPrefix+token, string(value), ttl)
+}
+
+// Redeem reads the token and then deletes it; only the request whose delete removed it may use it
+func (t kvTokenStore) Redeem(ctx context.Context, token string) (DownloadTarget, bool, error) {
+	key := oneTimeTokenKeyPrefix + token
+	value, ok, err := t.kv.Get(ctx, key)
+	if err != nil || !ok {
+		return DownloadTarget{}, false, err
+	}
+	deleted, err := t.kv.Delete(ctx, key)
+	if err != nil || !deleted {
+		return DownloadTarget{}, false, err
+	}
+
+	var target DownloadTarget
+	if err := json.Unmarshal([]byte(value), &target); err != nil {
+		return DownloadTarget{}, false, fmt.Errorf("invalid token entry: %w", err)
+	}
+	return target, true, nil
+}
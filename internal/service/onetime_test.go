@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"github.com/gofreego/mediabase/internal/kvstore/memory"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// closeCountingStore counts how often the store is closed
+type closeCountingStore struct {
+	*memory.Store
+	closed int
+}
+
+func (c *closeCountingStore) Close() error {
+	c.closed++
+	return nil
+}
+
+func TestOneTimeTokenSharedThroughKVStore(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	fake.put("media", "report.pdf", []byte("%PDF"), "application/pdf", nil)
+	kv := &closeCountingStore{Store: memory.New()}
+	cfg := testConfig()
+	issuer := NewService(ctx, &cfg, fake, WithKVStore(kv))
+	redeemer := NewService(ctx, &cfg, fake, WithKVStore(kv))
+
+	created, err := issuer.CreateOneTimeDownload(ctx, &mediabase_v1.CreateOneTimeDownloadRequest{ObjectKey: "report.pdf"})
+	if err != nil {
+		t.Fatalf("CreateOneTimeDownload: %v", err)
+	}
+	if _, err := redeemer.RedeemDownload(ctx, &mediabase_v1.RedeemDownloadRequest{Token: created.Token}); err != nil {
+		t.Fatalf("redeeming on another service sharing the store: %v", err)
+	}
+	_, err = issuer.RedeemDownload(ctx, &mediabase_v1.RedeemDownloadRequest{Token: created.Token})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("second redemption: error = %v, want NOT_FOUND", err)
+	}
+
+	// A store supplied by the caller outlives the services using it
+	issuer.Close(ctx)
+	redeemer.Close(ctx)
+	if kv.closed != 0 {
+		t.Errorf("services closed the caller's store %d times", kv.closed)
+	}
+}
+
+func TestOneTimeTokenNotRedeemedTwice(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	fake.put("media", "a.png", []byte("png"), "image/png", nil)
+	s := newTestService(t, testConfig(), fake)
+
+	_, err := s.CreateOneTimeDownload(ctx, &mediabase_v1.CreateOneTimeDownloadRequest{ObjectKey: "missing.png"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("token for a missing object: error = %v, want NOT_FOUND", err)
+	}
+
+	created, err := s.CreateOneTimeDownload(ctx, &mediabase_v1.CreateOneTimeDownloadRequest{ObjectKey: "a.png"})
+	if err != nil {
+		t.Fatalf("CreateOneTimeDownload: %v", err)
+	}
+	results := make(chan error, 10)
+	for range 10 {
+		go func() {
+			_, err := s.RedeemDownload(ctx, &mediabase_v1.RedeemDownloadRequest{Token: created.Token})
+			results <- err
+		}()
+	}
+	redeemed := 0
+	for range 10 {
+		if err := <-results; err == nil {
+			redeemed++
+		} else if status.Code(err) != codes.NotFound {
+			t.Errorf("redemption failed with %v, want NOT_FOUND", err)
+		}
+	}
+	if redeemed != 1 {
+		t.Errorf("token redeemed %d times, want once", redeemed)
+	}
+}
@@ -0,0 +1,184 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/mediabase/internal/storage"
+)
+
+const (
+	// defaultOrphanCleanupPrefix is used when OrphanCleanupConfig.Prefix is not set
+	defaultOrphanCleanupPrefix = "pending/"
+	// defaultOrphanCleanupTTL is used when OrphanCleanupConfig.TTL is not set
+	defaultOrphanCleanupTTL = 24 * time.Hour
+	// defaultOrphanCleanupInterval is used when OrphanCleanupConfig.Interval is not set
+	defaultOrphanCleanupInterval = time.Hour
+	// orphanCleanupLockKey is the key-value store key a replica claims to run a cleanup
+	orphanCleanupLockKey = "orphan-cleanup:lock"
+	// confirmedTagKey marks objects under the cleanup prefix whose upload was confirmed, or that
+	// the service wrote itself
+	confirmedTagKey = "mediabase-confirmed"
+)
+
+// OrphanCleanupConfig controls the background deletion of presigned uploads that were never
+// confirmed
+type OrphanCleanupConfig struct {
+	// Enabled periodically deletes unconfirmed objects under Prefix that are older than TTL.
+	// It needs a storage backend with object tags.
+	Enabled bool `yaml:"Enabled"`
+	// Bucket is the bucket to clean (defaults to DefaultBucket)
+	Bucket string `yaml:"Bucket"`
+	// Prefix is the key prefix presigned uploads are placed under (defaults to "pending/")
+	Prefix string `yaml:"Prefix"`
+	// TTL is how long an upload may stay unconfirmed before it is deleted (defaults to 24h)
+	TTL time.Duration `yaml:"TTL"`
+	// Interval is the time between two cleanups (defaults to 1h)
+	Interval time.Duration `yaml:"Interval"`
+}
+
+// validate rejects settings the cleanup cannot run with
+func (c OrphanCleanupConfig) validate() error {
+	if c.TTL < 0 || c.Interval < 0 {
+		return errors.New("OrphanCleanup.TTL and OrphanCleanup.Interval must not be negative")
+	}
+	return nil
+}
+
+// orphanCleaner periodically deletes unconfirmed uploads
+type orphanCleaner struct {
+	cfg  OrphanCleanupConfig
+	stop chan struct{}
+	done chan struct{}
+}
+
+// covers reports whether an object is subject to the cleanup, and so needs its upload confirmed
+func (c *orphanCleaner) covers(bucketName, objectKey string) bool {
+	return c != nil && bucketName == c.cfg.Bucket && strings.HasPrefix(objectKey, c.cfg.Prefix)
+}
+
+// startOrphanCleanup starts the cleanup loop with the defaults applied to cfg
+func (s *Service) startOrphanCleanup(cfg OrphanCleanupConfig) *orphanCleaner {
+	cfg.Prefix = cmp.Or(cfg.Prefix, defaultOrphanCleanupPrefix)
+	cfg.TTL = cmp.Or(cfg.TTL, defaultOrphanCleanupTTL)
+	cfg.Interval = cmp.Or(cfg.Interval, defaultOrphanCleanupInterval)
+
+	c := &orphanCleaner{
+		cfg:  cfg,
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+	go func() {
+		defer close(c.done)
+		ticker := time.NewTicker(cfg.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.cleanOrphans(cfg)
+			case <-c.stop:
+				return
+			}
+		}
+	}()
+	return c
+}
+
+// close stops the cleanup loop, waiting for a running cleanup to finish
+func (c *orphanCleaner) close() {
+	close(c.stop)
+	<-c.done
+}
+
+// cleanOrphans deletes the unconfirmed objects under the prefix that are older than the TTL.
+// The first service to claim the lock in the key-value store for an interval runs the cleanup.
+// Replicas only take turns when they share the store, such as Redis; with the in-memory store
+// each process claims its own lock and cleans on its own. The cleanup is bounded by the
+// lifetime of the lock, so two cleanups sharing a store never overlap.
+// The lock expires somewhat before the next tick, so a late claim does not skip an interval.
+func (s *Service) cleanOrphans(cfg OrphanCleanupConfig) {
+	lockTTL := cfg.Interval * 9 / 10
+	ctx, cancel := context.WithTimeout(context.Background(), lockTTL)
+	defer cancel()
+	ctx = withLogFields(ctx, cfg.Bucket, "")
+
+	claimed, err := s.kv.SetNX(ctx, orphanCleanupLockKey, time.Now().UTC().Format(time.RFC3339), lockTTL)
+	if err != nil {
+		logger.Error(ctx, "Failed to claim the orphan cleanup lock: %v", err)
+		return
+	}
+	if !claimed {
+		logDebug(ctx, "Orphan cleanup skipped, another replica ran it for this interval")
+		return
+	}
+
+	cutoff := time.Now().Add(-cfg.TTL)
+	var deleted, confirmed, failed int
+	for info, err := range s.storage.ListObjects(ctx, cfg.Bucket, cfg.Prefix) {
+		if err != nil {
+			logger.Error(ctx, "Failed to list objects for orphan cleanup: %v", err)
+			failed++
+			break
+		}
+		if info.LastModified.After(cutoff) {
+			continue
+		}
+
+		tags, err := s.storage.GetObjectTags(ctx, cfg.Bucket, info.Key)
+		if errors.Is(err, storage.ErrObjectNotFound) {
+			continue
+		}
+		if err != nil {
+			logger.Error(ctx, "Failed to get tags of %s for orphan cleanup: %v", info.Key, err)
+			failed++
+			continue
+		}
+		if tags[confirmedTagKey] != "" {
+			confirmed++
+			continue
+		}
+
+		if err := s.storage.DeleteObject(ctx, cfg.Bucket, info.Key); err != nil && !errors.Is(err, storage.ErrObjectNotFound) {
+			logger.Error(ctx, "Failed to delete orphaned upload %s: %v", info.Key, err)
+			failed++
+			continue
+		}
+		deleted++
+	}
+
+	logger.Info(ctx, "Orphan cleanup finished, bucket: %s, prefix: %s, deleted: %d, confirmed: %d, failed: %d", cfg.Bucket, cfg.Prefix, deleted, confirmed, failed)
+}
+
+// confirmCopy marks a copy the cleanup covers as confirmed when its source is not covered, since
+// the service wrote it itself. Copies of covered objects keep the source tags, so an unconfirmed
+// upload stays unconfirmed wherever it is copied.
+func (s *Service) confirmCopy(ctx context.Context, srcBucket, srcKey, dstBucket, dstKey string) error {
+	if !s.orphanCleanup.covers(dstBucket, s.normalizeKey(dstKey)) || s.orphanCleanup.covers(srcBucket, s.normalizeKey(srcKey)) {
+		return nil
+	}
+	tags, err := s.storage.GetObjectTags(ctx, dstBucket, dstKey)
+	if err != nil {
+		return err
+	}
+	if tags[confirmedTagKey] != "" {
+		return nil
+	}
+	return s.storage.SetObjectTags(ctx, dstBucket, dstKey, s.markConfirmed(dstBucket, dstKey, tags))
+}
+
+// markConfirmed adds the confirmation tag to the tags of an object the cleanup covers
+func (s *Service) markConfirmed(bucketName, objectKey string, tags map[string]string) map[string]string {
+	if !s.orphanCleanup.covers(bucketName, s.normalizeKey(objectKey)) {
+		return tags
+	}
+	marked := make(map[string]string, len(tags)+1)
+	for k, v := range tags {
+		marked[k] = v
+	}
+	marked[confirmedTagKey] = "true"
+	return marked
+}
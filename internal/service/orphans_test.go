@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"github.com/gofreego/mediabase/internal/kvstore/memory"
+)
+
+// orphanTestConfig cleans pending/ in media, with an interval long enough never to tick in a test
+func orphanTestConfig() Config {
+	cfg := testConfig()
+	cfg.OrphanCleanup = OrphanCleanupConfig{Enabled: true, TTL: time.Hour, Interval: 24 * time.Hour}
+	return cfg
+}
+
+// putAged stores an object last modified age ago
+func putAged(fake *fakeStorage, objectKey string, age time.Duration, tags map[string]string) {
+	fake.put("media", objectKey, []byte("data"), "image/png", nil)
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	fake.buckets["media"][objectKey].lastModified = time.Now().Add(-age)
+	fake.buckets["media"][objectKey].tags = tags
+}
+
+func TestCleanOrphansDeletesOldUnconfirmedUploads(t *testing.T) {
+	fake := newFakeStorage("media")
+	putAged(fake, "pending/old.png", 2*time.Hour, nil)
+	putAged(fake, "pending/confirmed.png", 2*time.Hour, map[string]string{confirmedTagKey: "true"})
+	putAged(fake, "pending/young.png", time.Minute, nil)
+	putAged(fake, "avatars/old.png", 2*time.Hour, nil)
+	s := newTestService(t, orphanTestConfig(), fake)
+
+	s.cleanOrphans(s.orphanCleanup.cfg)
+
+	for objectKey, want := range map[string]bool{
+		"pending/old.png":       false,
+		"pending/confirmed.png": true,
+		"pending/young.png":     true,
+		"avatars/old.png":       true,
+	} {
+		if _, ok := fake.buckets["media"][objectKey]; ok != want {
+			t.Errorf("%s kept = %v, want %v", objectKey, ok, want)
+		}
+	}
+}
+
+func TestCleanOrphansKeepsServiceWrites(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	fake.put("media", "avatars/a.png", []byte("png"), "image/png", nil)
+	putAged(fake, "pending/upload.png", 2*time.Hour, nil)
+	s := newTestService(t, orphanTestConfig(), fake)
+
+	put, err := s.PutObject(ctx, &mediabase_v1.PutObjectRequest{Path: "pending", FileName: "direct.png", ContentType: "image/png", Content: []byte("png")})
+	if err != nil {
+		t.Fatalf("PutObject: %v", err)
+	}
+	if !strings.HasPrefix(put.ObjectKey, "pending/") {
+		t.Fatalf("PutObject stored %s outside the cleanup prefix", put.ObjectKey)
+	}
+	for src, dst := range map[string]string{"avatars/a.png": "pending/copy.png", "pending/upload.png": "pending/upload-copy.png"} {
+		if _, err := s.CopyObject(ctx, &mediabase_v1.CopyObjectRequest{SourceKey: src, DestinationKey: dst}); err != nil {
+			t.Fatalf("CopyObject %s: %v", src, err)
+		}
+	}
+	for _, object := range fake.buckets["media"] {
+		object.lastModified = time.Now().Add(-2 * time.Hour)
+	}
+
+	s.cleanOrphans(s.orphanCleanup.cfg)
+
+	for objectKey, want := range map[string]bool{
+		put.ObjectKey:             true,
+		"pending/copy.png":        true,
+		"pending/upload.png":      false,
+		"pending/upload-copy.png": false,
+	} {
+		if _, ok := fake.buckets["media"][objectKey]; ok != want {
+			t.Errorf("%s kept = %v, want %v", objectKey, ok, want)
+		}
+	}
+}
+
+func TestCleanOrphansRunsOncePerIntervalOnSharedStore(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	kv := memory.New()
+	cfg := orphanTestConfig()
+	first := NewService(ctx, &cfg, fake, WithKVStore(kv))
+	defer first.Close(ctx)
+	second := NewService(ctx, &cfg, fake, WithKVStore(kv))
+	defer second.Close(ctx)
+
+	first.cleanOrphans(first.orphanCleanup.cfg)
+	second.cleanOrphans(second.orphanCleanup.cfg)
+
+	if got := fake.callCount("ListObjects"); got != 1 {
+		t.Errorf("services sharing a store listed %d times in one interval, want once", got)
+	}
+}
+
+func TestMarkConfirmedOnlyUnderPrefix(t *testing.T) {
+	s := newTestService(t, orphanTestConfig(), newFakeStorage("media"))
+	tags := map[string]string{"team": "a"}
+
+	marked := s.markConfirmed("media", "pending/a.png", tags)
+	if marked[confirmedTagKey] != "true" || marked["team"] != "a" {
+		t.Errorf("covered upload tagged %v", marked)
+	}
+	if _, ok := tags[confirmedTagKey]; ok {
+		t.Error("markConfirmed modified the caller's tags")
+	}
+	if marked := s.markConfirmed("media", "avatars/a.png", tags); marked[confirmedTagKey] != "" {
+		t.Error("upload outside the prefix was tagged")
+	}
+	if marked := s.markConfirmed("other", "pending/a.png", tags); marked[confirmedTagKey] != "" {
+		t.Error("upload to another bucket was tagged")
+	}
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListUploadedParts lists the parts already uploaded to an in-progress multipart upload,
+// so clients can resume it after a crash
+func (s *Service) ListUploadedParts(ctx context.Context, req *mediabase_v1.ListUploadedPartsRequest) (*mediabase_v1.ListUploadedPartsResponse, error) {
+	ctx = withLogFields(ctx, req.BucketName, req.ObjectKey)
+	logDebug(ctx, "ListUploadedParts request received, bucket: %s, object_key: %s, upload_id: %s", req.BucketName, req.ObjectKey, req.UploadId)
+
+	if err := s.resolveBucket(&req.BucketName); err != nil {
+		return nil, err
+	}
+
+	if req.ObjectKey == "" || req.UploadId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "object_key and upload_id are required")
+	}
+
+	parts, err := s.storage.ListUploadedParts(ctx, req.BucketName, req.ObjectKey, req.UploadId)
+	if err != nil {
+		logger.Error(ctx, "Failed to list uploaded parts: %v", err)
+		return nil, storageError("failed to list uploaded parts", err)
+	}
+
+	resp := &mediabase_v1.ListUploadedPartsResponse{
+		Parts: make([]*mediabase_v1.UploadedPart, 0, len(parts)),
+	}
+	for _, p := range parts {
+		resp.Parts = append(resp.Parts, &mediabase_v1.UploadedPart{
+			PartNumber:   int32(p.PartNumber),
+			Size:         p.Size,
+			Etag:         p.ETag,
+			LastModified: timestamppb.New(p.LastModified),
+		})
+	}
+
+	return resp, nil
+}
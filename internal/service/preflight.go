@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// postFileField is the form field presigned POST uploads carry the file in
+const postFileField = "file"
+
+// PreflightUpload validates an upload and describes the request a browser must make for it.
+// The fields are derived from a freshly generated policy, so clients do not have to guess them.
+func (s *Service) PreflightUpload(ctx context.Context, req *mediabase_v1.PreflightUploadRequest) (*mediabase_v1.PreflightUploadResponse, error) {
+	if req.Upload == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "upload is required")
+	}
+
+	ctx = withLogFields(ctx, req.Upload.BucketName, "")
+	logDebug(ctx, "PreflightUpload request received, bucket: %s, content_type: %s, origin: %s", req.Upload.BucketName, req.Upload.ContentType, req.Origin)
+
+	// The caller's request is left untouched
+	upload := proto.Clone(req.Upload).(*mediabase_v1.PresignUploadRequest)
+	upload.DryRun = false
+	upload.Deduplicate = false
+	presigned, err := s.PresignUpload(ctx, upload)
+	if err != nil {
+		return nil, err
+	}
+
+	maxFileSize := upload.MaxFileSize
+	if maxFileSize <= 0 {
+		maxFileSize = s.maxFileSizeFor(upload.ContentType)
+	}
+
+	resp := &mediabase_v1.PreflightUploadResponse{
+		Method:              http.MethodPost,
+		MaxFileSize:         maxFileSize,
+		SuccessActionStatus: http.StatusNoContent,
+		OriginAllowed:       req.Origin != "" && s.isAllowedOrigin(req.Origin),
+		AllowedMethods:      s.cors.AllowedMethods,
+	}
+	if upload.Method == mediabase_v1.UploadMethod_UPLOAD_METHOD_PUT {
+		resp.Method = http.MethodPut
+		resp.Headers = sortedKeys(presigned.Headers)
+		resp.SuccessActionStatus = http.StatusOK
+	} else {
+		resp.FormFields = sortedKeys(presigned.FormData)
+		resp.FileField = postFileField
+		switch {
+		case upload.SuccessActionRedirect != "":
+			resp.SuccessActionStatus = http.StatusSeeOther
+		case upload.SuccessActionStatus != 0:
+			resp.SuccessActionStatus = upload.SuccessActionStatus
+		}
+	}
+
+	return resp, nil
+}
+
+// validateSuccessAction checks the success_action_redirect and success_action_status of a
+// presigned POST upload. Redirects may only lead to the configured CORS origins, so a
+// presigned upload cannot be used to send browsers elsewhere.
+func (s *Service) validateSuccessAction(req *mediabase_v1.PresignUploadRequest) error {
+	if req.SuccessActionRedirect == "" && req.SuccessActionStatus == 0 {
+		return nil
+	}
+	if req.Method == mediabase_v1.UploadMethod_UPLOAD_METHOD_PUT {
+		return status.Errorf(codes.InvalidArgument, "success_action_redirect and success_action_status are only available for POST uploads")
+	}
+	if req.SuccessActionRedirect != "" && req.SuccessActionStatus != 0 {
+		return status.Errorf(codes.InvalidArgument, "success_action_redirect cannot be combined with success_action_status")
+	}
+
+	switch req.SuccessActionStatus {
+	case 0, http.StatusOK, http.StatusCreated, http.StatusNoContent:
+	default:
+		return status.Errorf(codes.InvalidArgument, "success_action_status must be 200, 201 or 204, got %d", req.SuccessActionStatus)
+	}
+
+	if req.SuccessActionRedirect != "" {
+		u, err := url.Parse(req.SuccessActionRedirect)
+		if err != nil || u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+			return status.Errorf(codes.InvalidArgument, "success_action_redirect must be an absolute http or https URL")
+		}
+		if origin := u.Scheme + "://" + u.Host; !s.isAllowedOrigin(origin) {
+			return status.Errorf(codes.InvalidArgument, "success_action_redirect origin %s is not an allowed origin", origin)
+		}
+	}
+	return nil
+}
+
+// isAllowedOrigin matches an origin against the configured CORS origins, which may contain
+// one * wildcard as in S3 CORS rules
+func (s *Service) isAllowedOrigin(origin string) bool {
+	for _, allowed := range s.cors.AllowedOrigins {
+		before, after, wildcard := strings.Cut(allowed, "*")
+		if !wildcard && allowed == origin {
+			return true
+		}
+		if wildcard && len(origin) >= len(before)+len(after) && strings.HasPrefix(origin, before) && strings.HasSuffix(origin, after) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedKeys returns the keys of a map in order
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultDeleteExpiry is the expiry of presigned delete URLs when the request sets none. It is
+// short, since the URL grants the delete to whoever holds it.
+const defaultDeleteExpiry = 5 * time.Minute
+
+// PresignDelete generates a presigned URL for a DELETE request, so clients can remove an object
+// in storage without routing the delete through the server. It is only available with
+// AllowPresignedDelete, since the delete bypasses every server-side check.
+func (s *Service) PresignDelete(ctx context.Context, req *mediabase_v1.PresignDeleteRequest) (*mediabase_v1.PresignDeleteResponse, error) {
+	ctx = withLogFields(ctx, req.BucketName, req.ObjectKey)
+	logDebug(ctx, "PresignDelete request received, bucket: %s, object_key: %s", req.BucketName, req.ObjectKey)
+
+	if !s.allowPresignedDelete {
+		return nil, status.Errorf(codes.PermissionDenied, "presigned deletes are disabled")
+	}
+	// A presigned delete removes the object for good, which would defeat the trash
+	if s.softDelete.Enabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "presigned deletes are not available with soft delete enabled")
+	}
+
+	if err := s.resolveBucket(&req.BucketName); err != nil {
+		return nil, err
+	}
+
+	expiry, err := s.presignExpiry(req.ExpiresIn, defaultDeleteExpiry)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.StatObject(ctx, req.BucketName, req.ObjectKey); err != nil {
+		return nil, err
+	}
+
+	presignedURL, err := s.storage.GeneratePresignedDeleteURL(ctx, req.BucketName, req.ObjectKey, expiry)
+	if err != nil {
+		logger.Error(ctx, "Failed to generate presigned delete URL: %v", err)
+		return nil, storageError("failed to generate presigned delete URL", err)
+	}
+	presignedURL, err = s.publicPresignedURL(presignedURL, req.BucketName)
+	if err != nil {
+		logger.Error(ctx, "Failed to move presigned delete URL to the public endpoint: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to generate presigned delete URL: %v", err)
+	}
+
+	logger.Info(ctx, "Presigned delete URL generated for object: %s, expires in: %s", req.ObjectKey, expiry)
+
+	return &mediabase_v1.PresignDeleteResponse{
+		PresignedUrl: presignedURL,
+		ExpiresIn:    int32(expiry.Seconds()),
+	}, nil
+}
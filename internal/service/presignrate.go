@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/gofreego/goutils/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PresignRateConfig caps how fast presigned upload and download URLs are issued per bucket,
+// whichever client asks for them
+type PresignRateConfig struct {
+	// Buckets maps bucket names to the presigned URLs per second they may get; buckets not listed
+	// are unlimited
+	Buckets map[string]float64 `yaml:"Buckets"`
+	// Burst is the number of URLs a bucket may get at once after being idle (defaults to its
+	// rate rounded up)
+	Burst int `yaml:"Burst"`
+}
+
+// validate rejects rates the service cannot honour
+func (c PresignRateConfig) validate() error {
+	for bucketName, rate := range c.Buckets {
+		if !(rate > 0) || math.IsInf(rate, 0) {
+			return fmt.Errorf("PresignRate.Buckets for %s must be greater than zero", bucketName)
+		}
+	}
+	if c.Burst < 0 {
+		return errors.New("PresignRate.Burst must not be negative")
+	}
+	return nil
+}
+
+// presignRateLimiter keeps a token bucket per limited bucket. Tokens are refilled at the
+// bucket's rate up to the burst, and each presigned URL takes one once its request passed
+// validation. The limits are kept per replica, so several replicas together issue up to their
+// number times the rate.
+type presignRateLimiter struct {
+	rates map[string]float64
+	burst int
+	now   func() time.Time
+
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+// tokenBucket is the tokens left for a bucket and when they were last refilled
+type tokenBucket struct {
+	tokens     float64
+	refilledAt time.Time
+}
+
+// newPresignRateLimiter returns nil when no bucket is limited
+func newPresignRateLimiter(cfg PresignRateConfig) *presignRateLimiter {
+	if len(cfg.Buckets) == 0 {
+		return nil
+	}
+	return &presignRateLimiter{
+		rates:   cfg.Buckets,
+		burst:   cfg.Burst,
+		now:     time.Now,
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+// allow takes a token for a presigned URL in the bucket, failing with RESOURCE_EXHAUSTED when
+// none is left. A nil limiter allows every URL.
+func (l *presignRateLimiter) allow(ctx context.Context, bucketName string) error {
+	if l == nil {
+		return nil
+	}
+	rate, ok := l.rates[bucketName]
+	if !ok {
+		return nil
+	}
+	burst := float64(l.burst)
+	if l.burst == 0 {
+		burst = math.Ceil(rate)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	b, ok := l.buckets[bucketName]
+	if !ok {
+		b = &tokenBucket{tokens: burst, refilledAt: now}
+		l.buckets[bucketName] = b
+	}
+	if elapsed := now.Sub(b.refilledAt); elapsed > 0 {
+		b.tokens = min(burst, b.tokens+elapsed.Seconds()*rate)
+		b.refilledAt = now
+	}
+	if b.tokens < 1 {
+		logger.Warn(ctx, "Presign rejected, bucket %s exceeded its rate of %g per second", bucketName, rate)
+		return status.Errorf(codes.ResourceExhausted, "too many presigned URLs for bucket %s, retry later", bucketName)
+	}
+	b.tokens--
+	return nil
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newTestLimiter returns a limiter whose clock only moves when the returned function is called
+func newTestLimiter(cfg PresignRateConfig) (*presignRateLimiter, func(time.Duration)) {
+	l := newPresignRateLimiter(cfg)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	l.now = func() time.Time { return now }
+	return l, func(d time.Duration) { now = now.Add(d) }
+}
+
+// allowed counts the URLs the limiter allows for a bucket out of n requests
+func allowed(l *presignRateLimiter, bucketName string, n int) int {
+	count := 0
+	for range n {
+		if l.allow(context.Background(), bucketName) == nil {
+			count++
+		}
+	}
+	return count
+}
+
+func TestPresignRateBurstAndRefill(t *testing.T) {
+	l, advance := newTestLimiter(PresignRateConfig{Buckets: map[string]float64{"media": 2}, Burst: 5})
+
+	if got := allowed(l, "media", 10); got != 5 {
+		t.Errorf("idle bucket allowed %d URLs at once, want the burst of 5", got)
+	}
+	err := l.allow(context.Background(), "media")
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("exhausted bucket: error = %v, want RESOURCE_EXHAUSTED", err)
+	}
+
+	advance(time.Second)
+	if got := allowed(l, "media", 10); got != 2 {
+		t.Errorf("after one second %d URLs allowed, want the rate of 2", got)
+	}
+
+	// Refilling stops at the burst
+	advance(time.Hour)
+	if got := allowed(l, "media", 10); got != 5 {
+		t.Errorf("after a long idle time %d URLs allowed, want the burst of 5", got)
+	}
+}
+
+func TestPresignRateDefaultBurst(t *testing.T) {
+	l, _ := newTestLimiter(PresignRateConfig{Buckets: map[string]float64{"media": 2.5}})
+
+	if got := allowed(l, "media", 10); got != 3 {
+		t.Errorf("idle bucket allowed %d URLs at once, want the rate rounded up to 3", got)
+	}
+}
+
+func TestPresignRateBucketsAreIsolated(t *testing.T) {
+	l, _ := newTestLimiter(PresignRateConfig{Buckets: map[string]float64{"hot": 1, "cold": 1}})
+
+	allowed(l, "hot", 10)
+	if got := allowed(l, "cold", 1); got != 1 {
+		t.Error("an exhausted bucket limited another one")
+	}
+	if got := allowed(l, "unlisted", 100); got != 100 {
+		t.Errorf("unlisted bucket allowed %d of 100 URLs, want all", got)
+	}
+
+	var unlimited *presignRateLimiter
+	if err := unlimited.allow(context.Background(), "hot"); err != nil {
+		t.Errorf("nil limiter: %v", err)
+	}
+}
+
+func TestPresignRateSkipsInvalidRequests(t *testing.T) {
+	ctx := context.Background()
+	cfg := testConfig()
+	cfg.PresignRate = PresignRateConfig{Buckets: map[string]float64{"media": 1}}
+	fake := newFakeStorage("media")
+	fake.put("media", "a.png", []byte("png"), "image/png", nil)
+	s := newTestService(t, cfg, fake)
+	s.presignRate.now = func() time.Time { return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) }
+
+	for range 5 {
+		_, err := s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{ContentType: "application/x-msdownload"})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("invalid upload: error = %v, want INVALID_ARGUMENT", err)
+		}
+		_, err = s.PresignDownload(ctx, &mediabase_v1.PresignDownloadRequest{ObjectKey: "a.png", ExpiresIn: -1})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("invalid download: error = %v, want INVALID_ARGUMENT", err)
+		}
+		_, err = s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{ContentType: "image/png", DryRun: true})
+		if err != nil {
+			t.Fatalf("dry run: %v", err)
+		}
+	}
+
+	// The single token is still there for a valid request, and then spent
+	if _, err := s.PresignDownload(ctx, &mediabase_v1.PresignDownloadRequest{ObjectKey: "a.png"}); err != nil {
+		t.Fatalf("valid download after rejected requests: %v", err)
+	}
+	_, err := s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{ContentType: "image/png"})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("second valid request: error = %v, want RESOURCE_EXHAUSTED", err)
+	}
+}
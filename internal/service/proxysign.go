@@ -0,0 +1,106 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// proxyDownloadPrefix is the route of the HTTP download proxy, followed by bucket and key
+	proxyDownloadPrefix = "/api/download/"
+	// minProxySecretLength is the shortest HMAC secret accepted, in bytes
+	minProxySecretLength = 32
+)
+
+// ProxyDownloadConfig signs URLs of the HTTP download proxy, which streams objects through the
+// server for clients that cannot reach storage
+type ProxyDownloadConfig struct {
+	// Secret is the HMAC key the URLs are signed with. When set, the proxy only serves signed,
+	// unexpired URLs. Replicas behind one load balancer need the same secret.
+	Secret string `yaml:"Secret"`
+	// BaseURL is the scheme and host of the HTTP server put in front of signed URLs; without it
+	// the URLs are relative
+	BaseURL string `yaml:"BaseURL"`
+}
+
+// validate rejects secrets too short to resist guessing and unusable base URLs
+func (c ProxyDownloadConfig) validate() error {
+	if c.Secret != "" && len(c.Secret) < minProxySecretLength {
+		return errors.New("ProxyDownload.Secret must be at least 32 bytes long")
+	}
+	if err := validateBaseURL(c.BaseURL); err != nil {
+		return fmt.Errorf("ProxyDownload.BaseURL: %w", err)
+	}
+	return nil
+}
+
+// proxySignature returns the HMAC of an object and expiry. The fields are separated by a byte
+// that cannot occur in bucket names, so no two objects share a signed message.
+func proxySignature(secret []byte, bucketName, objectKey, expires string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(bucketName + "\n" + expires + "\n" + objectKey))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// signProxyDownload returns a signed URL of the download proxy for an object, valid until expiry
+// has passed
+func (s *Service) signProxyDownload(bucketName, objectKey string, expiry time.Duration) string {
+	expires := strconv.FormatInt(time.Now().Add(expiry).Unix(), 10)
+	segments := strings.Split(objectKey, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	query := url.Values{
+		"expires":   {expires},
+		"signature": {proxySignature(s.proxyDownload.secret, bucketName, objectKey, expires)},
+	}
+	return s.proxyDownload.baseURL + proxyDownloadPrefix + url.PathEscape(bucketName) + "/" + strings.Join(segments, "/") + "?" + query.Encode()
+}
+
+// VerifyProxyDownload checks the signature and expiry of a download proxy request, failing with
+// PERMISSION_DENIED for unsigned, tampered or expired URLs. Without a configured secret every
+// request is allowed.
+func (s *Service) VerifyProxyDownload(bucketName, objectKey, expires, signature string) error {
+	if s.proxyDownload.secret == nil {
+		return nil
+	}
+	if expires == "" || signature == "" {
+		return status.Errorf(codes.PermissionDenied, "download URL is not signed")
+	}
+	expected := proxySignature(s.proxyDownload.secret, bucketName, objectKey, expires)
+	// The comparison takes the same time wherever the signatures differ
+	if !hmac.Equal([]byte(signature), []byte(expected)) {
+		return status.Errorf(codes.PermissionDenied, "download URL signature is invalid")
+	}
+	// The expiry is only trusted once the signature covers it
+	unix, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || !time.Now().Before(time.Unix(unix, 0)) {
+		return status.Errorf(codes.PermissionDenied, "download URL has expired")
+	}
+	return nil
+}
+
+// proxyDownloadSigner holds the parsed ProxyDownloadConfig
+type proxyDownloadSigner struct {
+	// secret is nil when the proxy serves unsigned requests
+	secret  []byte
+	baseURL string
+}
+
+func newProxyDownloadSigner(cfg ProxyDownloadConfig) proxyDownloadSigner {
+	signer := proxyDownloadSigner{baseURL: strings.TrimSuffix(cfg.BaseURL, "/")}
+	if cfg.Secret != "" {
+		signer.secret = []byte(cfg.Secret)
+	}
+	return signer
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testProxySecret = "0123456789abcdef0123456789abcdef"
+
+func proxyTestService(t *testing.T, fake *fakeStorage) *Service {
+	cfg := testConfig()
+	cfg.ProxyDownload = ProxyDownloadConfig{Secret: testProxySecret, BaseURL: "https://media.test/"}
+	return newTestService(t, cfg, fake)
+}
+
+// parseProxyURL splits a signed proxy URL into the bucket, key and query the HTTP server sees
+func parseProxyURL(t *testing.T, signed string) (bucketName, objectKey string, query url.Values) {
+	t.Helper()
+	u, err := url.Parse(signed)
+	if err != nil {
+		t.Fatalf("signed URL %q: %v", signed, err)
+	}
+	path, ok := strings.CutPrefix(u.Path, proxyDownloadPrefix)
+	if !ok {
+		t.Fatalf("signed URL %q is not under %s", signed, proxyDownloadPrefix)
+	}
+	bucketName, objectKey, _ = strings.Cut(path, "/")
+	return bucketName, objectKey, u.Query()
+}
+
+func TestProxyDownloadSignatureRoundTrip(t *testing.T) {
+	s := proxyTestService(t, newFakeStorage("media"))
+
+	signed := s.signProxyDownload("media", "users/a b/ü?.png", time.Minute)
+	if !strings.HasPrefix(signed, "https://media.test/api/download/media/users/a%20b/") {
+		t.Errorf("signed URL = %q, want it on the base URL with an escaped key", signed)
+	}
+	bucketName, objectKey, query := parseProxyURL(t, signed)
+	if objectKey != "users/a b/ü?.png" {
+		t.Errorf("key in URL = %q, want the original key", objectKey)
+	}
+	if err := s.VerifyProxyDownload(bucketName, objectKey, query.Get("expires"), query.Get("signature")); err != nil {
+		t.Errorf("signed URL rejected: %v", err)
+	}
+}
+
+func TestProxyDownloadRejectsTamperedURLs(t *testing.T) {
+	s := proxyTestService(t, newFakeStorage("media", "other"))
+	bucketName, objectKey, query := parseProxyURL(t, s.signProxyDownload("media", "a.png", time.Hour))
+	expires, signature := query.Get("expires"), query.Get("signature")
+	later := strconv.FormatInt(time.Now().Add(48*time.Hour).Unix(), 10)
+	flipped := []byte(signature)
+	flipped[0] ^= 1
+
+	for _, tc := range []struct {
+		name                                   string
+		bucketName, objectKey, expires, signed string
+	}{
+		{"other key", bucketName, "b.png", expires, signature},
+		{"key prefix", bucketName, "a.pn", expires, signature},
+		{"other bucket", "other", objectKey, expires, signature},
+		{"extended expiry", bucketName, objectKey, later, signature},
+		{"changed signature", bucketName, objectKey, expires, string(flipped)},
+		{"truncated signature", bucketName, objectKey, expires, signature[:len(signature)-1]},
+		{"missing signature", bucketName, objectKey, expires, ""},
+		{"missing expiry", bucketName, objectKey, "", signature},
+	} {
+		err := s.VerifyProxyDownload(tc.bucketName, tc.objectKey, tc.expires, tc.signed)
+		if status.Code(err) != codes.PermissionDenied {
+			t.Errorf("%s: error = %v, want PERMISSION_DENIED", tc.name, err)
+		}
+	}
+}
+
+func TestProxyDownloadRejectsExpiredURLs(t *testing.T) {
+	s := proxyTestService(t, newFakeStorage("media"))
+	// A validly signed expiry in the past, as a URL handed out earlier would carry
+	expires := strconv.FormatInt(time.Now().Add(-time.Second).Unix(), 10)
+	signature := proxySignature(s.proxyDownload.secret, "media", "a.png", expires)
+
+	err := s.VerifyProxyDownload("media", "a.png", expires, signature)
+	if status.Code(err) != codes.PermissionDenied || !strings.Contains(err.Error(), "expired") {
+		t.Errorf("error = %v, want PERMISSION_DENIED for an expired URL", err)
+	}
+}
+
+func TestProxyDownloadWithoutSecretServesUnsignedRequests(t *testing.T) {
+	s := newTestService(t, testConfig(), newFakeStorage("media"))
+
+	if err := s.VerifyProxyDownload("media", "a.png", "", ""); err != nil {
+		t.Errorf("unsigned request without a secret: %v", err)
+	}
+}
+
+func TestProxyDownloadConfigValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		cfg  ProxyDownloadConfig
+		ok   bool
+	}{
+		{"unset", ProxyDownloadConfig{}, true},
+		{"valid", ProxyDownloadConfig{Secret: testProxySecret, BaseURL: "https://media.test"}, true},
+		{"short secret", ProxyDownloadConfig{Secret: "short"}, false},
+		{"bad base URL", ProxyDownloadConfig{Secret: testProxySecret, BaseURL: "media.test"}, false},
+	} {
+		if err := tc.cfg.validate(); (err == nil) != tc.ok {
+			t.Errorf("%s: validate() = %v, want ok %v", tc.name, err, tc.ok)
+		}
+	}
+}
+
+func TestPresignDownloadProxy(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	fake.put("media", "a.png", []byte("png"), "image/png", nil)
+	s := proxyTestService(t, fake)
+
+	resp, err := s.PresignDownload(ctx, &mediabase_v1.PresignDownloadRequest{ObjectKey: "a.png", ExpiresIn: 60, Proxy: true})
+	if err != nil {
+		t.Fatalf("PresignDownload: %v", err)
+	}
+	bucketName, objectKey, query := parseProxyURL(t, resp.PresignedUrl)
+	if err := s.VerifyProxyDownload(bucketName, objectKey, query.Get("expires"), query.Get("signature")); err != nil {
+		t.Errorf("returned URL rejected: %v", err)
+	}
+	if resp.ExpiresIn != 60 {
+		t.Errorf("expires_in = %d, want 60", resp.ExpiresIn)
+	}
+	if got := fake.callCount("GeneratePresignedDownloadURL"); got != 0 {
+		t.Errorf("storage presigned %d URLs for a proxy download", got)
+	}
+
+	_, err = s.PresignDownload(ctx, &mediabase_v1.PresignDownloadRequest{ObjectKey: "missing.png", Proxy: true})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("missing object: error = %v, want NOT_FOUND", err)
+	}
+	_, err = s.PresignDownload(ctx, &mediabase_v1.PresignDownloadRequest{ObjectKey: "a.png", Proxy: true, ContentType: "image/png"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("proxy with content_type: error = %v, want INVALID_ARGUMENT", err)
+	}
+
+	unsigned := newTestService(t, testConfig(), fake)
+	_, err = unsigned.PresignDownload(ctx, &mediabase_v1.PresignDownloadRequest{ObjectKey: "a.png", Proxy: true})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("proxy without a secret: error = %v, want FAILED_PRECONDITION", err)
+	}
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"github.com/gofreego/mediabase/internal/policy"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetPublicURL returns the unsigned URL of an object whose bucket policy allows anonymous reads.
+// The URL does not expire, so it suits long-lived, cacheable links.
+func (s *Service) GetPublicURL(ctx context.Context, req *mediabase_v1.GetPublicURLRequest) (*mediabase_v1.GetPublicURLResponse, error) {
+	ctx = withLogFields(ctx, req.BucketName, req.ObjectKey)
+	logDebug(ctx, "GetPublicURL request received, bucket: %s, object_key: %s", req.BucketName, req.ObjectKey)
+
+	if err := s.resolveBucket(&req.BucketName); err != nil {
+		return nil, err
+	}
+
+	if req.ObjectKey == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "object_key is required")
+	}
+
+	if err := s.requireCapability(s.storage.Capabilities().BucketPolicy, "bucket policies"); err != nil {
+		return nil, err
+	}
+
+	bucketPolicy, err := s.storage.GetBucketPolicy(ctx, req.BucketName)
+	if err != nil {
+		logger.Error(ctx, "Failed to get bucket policy: %v", err)
+		return nil, storageError("failed to get bucket policy", err)
+	}
+	// The policy applies to the key storage sees, including the deployment prefix
+	public, err := policy.AllowsAnonymousRead(bucketPolicy, req.BucketName, s.keyPrefix+s.normalizeKey(req.ObjectKey))
+	if err != nil {
+		logger.Error(ctx, "Failed to evaluate policy of bucket %s: %v", req.BucketName, err)
+		return nil, status.Errorf(codes.Internal, "failed to evaluate bucket policy: %v", err)
+	}
+	if !public {
+		return nil, status.Errorf(codes.FailedPrecondition, "bucket %s does not allow public reads of %s", req.BucketName, req.ObjectKey)
+	}
+
+	exists, err := s.storage.ObjectExists(ctx, req.BucketName, req.ObjectKey)
+	if err != nil {
+		logger.Error(ctx, "Failed to check object existence: %v", err)
+		return nil, storageError("failed to check object existence", err)
+	}
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "object not found: %s in bucket: %s", req.ObjectKey, req.BucketName)
+	}
+
+	publicURL, err := s.storage.PublicObjectURL(ctx, req.BucketName, req.ObjectKey)
+	if err != nil {
+		logger.Error(ctx, "Failed to build public URL: %v", err)
+		return nil, storageError("failed to build public URL", err)
+	}
+	if baseURL := s.publicURLBase(); baseURL != "" {
+		publicURL, err = rebaseURL(publicURL, baseURL)
+		if err != nil {
+			logger.Error(ctx, "Failed to rebase public URL: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to build public URL: %v", err)
+		}
+	}
+
+	logDebug(ctx, "Public URL generated successfully for object: %s in bucket: %s", req.ObjectKey, req.BucketName)
+
+	return &mediabase_v1.GetPublicURLResponse{
+		Url: publicURL,
+	}, nil
+}
+
+// publicURLBase returns the base URL public object URLs are rebased onto, if any
+func (s *Service) publicURLBase() string {
+	if s.publicBaseURL != "" {
+		return s.publicBaseURL
+	}
+	if s.cdnBaseURL != "" {
+		return s.cdnBaseURL
+	}
+	return s.publicEndpoint
+}
+
+// publicPresignedURL moves a presigned URL from the endpoint it was signed for onto the
+// configured PublicEndpoint, or returns it unchanged when none is configured. A bucket
+// addressed in the host name would be lost, so virtual-hosted URLs are refused, and the
+// rewritten URL must carry exactly the query string, and with it the signature, it was signed with.
+func (s *Service) publicPresignedURL(presignedURL, bucketName string) (string, error) {
+	if s.publicEndpoint == "" {
+		return presignedURL, nil
+	}
+	signed, err := url.Parse(presignedURL)
+	if err != nil {
+		return "", err
+	}
+	if strings.HasPrefix(signed.Hostname(), bucketName+".") {
+		return "", fmt.Errorf("presigned URL addresses bucket %s in its host name, PublicEndpoint needs path-style addressing", bucketName)
+	}
+
+	rebased, err := rebaseURL(presignedURL, s.publicEndpoint)
+	if err != nil {
+		return "", err
+	}
+	rewritten, err := url.Parse(rebased)
+	if err != nil {
+		return "", err
+	}
+	if rewritten.RawQuery != signed.RawQuery {
+		return "", fmt.Errorf("rewriting the presigned URL changed its signed query string")
+	}
+	return rebased, nil
+}
+
+// rebaseURL replaces the scheme and host of a storage URL with a base URL, e.g. of a CDN
+// in front of the storage endpoint. A path in the base URL is prepended to the object path,
+// and the query string, which carries the signature of presigned URLs, is kept as is.
+func rebaseURL(storageURL, baseURL string) (string, error) {
+	u, err := url.Parse(storageURL)
+	if err != nil {
+		return "", err
+	}
+	rebased := strings.TrimSuffix(baseURL, "/") + u.EscapedPath()
+	if u.RawQuery != "" {
+		rebased += "?" + u.RawQuery
+	}
+	return rebased, nil
+}
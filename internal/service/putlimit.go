@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofreego/goutils/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PutConcurrencyConfig caps the direct and streamed uploads the service writes to storage at once,
+// so load spikes queue in the service instead of overwhelming storage
+type PutConcurrencyConfig struct {
+	// MaxInFlight is the number of uploads written at once; zero leaves them unlimited
+	MaxInFlight int `yaml:"MaxInFlight"`
+	// MaxQueued is the number of uploads that may wait for a slot. Uploads beyond it fail at once
+	// with RESOURCE_EXHAUSTED; zero rejects every upload that finds all slots taken.
+	MaxQueued int `yaml:"MaxQueued"`
+	// QueueTimeout is the longest an upload waits for a slot before failing with
+	// RESOURCE_EXHAUSTED; zero waits until the request is cancelled
+	QueueTimeout time.Duration `yaml:"QueueTimeout"`
+}
+
+// validate rejects limits the service cannot honour
+func (c PutConcurrencyConfig) validate() error {
+	if c.MaxInFlight < 0 || c.MaxQueued < 0 || c.QueueTimeout < 0 {
+		return errors.New("PutConcurrency must not be negative")
+	}
+	if c.MaxInFlight == 0 && (c.MaxQueued > 0 || c.QueueTimeout > 0) {
+		return errors.New("PutConcurrency.MaxQueued and QueueTimeout require MaxInFlight")
+	}
+	return nil
+}
+
+// putLimiter is a semaphore with a bounded wait queue. A nil limiter admits every upload.
+type putLimiter struct {
+	slots        chan struct{}
+	queued       atomic.Int64
+	maxQueued    int64
+	queueTimeout time.Duration
+}
+
+// newPutLimiter returns nil when the concurrency is unlimited
+func newPutLimiter(cfg PutConcurrencyConfig) *putLimiter {
+	if cfg.MaxInFlight == 0 {
+		return nil
+	}
+	return &putLimiter{
+		slots:        make(chan struct{}, cfg.MaxInFlight),
+		maxQueued:    int64(cfg.MaxQueued),
+		queueTimeout: cfg.QueueTimeout,
+	}
+}
+
+// acquire takes an upload slot, waiting in the queue if all are taken. The returned function
+// releases the slot and must be called once the upload has finished.
+func (l *putLimiter) acquire(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+	release := func() { <-l.slots }
+
+	select {
+	case l.slots <- struct{}{}:
+		return release, nil
+	default:
+	}
+
+	if l.queued.Add(1) > l.maxQueued {
+		l.queued.Add(-1)
+		logger.Warn(ctx, "Upload rejected, %d uploads in progress and the queue is full", cap(l.slots))
+		return nil, status.Errorf(codes.ResourceExhausted, "too many uploads in progress, retry later")
+	}
+	defer l.queued.Add(-1)
+
+	waitCtx, cancel := withTimeout(ctx, l.queueTimeout)
+	defer cancel()
+	select {
+	case l.slots <- struct{}{}:
+		return release, nil
+	case <-waitCtx.Done():
+		if err := ctx.Err(); err != nil {
+			return nil, status.FromContextError(err).Err()
+		}
+		logger.Warn(ctx, "Upload rejected after waiting %s for a slot", l.queueTimeout)
+		return nil, status.Errorf(codes.ResourceExhausted, "timed out waiting for an upload slot, retry later")
+	}
+}
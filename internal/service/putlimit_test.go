@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"github.com/gofreego/mediabase/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// gatedStorage holds every PutObject until the gate is closed and records how many ran at once
+type gatedStorage struct {
+	*fakeStorage
+	gate        chan struct{}
+	inFlight    atomic.Int64
+	maxInFlight atomic.Int64
+	started     chan struct{}
+}
+
+func newGatedStorage() *gatedStorage {
+	return &gatedStorage{
+		fakeStorage: newFakeStorage("media"),
+		gate:        make(chan struct{}),
+		started:     make(chan struct{}, 100),
+	}
+}
+
+func (g *gatedStorage) PutObject(ctx context.Context, bucketName, objectKey string, reader io.Reader, objectSize int64, contentType string, opts storage.UploadOptions) (string, error) {
+	n := g.inFlight.Add(1)
+	defer g.inFlight.Add(-1)
+	for {
+		peak := g.maxInFlight.Load()
+		if n <= peak || g.maxInFlight.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+	g.started <- struct{}{}
+	<-g.gate
+	return g.fakeStorage.PutObject(ctx, bucketName, objectKey, reader, objectSize, contentType, opts)
+}
+
+func putLimitTestService(t *testing.T, st storage.Storage, limits PutConcurrencyConfig) *Service {
+	cfg := testConfig()
+	cfg.PutConcurrency = limits
+	return newTestService(t, cfg, st)
+}
+
+func putPNG(s *Service, ctx context.Context, name string) error {
+	_, err := s.PutObject(ctx, &mediabase_v1.PutObjectRequest{ContentType: "image/png", FileName: name, Content: []byte("png")})
+	return err
+}
+
+func TestPutConcurrencyCapsUploads(t *testing.T) {
+	st := newGatedStorage()
+	s := putLimitTestService(t, st, PutConcurrencyConfig{MaxInFlight: 2, MaxQueued: 10})
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- putPNG(s, context.Background(), fmt.Sprintf("%d.png", i))
+		}()
+	}
+
+	// Two uploads reach storage and the rest queue behind them
+	<-st.started
+	<-st.started
+	time.Sleep(20 * time.Millisecond)
+	if n := st.inFlight.Load(); n != 2 {
+		t.Errorf("%d uploads in storage, want 2", n)
+	}
+	close(st.gate)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("queued upload failed: %v", err)
+		}
+	}
+	if peak := st.maxInFlight.Load(); peak != 2 {
+		t.Errorf("at most %d uploads ran at once, want 2", peak)
+	}
+	if n := len(st.buckets["media"]); n != 8 {
+		t.Errorf("%d objects stored, want 8", n)
+	}
+}
+
+func TestPutConcurrencyRejectsBeyondQueue(t *testing.T) {
+	st := newGatedStorage()
+	s := putLimitTestService(t, st, PutConcurrencyConfig{MaxInFlight: 1})
+
+	done := make(chan error)
+	go func() { done <- putPNG(s, context.Background(), "a.png") }()
+	<-st.started
+
+	if err := putPNG(s, context.Background(), "b.png"); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("upload with every slot taken: error = %v, want RESOURCE_EXHAUSTED", err)
+	}
+	close(st.gate)
+	if err := <-done; err != nil {
+		t.Errorf("first upload: %v", err)
+	}
+	// The slot is free again
+	if err := putPNG(s, context.Background(), "c.png"); err != nil {
+		t.Errorf("upload after the slot was released: %v", err)
+	}
+}
+
+func TestPutLimiterQueue(t *testing.T) {
+	ctx := context.Background()
+	l := newPutLimiter(PutConcurrencyConfig{MaxInFlight: 1, MaxQueued: 1, QueueTimeout: 20 * time.Millisecond})
+	release, err := l.acquire(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A queued upload gives up after the queue timeout
+	start := time.Now()
+	if _, err := l.acquire(ctx); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("queue timeout: error = %v, want RESOURCE_EXHAUSTED", err)
+	}
+	if waited := time.Since(start); waited < 20*time.Millisecond {
+		t.Errorf("gave up after %s, want it to wait for the queue timeout", waited)
+	}
+
+	// A cancelled request leaves the queue with its own status
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := l.acquire(cancelled); status.Code(err) != codes.Canceled {
+		t.Errorf("cancelled while queued: error = %v, want CANCELED", err)
+	}
+
+	// A queued upload gets the slot once it is released
+	acquired := make(chan error)
+	go func() {
+		next, err := l.acquire(ctx)
+		if err == nil {
+			next()
+		}
+		acquired <- err
+	}()
+	for l.queued.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	// The queue holds one upload, so another is turned away at once
+	if _, err := l.acquire(ctx); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("full queue: error = %v, want RESOURCE_EXHAUSTED", err)
+	}
+	release()
+	if err := <-acquired; err != nil {
+		t.Errorf("queued upload after the release: %v", err)
+	}
+}
+
+func TestPutConcurrencyConfigValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		cfg  PutConcurrencyConfig
+		ok   bool
+	}{
+		{"unlimited", PutConcurrencyConfig{}, true},
+		{"queued", PutConcurrencyConfig{MaxInFlight: 4, MaxQueued: 16, QueueTimeout: time.Second}, true},
+		{"negative", PutConcurrencyConfig{MaxInFlight: -1}, false},
+		{"queue without limit", PutConcurrencyConfig{MaxQueued: 4}, false},
+	} {
+		if err := tc.cfg.validate(); (err == nil) != tc.ok {
+			t.Errorf("%s: validate() = %v, want ok %v", tc.name, err, tc.ok)
+		}
+	}
+}
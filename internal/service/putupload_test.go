@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"github.com/gofreego/mediabase/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestConfirmUploadEnforcesPutConstraints(t *testing.T) {
+	ctx := context.Background()
+	for _, tc := range []struct {
+		name        string
+		req         *mediabase_v1.PresignUploadRequest
+		data        []byte
+		contentType string
+		want        codes.Code
+	}{
+		{"exact size", &mediabase_v1.PresignUploadRequest{ContentType: "image/png", MaxFileSize: 4, Method: mediabase_v1.UploadMethod_UPLOAD_METHOD_PUT}, []byte("1234"), "image/png", codes.OK},
+		{"other size", &mediabase_v1.PresignUploadRequest{ContentType: "image/png", MaxFileSize: 4, Method: mediabase_v1.UploadMethod_UPLOAD_METHOD_PUT}, []byte("123"), "image/png", codes.InvalidArgument},
+		{"other content type", &mediabase_v1.PresignUploadRequest{ContentType: "image/png", MaxFileSize: 4, Method: mediabase_v1.UploadMethod_UPLOAD_METHOD_PUT}, []byte("1234"), "text/html", codes.InvalidArgument},
+		{"fallback within the limit", &mediabase_v1.PresignUploadRequest{ContentType: "image/png", MaxFileSize: 4}, []byte("12"), "image/png", codes.OK},
+		{"fallback above the limit", &mediabase_v1.PresignUploadRequest{ContentType: "image/png", MaxFileSize: 4}, []byte("12345"), "image/png", codes.InvalidArgument},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			fake := newFakeStorage("media")
+			fake.errs["GeneratePresignedUploadURL"] = fmt.Errorf("%w: presigned POST uploads", storage.ErrNotSupported)
+			s := newTestService(t, testConfig(), fake)
+
+			presigned, err := s.PresignUpload(ctx, tc.req)
+			if err != nil {
+				t.Fatalf("PresignUpload: %v", err)
+			}
+			// Storage that signs no headers takes whatever the client sends
+			fake.put("media", presigned.ObjectKey, tc.data, tc.contentType, nil)
+
+			_, err = s.ConfirmUpload(ctx, &mediabase_v1.ConfirmUploadRequest{ObjectKey: presigned.ObjectKey})
+			if status.Code(err) != tc.want {
+				t.Fatalf("ConfirmUpload error = %v, want %s", err, tc.want)
+			}
+			if _, err := fake.object("media", presigned.ObjectKey); (err == nil) != (tc.want == codes.OK) {
+				t.Errorf("object kept = %v, want it kept only when confirmed", err == nil)
+			}
+		})
+	}
+}
+
+func TestConfirmUploadIgnoresPostUploads(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	s := newTestService(t, testConfig(), fake)
+
+	presigned, err := s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{ContentType: "image/png", MaxFileSize: 4})
+	if err != nil {
+		t.Fatalf("PresignUpload: %v", err)
+	}
+	// POST policies are enforced by storage, so nothing is recorded to check
+	fake.put("media", presigned.ObjectKey, []byte("12345"), "text/plain", nil)
+	if _, err := s.ConfirmUpload(ctx, &mediabase_v1.ConfirmUploadRequest{ObjectKey: presigned.ObjectKey}); err != nil {
+		t.Errorf("ConfirmUpload: %v", err)
+	}
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/mediabase/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultQuotaRefreshInterval is used when Quota.RefreshInterval is not set
+const defaultQuotaRefreshInterval = 5 * time.Minute
+
+// QuotaConfig caps the total size of objects stored per bucket
+type QuotaConfig struct {
+	// Buckets maps bucket names to their quota in bytes; buckets not listed are unlimited
+	Buckets map[string]int64 `yaml:"Buckets"`
+	// RefreshInterval is how often bucket usage is recounted from storage (defaults to 5m)
+	RefreshInterval time.Duration `yaml:"RefreshInterval"`
+}
+
+// quotaManager tracks bucket usage to enforce quotas. Usage is counted by listing the bucket,
+// which is exact but expensive, so the count is reused until RefreshInterval passes.
+// In between, uploads the service completes are added to the count, each object once. Deletes,
+// uploads that bypass the service and presigned uploads replacing an object only show up at the
+// next recount, so usage may be over- or underestimated until then. Checks hold nothing back,
+// so concurrent uploads can together exceed a quota.
+type quotaManager struct {
+	storage         storage.Storage
+	quotas          map[string]int64
+	refreshInterval time.Duration
+	now             func() time.Time
+
+	mu    sync.Mutex
+	usage map[string]*bucketUsage
+}
+
+// bucketUsage is the counted size of a bucket and when it was last recounted
+type bucketUsage struct {
+	bytes     int64
+	countedAt time.Time
+	// recorded holds the sizes of the objects added to bytes since the recount
+	recorded map[string]int64
+}
+
+func newQuotaManager(store storage.Storage, cfg QuotaConfig) *quotaManager {
+	interval := cfg.RefreshInterval
+	if interval == 0 {
+		interval = defaultQuotaRefreshInterval
+	}
+	return &quotaManager{
+		storage:         store,
+		quotas:          cfg.Buckets,
+		refreshInterval: interval,
+		now:             time.Now,
+		usage:           make(map[string]*bucketUsage),
+	}
+}
+
+// tracks reports whether the bucket has a quota
+func (q *quotaManager) tracks(bucketName string) bool {
+	_, ok := q.quotas[bucketName]
+	return ok
+}
+
+// check reports whether adding size bytes keeps the bucket within its quota
+func (q *quotaManager) check(ctx context.Context, bucketName string, size int64) error {
+	quota, ok := q.quotas[bucketName]
+	if !ok {
+		return nil
+	}
+
+	used, err := q.used(ctx, bucketName)
+	if err != nil {
+		return err
+	}
+	if used+size > quota {
+		return status.Errorf(codes.ResourceExhausted, "bucket %s quota exceeded: %d bytes used, %d requested, quota is %d", bucketName, used, size, quota)
+	}
+	return nil
+}
+
+// record adds a completed upload of size bytes to the bucket usage. replaced is the size of the
+// object it overwrote, if known. An object recorded before, or last modified before the recount
+// and so already counted, only adds the change of its size, so repeated confirmations of an
+// upload count it once.
+func (q *quotaManager) record(bucketName, objectKey string, size, replaced int64, modifiedAt time.Time) {
+	if !q.tracks(bucketName) {
+		return
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	u, ok := q.usage[bucketName]
+	if !ok {
+		return
+	}
+	switch previous, recorded := u.recorded[objectKey]; {
+	case recorded:
+		u.bytes += size - previous
+	case modifiedAt.Before(u.countedAt):
+	default:
+		u.bytes += size - replaced
+	}
+	u.recorded[objectKey] = size
+}
+
+// used returns the bucket usage, recounting it from storage when the count is stale
+func (q *quotaManager) used(ctx context.Context, bucketName string) (int64, error) {
+	q.mu.Lock()
+	if u, ok := q.usage[bucketName]; ok && q.now().Sub(u.countedAt) < q.refreshInterval {
+		q.mu.Unlock()
+		return u.bytes, nil
+	}
+	q.mu.Unlock()
+
+	// Counting lists the whole bucket, so it runs without holding the lock
+	bytes, err := q.storage.BucketUsage(ctx, bucketName)
+	if err != nil {
+		logger.Error(ctx, "Failed to count bucket usage: %v", err)
+		return 0, storageError("failed to count bucket usage", err)
+	}
+
+	q.mu.Lock()
+	q.usage[bucketName] = &bucketUsage{bytes: bytes, countedAt: q.now(), recorded: make(map[string]int64)}
+	q.mu.Unlock()
+
+	logDebug(ctx, "Bucket usage counted: %s, bytes: %d", bucketName, bytes)
+	return bytes, nil
+}
+
+// replacedSize returns the size of the object an upload is about to overwrite, so the quota only
+// counts the difference. It is only looked up for buckets with a quota.
+func (s *Service) replacedSize(ctx context.Context, bucketName, objectKey string) int64 {
+	if !s.quotas.tracks(bucketName) {
+		return 0
+	}
+	info, err := s.storage.StatObject(ctx, bucketName, objectKey)
+	if err != nil {
+		if !errors.Is(err, storage.ErrObjectNotFound) {
+			logger.Warn(ctx, "Failed to stat %s before overwriting it, its size stays counted until the next recount: %v", objectKey, err)
+		}
+		return 0
+	}
+	return info.Size
+}
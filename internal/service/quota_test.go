@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// usage returns the counted usage of a bucket
+func usage(t *testing.T, q *quotaManager, bucketName string) int64 {
+	t.Helper()
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	u, ok := q.usage[bucketName]
+	if !ok {
+		t.Fatalf("usage of %s was never counted", bucketName)
+	}
+	return u.bytes
+}
+
+func TestQuotaRecordCountsEachObjectOnce(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	fake.put("media", "old.png", make([]byte, 100), "image/png", nil)
+	q := newQuotaManager(fake, QuotaConfig{Buckets: map[string]int64{"media": 1000}})
+
+	if err := q.check(ctx, "media", 0); err != nil {
+		t.Fatal(err)
+	}
+	after := time.Now().Add(time.Second)
+
+	// Objects modified before the count are already in it
+	q.record("media", "old.png", 100, 0, time.Now().Add(-time.Hour))
+	if got := usage(t, q, "media"); got != 100 {
+		t.Errorf("usage after recording a counted object = %d, want 100", got)
+	}
+
+	q.record("media", "new.png", 50, 0, after)
+	q.record("media", "new.png", 50, 0, after)
+	if got := usage(t, q, "media"); got != 150 {
+		t.Errorf("usage after recording an object twice = %d, want 150", got)
+	}
+
+	// Overwrites add the change of size
+	q.record("media", "new.png", 20, 50, after)
+	if got := usage(t, q, "media"); got != 120 {
+		t.Errorf("usage after shrinking an object = %d, want 120", got)
+	}
+	q.record("media", "old.png", 300, 100, after)
+	if got := usage(t, q, "media"); got != 320 {
+		t.Errorf("usage after growing a counted object = %d, want 320", got)
+	}
+
+	// Buckets without a quota are not tracked
+	q.record("other", "a.png", 50, 0, after)
+	if _, ok := q.usage["other"]; ok {
+		t.Error("usage of a bucket without quota was tracked")
+	}
+}
+
+func TestQuotaCheck(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	fake.put("media", "a.png", make([]byte, 600), "image/png", nil)
+	q := newQuotaManager(fake, QuotaConfig{Buckets: map[string]int64{"media": 1000}})
+	now := time.Now()
+	q.now = func() time.Time { return now }
+
+	if err := q.check(ctx, "media", 400); err != nil {
+		t.Errorf("check within the quota: %v", err)
+	}
+	if err := q.check(ctx, "media", 401); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("check over the quota: error = %v, want RESOURCE_EXHAUSTED", err)
+	}
+	if err := q.check(ctx, "unlimited", 1<<40); err != nil {
+		t.Errorf("check of a bucket without quota: %v", err)
+	}
+
+	// The count is reused until it is stale
+	fake.put("media", "b.png", make([]byte, 400), "image/png", nil)
+	q.check(ctx, "media", 0)
+	if got := fake.callCount("BucketUsage"); got != 1 {
+		t.Errorf("bucket counted %d times within the refresh interval, want once", got)
+	}
+	now = now.Add(defaultQuotaRefreshInterval)
+	if err := q.check(ctx, "media", 1); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("check after the recount: error = %v, want RESOURCE_EXHAUSTED", err)
+	}
+}
+
+func quotaTestService(t *testing.T, fake *fakeStorage, quota int64) *Service {
+	cfg := testConfig()
+	cfg.Quota = QuotaConfig{Buckets: map[string]int64{"media": quota}}
+	return newTestService(t, cfg, fake)
+}
+
+func TestConfirmUploadRecordsUsageOnce(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	s := quotaTestService(t, fake, 1<<20)
+	if err := s.quotas.check(ctx, "media", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	fake.put("media", "upload.png", make([]byte, 100), "image/png", nil)
+	for range 3 {
+		if _, err := s.ConfirmUpload(ctx, &mediabase_v1.ConfirmUploadRequest{ObjectKey: "upload.png"}); err != nil {
+			t.Fatalf("ConfirmUpload: %v", err)
+		}
+	}
+	if got := usage(t, s.quotas, "media"); got != 100 {
+		t.Errorf("usage after three confirmations = %d, want 100", got)
+	}
+}
+
+func TestPutObjectOverwriteRecordsChange(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	s := quotaTestService(t, fake, 1<<20)
+	put := func(size int) {
+		t.Helper()
+		_, err := s.PutObject(ctx, &mediabase_v1.PutObjectRequest{FileName: "a.png", ContentType: "image/png", Content: make([]byte, size)})
+		if err != nil {
+			t.Fatalf("PutObject: %v", err)
+		}
+	}
+
+	put(100)
+	put(100)
+	put(40)
+	if got := usage(t, s.quotas, "media"); got != 40 {
+		t.Errorf("usage after overwriting one object = %d, want its size 40", got)
+	}
+}
+
+func TestPresignDeduplicatedUploadSkipsQuota(t *testing.T) {
+	ctx := context.Background()
+	content := []byte("stored content")
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+	fake := newFakeStorage("media")
+	fake.put("media", checksum+".png", content, "image/png", nil)
+	// The bucket is full, so no new upload fits
+	s := quotaTestService(t, fake, int64(len(content)))
+
+	resp, err := s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{ContentType: "image/png", ChecksumSha256: checksum, Deduplicate: true})
+	if err != nil {
+		t.Fatalf("deduplicated upload in a full bucket: %v", err)
+	}
+	if !resp.Deduplicated {
+		t.Error("stored content was not reused")
+	}
+
+	_, err = s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{ContentType: "image/png"})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("new upload in a full bucket: error = %v, want RESOURCE_EXHAUSTED", err)
+	}
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+
+	"github.com/gofreego/goutils/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// scanObject streams an object to the malware scanner and deletes it if it is infected
+func (s *Service) scanObject(ctx context.Context, bucketName, objectKey string) error {
+	reader, err := s.storage.GetObject(ctx, bucketName, objectKey)
+	if err != nil {
+		logger.Error(ctx, "Failed to get object: %v", err)
+		return storageError("failed to get object", err)
+	}
+	defer reader.Close()
+
+	result, err := s.scanner.Scan(ctx, reader)
+	if err != nil {
+		logger.Error(ctx, "Failed to scan object %s: %v", objectKey, err)
+		return storageError("failed to scan object", err)
+	}
+	if !result.Infected {
+		return nil
+	}
+
+	logger.Warn(ctx, "Malware %s found in %s in bucket: %s, deleting it", result.Signature, objectKey, bucketName)
+	if err := s.storage.DeleteObject(ctx, bucketName, objectKey); err != nil {
+		logger.Error(ctx, "Failed to delete infected object %s: %v", objectKey, err)
+	}
+	return status.Errorf(codes.FailedPrecondition, "object %s is infected with %s and was deleted", objectKey, result.Signature)
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/mediabase/internal/storage"
+	"github.com/google/uuid"
+)
+
+// defaultSelfTestTimeout is used when SelfTest.Timeout is not set
+const defaultSelfTestTimeout = 30 * time.Second
+
+// selfTestKeyPrefix is where round-trip checks write their probe objects
+const selfTestKeyPrefix = ".mediabase-selftest/"
+
+// SelfTestConfig controls the startup check of the configured buckets
+type SelfTestConfig struct {
+	// Enabled checks the default bucket and the allowed buckets when a server starts, and stops
+	// the server if one of them is inaccessible
+	Enabled bool `yaml:"Enabled"`
+	// RoundTrip additionally writes, reads back and deletes a small object in every bucket, which
+	// needs write and delete permissions
+	RoundTrip bool `yaml:"RoundTrip"`
+	// Timeout bounds the whole self-test (defaults to 30s)
+	Timeout time.Duration `yaml:"Timeout"`
+}
+
+// SelfTest checks that the default bucket and every allowed bucket exist and are accessible with
+// the configured credentials, so misconfiguration shows up before traffic is served. Missing
+// buckets are only reported when AutoCreateBucket is off, since they are created on first use
+// otherwise. It does nothing unless enabled.
+func (s *Service) SelfTest(ctx context.Context) error {
+	if !s.selfTest.Enabled {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.selfTest.Timeout)
+	defer cancel()
+
+	buckets := s.selfTestBuckets()
+	if len(buckets) == 0 {
+		logger.Warn(ctx, "Self-test skipped: neither DefaultBucket nor AllowedBuckets is configured")
+		return nil
+	}
+
+	for _, bucket := range buckets {
+		if err := s.checkBucket(ctx, bucket); err != nil {
+			logger.Error(ctx, "Self-test failed for bucket %s: %v", bucket, err)
+			return fmt.Errorf("self-test failed for bucket %s: %w", bucket, err)
+		}
+	}
+
+	logger.Info(ctx, "Self-test passed for buckets: %v, round trip: %v", buckets, s.selfTest.RoundTrip)
+	return nil
+}
+
+// selfTestBuckets returns the configured buckets in a stable order, the default bucket first
+func (s *Service) selfTestBuckets() []string {
+	var buckets []string
+	if s.defaultBucket != "" {
+		buckets = append(buckets, s.defaultBucket)
+	}
+	allowed := make([]string, 0, len(s.allowedBuckets))
+	for bucket := range s.allowedBuckets {
+		if bucket != s.defaultBucket {
+			allowed = append(allowed, bucket)
+		}
+	}
+	slices.Sort(allowed)
+	return append(buckets, allowed...)
+}
+
+// checkBucket checks that a bucket exists and, if configured, that objects round-trip through it
+func (s *Service) checkBucket(ctx context.Context, bucketName string) error {
+	exists, err := s.storage.BucketExists(ctx, bucketName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if s.autoCreateBucket {
+			logger.Warn(ctx, "Self-test: bucket %s does not exist yet and will be created on first upload", bucketName)
+			return nil
+		}
+		return storage.ErrBucketNotFound
+	}
+
+	if s.selfTest.RoundTrip {
+		if err := s.roundTrip(ctx, bucketName); err != nil {
+			return err
+		}
+	}
+
+	logger.Info(ctx, "Self-test: bucket %s is accessible", bucketName)
+	return nil
+}
+
+// roundTrip writes a small probe object, reads it back and deletes it
+func (s *Service) roundTrip(ctx context.Context, bucketName string) error {
+	key := selfTestKeyPrefix + uuid.NewString()
+	payload := []byte("mediabase self-test " + key)
+
+	if _, err := s.storage.PutObject(ctx, bucketName, key, bytes.NewReader(payload), int64(len(payload)), "text/plain", storage.UploadOptions{}); err != nil {
+		return fmt.Errorf("failed to write probe object: %w", err)
+	}
+	// The probe is removed even if reading it back fails
+	defer func() {
+		if err := s.storage.DeleteObject(context.WithoutCancel(ctx), bucketName, key); err != nil {
+			logger.Error(ctx, "Self-test: failed to delete probe object %s from bucket %s: %v", key, bucketName, err)
+		}
+	}()
+
+	reader, err := s.storage.GetObject(ctx, bucketName, key)
+	if err != nil {
+		return fmt.Errorf("failed to read probe object: %w", err)
+	}
+	defer reader.Close()
+
+	got, err := io.ReadAll(reader)
+	if err != nil {
+		return fmt.Errorf("failed to read probe object: %w", err)
+	}
+	if !bytes.Equal(got, payload) {
+		return errors.New("probe object was read back with different content")
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/gofreego/mediabase/internal/storage"
+)
+
+func selfTestService(t *testing.T, fake *fakeStorage, selfTest SelfTestConfig, allowed ...string) *Service {
+	cfg := testConfig()
+	cfg.SelfTest = selfTest
+	cfg.AllowedBuckets = allowed
+	return newTestService(t, cfg, fake)
+}
+
+func TestSelfTestDisabledDoesNothing(t *testing.T) {
+	fake := newFakeStorage()
+	s := selfTestService(t, fake, SelfTestConfig{})
+
+	if err := s.SelfTest(context.Background()); err != nil {
+		t.Errorf("disabled self-test: %v", err)
+	}
+	if len(fake.calls) != 0 {
+		t.Errorf("disabled self-test called storage: %v", fake.calls)
+	}
+}
+
+func TestSelfTestChecksConfiguredBuckets(t *testing.T) {
+	fake := newFakeStorage("media", "uploads", "archive")
+	s := selfTestService(t, fake, SelfTestConfig{Enabled: true}, "archive", "media", "uploads")
+
+	if err := s.SelfTest(context.Background()); err != nil {
+		t.Fatalf("SelfTest: %v", err)
+	}
+	if got := fake.callCount("BucketExists"); got != 3 {
+		t.Errorf("%d buckets checked, want each configured bucket once", got)
+	}
+	if got := s.selfTestBuckets(); strings.Join(got, ",") != "media,archive,uploads" {
+		t.Errorf("buckets checked in order %v, want the default bucket first", got)
+	}
+	if got := fake.callCount("PutObject"); got != 0 {
+		t.Errorf("%d objects written without the round trip enabled", got)
+	}
+}
+
+func TestSelfTestFailsOnInaccessibleBucket(t *testing.T) {
+	ctx := context.Background()
+
+	missing := selfTestService(t, newFakeStorage("media"), SelfTestConfig{Enabled: true}, "media", "uploads")
+	err := missing.SelfTest(ctx)
+	if !errors.Is(err, storage.ErrBucketNotFound) || !strings.Contains(err.Error(), "uploads") {
+		t.Errorf("missing bucket: error = %v, want ErrBucketNotFound naming the bucket", err)
+	}
+
+	denied := newFakeStorage("media")
+	denied.failWith("BucketExists", storage.ErrAccessDenied)
+	if err := selfTestService(t, denied, SelfTestConfig{Enabled: true}).SelfTest(ctx); !errors.Is(err, storage.ErrAccessDenied) {
+		t.Errorf("denied bucket: error = %v, want ErrAccessDenied", err)
+	}
+
+	// Missing buckets are fine when they are created on first use
+	cfg := testConfig()
+	cfg.SelfTest = SelfTestConfig{Enabled: true}
+	cfg.AutoCreateBucket = true
+	if err := newTestService(t, cfg, newFakeStorage()).SelfTest(ctx); err != nil {
+		t.Errorf("missing bucket with AutoCreateBucket: %v", err)
+	}
+}
+
+func TestSelfTestRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	s := selfTestService(t, fake, SelfTestConfig{Enabled: true, RoundTrip: true})
+
+	if err := s.SelfTest(ctx); err != nil {
+		t.Fatalf("SelfTest: %v", err)
+	}
+	for _, method := range []string{"PutObject", "GetObject", "DeleteObject"} {
+		if got := fake.callCount(method); got != 1 {
+			t.Errorf("%s called %d times, want once", method, got)
+		}
+	}
+	if len(fake.buckets["media"]) != 0 {
+		t.Errorf("probe objects left behind: %d", len(fake.buckets["media"]))
+	}
+
+	// A failed read back fails the self-test and still removes the probe
+	fake.failWith("GetObject", storage.ErrAccessDenied)
+	if err := s.SelfTest(ctx); !errors.Is(err, storage.ErrAccessDenied) {
+		t.Errorf("unreadable probe: error = %v, want ErrAccessDenied", err)
+	}
+	if len(fake.buckets["media"]) != 0 {
+		t.Error("probe object kept after a failed read back")
+	}
+}
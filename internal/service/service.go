@@ -1,34 +1,794 @@
 package service
 
 import (
+	"cmp"
 	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net/url"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
 
+	"github.com/gofreego/goutils/logger"
 	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"github.com/gofreego/mediabase/internal/kvstore"
+	"github.com/gofreego/mediabase/internal/kvstore/memory"
+	"github.com/gofreego/mediabase/internal/kvstore/redis"
+	"github.com/gofreego/mediabase/internal/policy"
+	"github.com/gofreego/mediabase/internal/scanner"
+	"github.com/gofreego/mediabase/internal/scanner/clamav"
 	"github.com/gofreego/mediabase/internal/storage"
+	"github.com/gofreego/mediabase/internal/storage/keycase"
+	"github.com/gofreego/mediabase/internal/storage/prefix"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Config struct {
 	StorageConfig       storage.Config
 	MaxFileSize         int64    `yaml:"MaxFileSize"`
 	AllowedContentTypes []string `yaml:"AllowedContentTypes"`
+	// MinFileSize is the smallest file size presigned uploads accept, so empty placeholder files
+	// are rejected by storage (defaults to 0)
+	MinFileSize int64 `yaml:"MinFileSize"`
+	// MaxFileSizeByContentType lowers the MaxFileSize cap for individual content types
+	MaxFileSizeByContentType map[string]int64 `yaml:"MaxFileSizeByContentType"`
+	// ContentTypeAliases maps content types clients send onto the canonical ones checked against
+	// AllowedContentTypes, e.g. image/jpg: image/jpeg. It extends the built-in aliases.
+	ContentTypeAliases map[string]string `yaml:"ContentTypeAliases"`
+	// DefaultContentType is used for uploads that declare no content type. It must be allowed;
+	// without it such uploads are rejected.
+	DefaultContentType string `yaml:"DefaultContentType"`
+	// AutoDeleteOnDownloadPrefixes lists object key prefixes whose objects are deleted
+	// after they have been fully streamed by the download endpoint (one-time files)
+	AutoDeleteOnDownloadPrefixes []string    `yaml:"AutoDeleteOnDownloadPrefixes"`
+	Image                        ImageConfig `yaml:"Image"`
+	CORS                         CORSConfig  `yaml:"CORS"`
+	// KeyStrategy selects how generated object keys are named: uuid (default), date or content-hash
+	KeyStrategy string `yaml:"KeyStrategy"`
+	// KeyTemplate is a text/template layout for object keys, e.g. "{{.Tenant}}/{{.Path}}/{{.UUID}}{{.Ext}}",
+	// used instead of KeyStrategy
+	KeyTemplate string `yaml:"KeyTemplate"`
+	// CollisionStrategy decides what happens when a presigned upload's file_name is already taken:
+	// overwrite (default), fail or auto-suffix
+	CollisionStrategy string `yaml:"CollisionStrategy"`
+	// ExtensionMismatch decides what happens when a presigned upload's file_name has an extension
+	// of another content type than the declared one: reject (default) or warn
+	ExtensionMismatch string `yaml:"ExtensionMismatch"`
+	// FileName restricts the length and characters of caller-supplied file names
+	FileName FileNameConfig `yaml:"FileName"`
+	// KeyNamespace is the UUID namespace keys are derived in from idempotency keys. Deployments
+	// sharing a bucket can use different namespaces to keep their derived keys apart.
+	KeyNamespace string `yaml:"KeyNamespace"`
+	// KeyPrefix places every object of this deployment under a fixed key prefix, e.g. "staging/",
+	// so environments can share a bucket. Clients never send or see it.
+	KeyPrefix string `yaml:"KeyPrefix"`
+	// KeyCase normalizes the case of object keys, for downstream systems that ignore it: lower
+	// lower-cases whole keys, extension only their extensions. Empty (default) keeps keys as sent.
+	KeyCase string `yaml:"KeyCase"`
+	// MaxObjectKeyLength caps the UTF-8 byte length of object keys (defaults to and may not exceed 1024)
+	MaxObjectKeyLength int `yaml:"MaxObjectKeyLength"`
+	// RequiredMetadata lists application metadata every presigned upload must lock into its policy,
+	// so storage rejects uploads that do not carry it
+	RequiredMetadata []RequiredMetadataConfig `yaml:"RequiredMetadata"`
+	// AdminSubjects lists the client certificate common names allowed to call admin RPCs such as
+	// UpdateCredentials. Admin RPCs need mutual TLS and are rejected for everyone when it is empty.
+	AdminSubjects []string `yaml:"AdminSubjects"`
+	// AllowedBuckets restricts requests to these buckets; any other bucket is rejected with
+	// PERMISSION_DENIED before reaching storage. Empty allows every bucket.
+	AllowedBuckets []string `yaml:"AllowedBuckets"`
+	// DefaultBucket is used by object requests that leave bucket_name empty
+	DefaultBucket string `yaml:"DefaultBucket"`
+	// AutoCreateBucket creates missing buckets on the first presigned upload to them
+	AutoCreateBucket bool `yaml:"AutoCreateBucket"`
+	// BucketCacheTTL is how long a bucket known to exist is trusted without asking storage (defaults to 5m)
+	BucketCacheTTL time.Duration `yaml:"BucketCacheTTL"`
+	// AccessLog records presigned downloads, downloads and deletes as structured log entries
+	AccessLog bool `yaml:"AccessLog"`
+	// Quota caps the total size of objects stored per bucket
+	Quota QuotaConfig `yaml:"Quota"`
+	// PresignRate caps how fast presigned upload and download URLs are issued per bucket
+	PresignRate PresignRateConfig `yaml:"PresignRate"`
+	// MaxObjectRetention caps how far in the future an object retention may end (defaults to 10 years)
+	MaxObjectRetention time.Duration `yaml:"MaxObjectRetention"`
+	// CDNBaseURL replaces the scheme and host of presigned download and public object URLs,
+	// so clients download through a CDN in front of storage
+	CDNBaseURL string `yaml:"CDNBaseURL"`
+	// PublicBaseURL replaces the scheme and host of public object URLs, taking precedence over CDNBaseURL
+	PublicBaseURL string `yaml:"PublicBaseURL"`
+	// PublicEndpoint replaces the scheme and host of every presigned URL after signing, for
+	// deployments where clients reach storage under another address than the service does.
+	// CDNBaseURL and PublicBaseURL take precedence for the URLs they cover.
+	PublicEndpoint string `yaml:"PublicEndpoint"`
+	// Scan checks presigned uploads for malware when they are confirmed
+	Scan scanner.Config `yaml:"Scan"`
+	// FailDeleteIfMissing makes DeleteObject return NOT_FOUND for objects that do not exist instead
+	// of reporting success, as if every request set fail_if_missing
+	FailDeleteIfMissing bool `yaml:"FailDeleteIfMissing"`
+	// AllowPresignedDelete enables PresignDelete. Presigned deletes go straight to storage, so they
+	// bypass soft delete, idempotency keys and access logging, and anyone holding the URL can use it.
+	AllowPresignedDelete bool `yaml:"AllowPresignedDelete"`
+	// AllowDebugHeader lets single requests turn on debug logging with the x-mediabase-debug header,
+	// independent of the global log level. Anyone who can call the service can then raise the log volume.
+	AllowDebugHeader bool `yaml:"AllowDebugHeader"`
+	// SoftDelete moves deleted objects to a trash prefix from which they can be restored
+	SoftDelete SoftDeleteConfig `yaml:"SoftDelete"`
+	// BucketStatsTimeout bounds how long GetBucketStats lists objects before reporting a truncated
+	// result (defaults to 30s)
+	BucketStatsTimeout time.Duration `yaml:"BucketStatsTimeout"`
+	// MaxScanObjects caps how many objects a single GetBucketStats or FindObjectsByTag scan iterates
+	// before reporting a truncated result, whatever max_objects the request asks for (defaults to 1,000,000)
+	MaxScanObjects int64 `yaml:"MaxScanObjects"`
+	// TagSearch bounds the scans of FindObjectsByTag
+	TagSearch TagSearchConfig `yaml:"TagSearch"`
+	// MaxPresignExpiry caps the expiry clients may request for presigned URLs (defaults to 7 days,
+	// the S3 limit). Lower it for backends with a shorter limit.
+	MaxPresignExpiry time.Duration `yaml:"MaxPresignExpiry"`
+	// DownloadChunkSize is the size in bytes of the content chunks streamed by GetObject (defaults to 64KB).
+	// It must stay below the gRPC MaxSendMsgSize.
+	DownloadChunkSize int `yaml:"DownloadChunkSize"`
+	// MaxPresignBatchSize caps the number of uploads in one PresignUploadBatch request (defaults to 100)
+	MaxPresignBatchSize int `yaml:"MaxPresignBatchSize"`
+	// MaxExistsBatchSize caps the number of keys in one BatchObjectExists request (defaults to 1000)
+	MaxExistsBatchSize int `yaml:"MaxExistsBatchSize"`
+	// ExistsBatchWorkers is the number of keys of a BatchObjectExists request checked at once (defaults to 8)
+	ExistsBatchWorkers int `yaml:"ExistsBatchWorkers"`
+	// ReadAfterWrite retries lookups of just-uploaded objects on eventually-consistent storage
+	ReadAfterWrite ReadAfterWriteConfig `yaml:"ReadAfterWrite"`
+	// StorageTimeouts bounds single storage calls per operation class
+	StorageTimeouts StorageTimeoutConfig `yaml:"StorageTimeouts"`
+	// OneTimeDownload controls tokens that can be redeemed once for a download URL
+	OneTimeDownload OneTimeDownloadConfig `yaml:"OneTimeDownload"`
+	// ProxyDownload signs URLs of the HTTP download proxy
+	ProxyDownload ProxyDownloadConfig `yaml:"ProxyDownload"`
+	// IdempotencyWindow is how long the response of a DeleteObject or CopyObject request with an
+	// idempotency key is replayed to retries (defaults to 24h)
+	IdempotencyWindow time.Duration `yaml:"IdempotencyWindow"`
+	// PutConcurrency caps the direct and streamed uploads written to storage at once
+	PutConcurrency PutConcurrencyConfig `yaml:"PutConcurrency"`
+	// SelfTest checks the configured buckets when a server starts
+	SelfTest SelfTestConfig `yaml:"SelfTest"`
+	// KVStore holds state shared between replicas, such as one-time download tokens (defaults to memory)
+	KVStore kvstore.Config `yaml:"KVStore"`
+	// OrphanCleanup periodically deletes presigned uploads that were never confirmed
+	OrphanCleanup OrphanCleanupConfig `yaml:"OrphanCleanup"`
+}
+
+// defaultBucketCacheTTL is used when BucketCacheTTL is not set
+const defaultBucketCacheTTL = 5 * time.Minute
+
+// defaultDownloadChunkSize is used when DownloadChunkSize is not set
+const defaultDownloadChunkSize = 64 * 1024
+
+// maxS3ObjectKeyLength is the longest object key S3 accepts, in UTF-8 bytes
+const maxS3ObjectKeyLength = 1024
+
+// CORSConfig holds the default CORS rule applied to buckets created with CORS enabled
+type CORSConfig struct {
+	// AllowedOrigins lists origins allowed to access buckets from a browser
+	AllowedOrigins []string `yaml:"AllowedOrigins"`
+	// AllowedMethods lists allowed HTTP methods (defaults to GET, PUT, POST and HEAD)
+	AllowedMethods []string `yaml:"AllowedMethods"`
+	// AllowedHeaders lists request headers allowed in preflight requests (defaults to all)
+	AllowedHeaders []string `yaml:"AllowedHeaders"`
+	// ExposeHeaders lists response headers readable by browsers (defaults to ETag)
+	ExposeHeaders []string `yaml:"ExposeHeaders"`
+	// MaxAgeSeconds is how long browsers may cache preflight responses
+	MaxAgeSeconds int `yaml:"MaxAgeSeconds"`
+}
+
+// ImageConfig controls server-side image processing
+type ImageConfig struct {
+	// ConversionSourceTypes lists content types that may be converted
+	ConversionSourceTypes []string `yaml:"ConversionSourceTypes"`
+	// ConversionTargetTypes lists content types that conversions may produce
+	ConversionTargetTypes []string `yaml:"ConversionTargetTypes"`
+	// MaxPixels caps width*height of images decoded by the server (0 means unlimited)
+	MaxPixels int64 `yaml:"MaxPixels"`
 }
 
 type Service struct {
-	storage             storage.Storage
-	maxFileSize         int64
-	allowedContentTypes map[string]bool
+	storage                      storage.Storage
+	maxFileSize                  int64
+	minFileSize                  int64
+	maxFileSizeByContentType     map[string]int64
+	allowedContentTypes          map[string]bool
+	contentTypeAliases           map[string]string
+	defaultContentType           string
+	autoDeleteOnDownloadPrefixes []string
+	conversionSourceTypes        map[string]bool
+	conversionTargetTypes        map[string]bool
+	maxImagePixels               int64
+	cors                         CORSConfig
+	keyGenerator                 KeyGenerator
+	keyPrefix                    string
+	keyCase                      string
+	collisionStrategy            string
+	extensionMismatch            string
+	fileNames                    fileNamePolicy
+	maxObjectKeyLength           int
+	defaultBucket                string
+	allowedBuckets               map[string]bool
+	adminSubjects                map[string]bool
+	requiredMetadata             []RequiredMetadataConfig
+	autoCreateBucket             bool
+	buckets                      *bucketCache
+	accessLogger                 AccessLogger
+	accessLog                    *asyncAccessLogger
+	quotas                       *quotaManager
+	puts                         *putLimiter
+	presignRate                  *presignRateLimiter
+	maxObjectRetention           time.Duration
+	readAfterWrite               ReadAfterWriteConfig
+	proxyDownload                proxyDownloadSigner
+	maxPresignBatchSize          int
+	maxExistsBatchSize           int
+	existsBatchWorkers           int
+	downloadChunkSize            int
+	maxPresignExpiry             time.Duration
+	bucketStatsTimeout           time.Duration
+	maxScanObjects               int64
+	tagSearch                    TagSearchConfig
+	softDelete                   SoftDeleteConfig
+	failDeleteIfMissing          bool
+	allowPresignedDelete         bool
+	allowDebugHeader             bool
+	oneTimeDownload              OneTimeDownloadConfig
+	kv                           kvstore.KVStore
+	ownsKV                       bool
+	orphanCleanup                *orphanCleaner
+	idempotencyWindow            time.Duration
+	selfTest                     SelfTestConfig
+	tokens                       TokenStore
+	publicBaseURL                string
+	cdnBaseURL                   string
+	publicEndpoint               string
+	scanner                      scanner.Scanner
+	activeStreams                atomic.Int64
 	mediabase_v1.UnimplementedMediabaseServiceServer
 }
 
-func NewService(ctx context.Context, cfg *Config, storageProvider storage.Storage) *Service {
-	allowedMap := make(map[string]bool)
-	for _, ct := range cfg.AllowedContentTypes {
-		allowedMap[ct] = true
+// Validate checks the config for values that would make the service reject every upload
+// or accept unbounded ones
+func (c *Config) Validate() error {
+	if c.MaxFileSize <= 0 {
+		return errors.New("MaxFileSize must be greater than zero")
+	}
+	if c.MinFileSize < 0 || c.MinFileSize > c.MaxFileSize {
+		return errors.New("MinFileSize must be between zero and MaxFileSize")
+	}
+	if len(c.AllowedContentTypes) == 0 {
+		return errors.New("AllowedContentTypes must not be empty")
+	}
+	for _, contentType := range c.AllowedContentTypes {
+		if _, _, err := mime.ParseMediaType(contentType); err != nil {
+			return fmt.Errorf("AllowedContentTypes contains an invalid content type %q: %w", contentType, err)
+		}
+	}
+	aliases := contentTypeAliases(c.ContentTypeAliases)
+	if err := validateContentTypeAliases(aliases); err != nil {
+		return err
+	}
+	if c.DefaultContentType != "" {
+		defaultType := canonicalContentType(c.DefaultContentType, aliases)
+		if !slices.Contains(normalizeContentTypes(c.AllowedContentTypes, aliases), defaultType) {
+			return fmt.Errorf("DefaultContentType %s must be one of AllowedContentTypes", c.DefaultContentType)
+		}
+	}
+	for contentType, size := range c.MaxFileSizeByContentType {
+		if size <= 0 {
+			return fmt.Errorf("MaxFileSizeByContentType for %s must be greater than zero", contentType)
+		}
+		if size < c.MinFileSize {
+			return fmt.Errorf("MaxFileSizeByContentType for %s must not be below MinFileSize", contentType)
+		}
+	}
+	if c.Image.MaxPixels < 0 {
+		return errors.New("Image.MaxPixels must not be negative")
+	}
+	if _, err := c.keyNamespace(); err != nil {
+		return err
+	}
+	if _, err := newKeyGenerator(c.KeyStrategy, defaultKeyNamespace); err != nil {
+		return err
+	}
+	if c.KeyTemplate != "" {
+		if c.KeyStrategy != "" {
+			return errors.New("KeyTemplate cannot be combined with KeyStrategy")
+		}
+		if _, err := newTemplateKeyGenerator(c.KeyTemplate, defaultKeyNamespace); err != nil {
+			return err
+		}
+	}
+	switch c.KeyCase {
+	case "", keycase.Lower, keycase.Extension:
+	default:
+		return fmt.Errorf("unknown key case: %s", c.KeyCase)
+	}
+	// Prefixes are matched against normalized keys, so upper-case ones would never match
+	if c.KeyCase == keycase.Lower {
+		prefixes := append([]string{c.SoftDelete.TrashPrefix, c.OrphanCleanup.Prefix}, c.AutoDeleteOnDownloadPrefixes...)
+		for _, prefix := range prefixes {
+			if prefix != strings.ToLower(prefix) {
+				return fmt.Errorf("key prefix %q must be lower case while KeyCase is %s", prefix, keycase.Lower)
+			}
+		}
+	}
+	if err := validateCollisionStrategy(c.CollisionStrategy); err != nil {
+		return err
+	}
+	if err := validateExtensionMismatch(c.ExtensionMismatch); err != nil {
+		return err
+	}
+	if err := c.FileName.validate(); err != nil {
+		return err
+	}
+	if c.MaxObjectKeyLength < 0 || c.MaxObjectKeyLength > maxS3ObjectKeyLength {
+		return fmt.Errorf("MaxObjectKeyLength must be between 0 and %d", maxS3ObjectKeyLength)
+	}
+	if !utf8.ValidString(c.KeyPrefix) || strings.HasPrefix(c.KeyPrefix, "/") {
+		return errors.New("KeyPrefix must be valid UTF-8 and must not start with a slash")
+	}
+	maxKeyLength := c.MaxObjectKeyLength
+	if maxKeyLength == 0 {
+		maxKeyLength = maxS3ObjectKeyLength
+	}
+	if len(c.KeyPrefix) >= maxKeyLength {
+		return fmt.Errorf("KeyPrefix must be shorter than %d bytes", maxKeyLength)
+	}
+	if c.BucketCacheTTL < 0 {
+		return errors.New("BucketCacheTTL must not be negative")
+	}
+	for bucketName, quota := range c.Quota.Buckets {
+		if quota <= 0 {
+			return fmt.Errorf("Quota.Buckets for %s must be greater than zero", bucketName)
+		}
+	}
+	if c.Quota.RefreshInterval < 0 {
+		return errors.New("Quota.RefreshInterval must not be negative")
+	}
+	if err := c.PresignRate.validate(); err != nil {
+		return err
+	}
+	if c.MaxObjectRetention < 0 {
+		return errors.New("MaxObjectRetention must not be negative")
+	}
+	if err := validateBaseURL(c.PublicBaseURL); err != nil {
+		return fmt.Errorf("PublicBaseURL: %w", err)
+	}
+	if err := validateBaseURL(c.CDNBaseURL); err != nil {
+		return fmt.Errorf("CDNBaseURL: %w", err)
+	}
+	if err := validateBaseURL(c.PublicEndpoint); err != nil {
+		return fmt.Errorf("PublicEndpoint: %w", err)
+	}
+	if c.Scan.Timeout < 0 {
+		return errors.New("Scan.Timeout must not be negative")
+	}
+	if c.SoftDelete.TrashPrefix != "" && !strings.HasSuffix(c.SoftDelete.TrashPrefix, "/") {
+		return errors.New("SoftDelete.TrashPrefix must end with a slash")
+	}
+	if c.BucketStatsTimeout < 0 {
+		return errors.New("BucketStatsTimeout must not be negative")
+	}
+	if c.MaxScanObjects < 0 {
+		return errors.New("MaxScanObjects must not be negative")
 	}
+	if err := c.TagSearch.validate(); err != nil {
+		return err
+	}
+	if c.MaxPresignExpiry < 0 {
+		return errors.New("MaxPresignExpiry must not be negative")
+	}
+	if c.MaxPresignExpiry != 0 && c.MaxPresignExpiry < time.Second {
+		return errors.New("MaxPresignExpiry must be at least one second")
+	}
+	if c.DownloadChunkSize < 0 {
+		return errors.New("DownloadChunkSize must not be negative")
+	}
+	if c.MaxPresignBatchSize < 0 {
+		return errors.New("MaxPresignBatchSize must not be negative")
+	}
+	if c.MaxExistsBatchSize < 0 || c.ExistsBatchWorkers < 0 {
+		return errors.New("MaxExistsBatchSize and ExistsBatchWorkers must not be negative")
+	}
+	if c.ReadAfterWrite.Attempts < 0 {
+		return errors.New("ReadAfterWrite.Attempts must not be negative")
+	}
+	if c.ReadAfterWrite.Interval < 0 {
+		return errors.New("ReadAfterWrite.Interval must not be negative")
+	}
+	if err := c.StorageTimeouts.validate(); err != nil {
+		return err
+	}
+	if err := c.OneTimeDownload.validate(); err != nil {
+		return err
+	}
+	if err := c.ProxyDownload.validate(); err != nil {
+		return err
+	}
+	if err := c.PutConcurrency.validate(); err != nil {
+		return err
+	}
+	if c.SelfTest.Timeout < 0 {
+		return errors.New("SelfTest.Timeout must not be negative")
+	}
+	if c.IdempotencyWindow < 0 {
+		return errors.New("IdempotencyWindow must not be negative")
+	}
+	if err := c.KVStore.Validate(); err != nil {
+		return err
+	}
+	if maxExpiry := cmp.Or(c.MaxPresignExpiry, defaultMaxPresignExpiry); c.OneTimeDownload.URLExpiry > maxExpiry {
+		return fmt.Errorf("OneTimeDownload.URLExpiry must not exceed the maximum presign expiry %s", maxExpiry)
+	}
+	if c.DefaultBucket != "" {
+		if err := policy.ValidateBucketName(c.DefaultBucket); err != nil {
+			return fmt.Errorf("DefaultBucket: %w", err)
+		}
+		if len(c.AllowedBuckets) > 0 && !slices.Contains(c.AllowedBuckets, c.DefaultBucket) {
+			return fmt.Errorf("DefaultBucket %s is not in AllowedBuckets", c.DefaultBucket)
+		}
+	}
+	for _, bucketName := range c.AllowedBuckets {
+		if err := policy.ValidateBucketName(bucketName); err != nil {
+			return fmt.Errorf("AllowedBuckets: %w", err)
+		}
+	}
+	if err := validateRequiredMetadataConfig(c.RequiredMetadata); err != nil {
+		return err
+	}
+	if err := validateCORSMethods(c.CORS.AllowedMethods); err != nil {
+		return fmt.Errorf("CORS.AllowedMethods: %w", err)
+	}
+	if err := c.OrphanCleanup.validate(); err != nil {
+		return err
+	}
+	if c.OrphanCleanup.Enabled {
+		bucketName := cmp.Or(c.OrphanCleanup.Bucket, c.DefaultBucket)
+		if bucketName == "" {
+			return errors.New("OrphanCleanup.Bucket is required when no default bucket is configured")
+		}
+		if err := policy.ValidateBucketName(bucketName); err != nil {
+			return fmt.Errorf("OrphanCleanup.Bucket: %w", err)
+		}
+	}
+	return nil
+}
+
+// keyNamespace parses KeyNamespace, falling back to the default namespace
+func (c *Config) keyNamespace() (uuid.UUID, error) {
+	if c.KeyNamespace == "" {
+		return defaultKeyNamespace, nil
+	}
+	namespace, err := uuid.Parse(c.KeyNamespace)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("KeyNamespace must be a UUID: %w", err)
+	}
+	return namespace, nil
+}
+
+// Option customizes a Service
+type Option func(*Service)
+
+// WithScanner checks confirmed uploads with a custom malware scanner instead of ClamAV
+func WithScanner(sc scanner.Scanner) Option {
+	return func(s *Service) {
+		s.scanner = sc
+	}
+}
+
+// WithKeyGenerator replaces the configured object key naming strategy
+func WithKeyGenerator(g KeyGenerator) Option {
+	return func(s *Service) {
+		s.keyGenerator = g
+	}
+}
+
+func NewService(ctx context.Context, cfg *Config, storageProvider storage.Storage, opts ...Option) *Service {
+	if err := cfg.Validate(); err != nil {
+		logger.Panic(ctx, "invalid service config: %v", err)
+	}
+	aliases := contentTypeAliases(cfg.ContentTypeAliases)
+	allowedMap := toSet(normalizeContentTypes(cfg.AllowedContentTypes, aliases))
+
+	keyNamespace, err := cfg.keyNamespace()
+	if err != nil {
+		logger.Panic(ctx, "invalid service config: %v", err)
+	}
+	var keyGenerator KeyGenerator
+	if cfg.KeyTemplate != "" {
+		keyGenerator, err = newTemplateKeyGenerator(cfg.KeyTemplate, keyNamespace)
+	} else {
+		keyGenerator, err = newKeyGenerator(cfg.KeyStrategy, keyNamespace)
+	}
+	if err != nil {
+		logger.Panic(ctx, "invalid service config: %v", err)
+	}
+
+	// With a key prefix, the service only ever sees keys relative to it
+	if cfg.KeyPrefix != "" {
+		storageProvider = prefix.New(storageProvider, cfg.KeyPrefix)
+	}
+	// Normalizing every key storage sees keeps lookups from missing on case alone
+	if cfg.KeyCase != "" {
+		storageProvider = keycase.New(storageProvider, cfg.KeyCase)
+	}
+	if cfg.StorageTimeouts.enabled() {
+		storageProvider = &timeoutStorage{Storage: storageProvider, timeouts: cfg.StorageTimeouts}
+	}
+
+	s := &Service{
+		storage:                      storageProvider,
+		maxFileSize:                  cfg.MaxFileSize,
+		minFileSize:                  cfg.MinFileSize,
+		maxFileSizeByContentType:     cfg.MaxFileSizeByContentType,
+		allowedContentTypes:          allowedMap,
+		contentTypeAliases:           aliases,
+		defaultContentType:           cfg.DefaultContentType,
+		autoDeleteOnDownloadPrefixes: cfg.AutoDeleteOnDownloadPrefixes,
+		conversionSourceTypes:        toSet(cfg.Image.ConversionSourceTypes),
+		conversionTargetTypes:        toSet(cfg.Image.ConversionTargetTypes),
+		maxImagePixels:               cfg.Image.MaxPixels,
+		cors:                         withCORSDefaults(cfg.CORS),
+		keyGenerator:                 keyGenerator,
+		keyPrefix:                    cfg.KeyPrefix,
+		keyCase:                      cfg.KeyCase,
+		collisionStrategy:            cfg.CollisionStrategy,
+		extensionMismatch:            cfg.ExtensionMismatch,
+		fileNames:                    newFileNamePolicy(cfg.FileName),
+		maxObjectKeyLength:           cfg.MaxObjectKeyLength,
+		defaultBucket:                cfg.DefaultBucket,
+		allowedBuckets:               toSet(cfg.AllowedBuckets),
+		adminSubjects:                toSet(cfg.AdminSubjects),
+		requiredMetadata:             cfg.RequiredMetadata,
+		autoCreateBucket:             cfg.AutoCreateBucket,
+		buckets:                      newBucketCache(cfg.BucketCacheTTL),
+		quotas:                       newQuotaManager(storageProvider, cfg.Quota),
+		puts:                         newPutLimiter(cfg.PutConcurrency),
+		presignRate:                  newPresignRateLimiter(cfg.PresignRate),
+		maxObjectRetention:           cmp.Or(cfg.MaxObjectRetention, defaultMaxObjectRetention),
+		readAfterWrite:               cfg.ReadAfterWrite,
+		proxyDownload:                newProxyDownloadSigner(cfg.ProxyDownload),
+		maxPresignBatchSize:          cfg.MaxPresignBatchSize,
+		maxExistsBatchSize:           cfg.MaxExistsBatchSize,
+		existsBatchWorkers:           cfg.ExistsBatchWorkers,
+		downloadChunkSize:            cfg.DownloadChunkSize,
+		maxPresignExpiry:             cfg.MaxPresignExpiry,
+		bucketStatsTimeout:           cfg.BucketStatsTimeout,
+		maxScanObjects:               cfg.MaxScanObjects,
+		tagSearch:                    cfg.TagSearch.withDefaults(),
+		softDelete:                   cfg.SoftDelete,
+		failDeleteIfMissing:          cfg.FailDeleteIfMissing,
+		allowPresignedDelete:         cfg.AllowPresignedDelete,
+		allowDebugHeader:             cfg.AllowDebugHeader,
+		oneTimeDownload:              cfg.OneTimeDownload,
+		idempotencyWindow:            cfg.IdempotencyWindow,
+		selfTest:                     cfg.SelfTest,
+		publicBaseURL:                cfg.PublicBaseURL,
+		cdnBaseURL:                   cfg.CDNBaseURL,
+		publicEndpoint:               cfg.PublicEndpoint,
+	}
+	if s.selfTest.Timeout == 0 {
+		s.selfTest.Timeout = defaultSelfTestTimeout
+	}
+	if s.idempotencyWindow == 0 {
+		s.idempotencyWindow = defaultIdempotencyWindow
+	}
+	if s.oneTimeDownload.TokenTTL == 0 {
+		s.oneTimeDownload.TokenTTL = defaultOneTimeTokenTTL
+	}
+	if s.oneTimeDownload.URLExpiry == 0 {
+		s.oneTimeDownload.URLExpiry = defaultOneTimeURLExpiry
+	}
+	if s.softDelete.TrashPrefix == "" {
+		s.softDelete.TrashPrefix = defaultTrashPrefix
+	}
+	if s.maxPresignBatchSize == 0 {
+		s.maxPresignBatchSize = defaultMaxPresignBatchSize
+	}
+	if s.maxExistsBatchSize == 0 {
+		s.maxExistsBatchSize = defaultMaxExistsBatchSize
+	}
+	if s.existsBatchWorkers == 0 {
+		s.existsBatchWorkers = defaultExistsBatchWorkers
+	}
+	if s.bucketStatsTimeout == 0 {
+		s.bucketStatsTimeout = defaultBucketStatsTimeout
+	}
+	if s.maxScanObjects == 0 {
+		s.maxScanObjects = defaultMaxScanObjects
+	}
+	if s.maxPresignExpiry == 0 {
+		s.maxPresignExpiry = defaultMaxPresignExpiry
+	}
+	if s.downloadChunkSize == 0 {
+		s.downloadChunkSize = defaultDownloadChunkSize
+	}
+	if s.readAfterWrite.Interval == 0 {
+		s.readAfterWrite.Interval = defaultReadAfterWriteInterval
+	}
+	if s.maxObjectKeyLength == 0 {
+		s.maxObjectKeyLength = maxS3ObjectKeyLength
+	}
+	if cfg.AccessLog {
+		s.accessLogger = jsonAccessLogger{}
+	}
+	if cfg.Scan.Enabled {
+		s.scanner = clamav.NewClamAV(cfg.Scan)
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.accessLogger != nil {
+		s.accessLog = newAsyncAccessLogger(s.accessLogger)
+	}
+	// The configured store is only opened when no option supplied one, so it is never leaked
+	if s.kv == nil {
+		s.kv = newKVStore(cfg.KVStore)
+		s.ownsKV = true
+	}
+	if s.tokens == nil {
+		s.tokens = kvTokenStore{kv: s.kv}
+	}
+	if cfg.OrphanCleanup.Enabled {
+		// Confirmations are recorded as object tags, which the cleanup must be able to read
+		if !s.storage.Capabilities().ObjectTagging {
+			logger.Panic(ctx, "invalid service config: OrphanCleanup needs a storage backend with object tags")
+		}
+		// Each process holds its own in-memory lock, so replicas would all clean every interval
+		if s.ownsKV && cfg.KVStore.Type != kvstore.TypeRedis {
+			logger.Warn(ctx, "OrphanCleanup uses the in-memory KVStore, so every replica cleans on its own; use the redis store to coordinate replicas")
+		}
+		cleanup := cfg.OrphanCleanup
+		cleanup.Bucket = cmp.Or(cleanup.Bucket, cfg.DefaultBucket)
+		s.orphanCleanup = s.startOrphanCleanup(cleanup)
+	}
+	return s
+}
+
+// WithKVStore keeps shared state in a custom store instead of the configured one. The store
+// is not closed by the service, so one store can back several services.
+func WithKVStore(kv kvstore.KVStore) Option {
+	return func(s *Service) {
+		s.kv = kv
+	}
+}
+
+// newKVStore opens the configured key-value store
+func newKVStore(cfg kvstore.Config) kvstore.KVStore {
+	if cfg.Type == kvstore.TypeRedis {
+		return redis.New(cfg.Redis)
+	}
+	return memory.New()
+}
+
+// Close flushes pending access events and stops the orphan cleanup. It should be called once
+// the servers have stopped.
+func (s *Service) Close(ctx context.Context) {
+	if s.orphanCleanup != nil {
+		s.orphanCleanup.close()
+	}
+	if s.accessLog != nil {
+		s.accessLog.close(ctx)
+	}
+	if s.ownsKV {
+		if err := s.kv.Close(); err != nil {
+			logger.Error(ctx, "Failed to close key-value store: %v", err)
+		}
+	}
+}
+
+// withCORSDefaults fills in the methods and headers browsers need for presigned uploads and downloads
+func withCORSDefaults(cfg CORSConfig) CORSConfig {
+	if len(cfg.AllowedMethods) == 0 {
+		cfg.AllowedMethods = []string{"GET", "PUT", "POST", "HEAD"}
+	}
+	if len(cfg.AllowedHeaders) == 0 {
+		cfg.AllowedHeaders = []string{"*"}
+	}
+	if len(cfg.ExposeHeaders) == 0 {
+		cfg.ExposeHeaders = []string{"ETag"}
+	}
+	return cfg
+}
+
+// generateObjectKey names a new object with the configured key generator, on behalf of the caller
+func (s *Service) generateObjectKey(ctx context.Context, in KeyInput) (string, error) {
+	in.Tenant = subjectFromContext(ctx)
+	key, err := s.keyGenerator.GenerateKey(in)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "failed to generate object key: %v", err)
+	}
+	// Callers get the key as it is stored
+	key = s.normalizeKey(key)
+	if err := s.validateObjectKey(key); err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+// normalizeKey returns an object key with the case storage sees it in, relative to the key prefix
+func (s *Service) normalizeKey(objectKey string) string {
+	return keycase.Normalize(s.keyCase, objectKey)
+}
+
+// validateObjectKey rejects keys that storage would refuse: invalid UTF-8 or longer than the
+// configured limit, which is measured in bytes so multibyte names reach it sooner.
+// The key prefix counts towards the limit, since storage sees the key with it.
+func (s *Service) validateObjectKey(key string) error {
+	if !utf8.ValidString(key) {
+		return status.Errorf(codes.InvalidArgument, "object key is not valid UTF-8")
+	}
+	if maxLength := s.maxObjectKeyLength - len(s.keyPrefix); len(key) > maxLength {
+		return status.Errorf(codes.InvalidArgument, "object key is %d bytes long, at most %d are allowed", len(key), maxLength)
+	}
+	return nil
+}
+
+// maxFileSizeFor returns the tightest size limit for a content type: its override if one is
+// configured and lower than the global limit, otherwise the global limit
+func (s *Service) maxFileSizeFor(contentType string) int64 {
+	if limit, ok := s.maxFileSizeByContentType[contentType]; ok && limit > 0 && limit < s.maxFileSize {
+		return limit
+	}
+	return s.maxFileSize
+}
+
+// maxFileSizeForPrefix returns the max file size for uploads whose content type starts with a
+// prefix, which is the tightest limit of any content type the prefix admits
+func (s *Service) maxFileSizeForPrefix(prefix string) int64 {
+	limit := s.maxFileSize
+	for contentType, typeLimit := range s.maxFileSizeByContentType {
+		if strings.HasPrefix(contentType, prefix) && typeLimit > 0 && typeLimit < limit {
+			limit = typeLimit
+		}
+	}
+	return limit
+}
+
+// ActiveStreams returns the number of streaming uploads and downloads in progress,
+// so servers can report what is still running when a shutdown drain times out
+func (s *Service) ActiveStreams() int64 {
+	return s.activeStreams.Load()
+}
+
+// trackStream counts a streaming operation as active until the returned function is called
+func (s *Service) trackStream() func() {
+	s.activeStreams.Add(1)
+	return func() {
+		s.activeStreams.Add(-1)
+	}
+}
+
+// validateBaseURL checks that an optional base URL is absolute and can take an object path
+func validateBaseURL(baseURL string) error {
+	if baseURL == "" {
+		return nil
+	}
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("%q must be an absolute http or https URL", baseURL)
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return fmt.Errorf("%q must not have a query or fragment", baseURL)
+	}
+	return nil
+}
 
-	return &Service{
-		storage:             storageProvider,
-		maxFileSize:         cfg.MaxFileSize,
-		allowedContentTypes: allowedMap,
+// toSet builds a lookup set from a list of strings
+func toSet(values []string) map[string]bool {
+	set := make(map[string]bool)
+	for _, v := range values {
+		set[v] = true
 	}
+	return set
 }
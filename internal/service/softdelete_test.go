@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"github.com/gofreego/mediabase/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func softDeleteTestService(t *testing.T, fake *fakeStorage) *Service {
+	cfg := testConfig()
+	cfg.SoftDelete = SoftDeleteConfig{Enabled: true}
+	return newTestService(t, cfg, fake)
+}
+
+func TestSoftDeleteRestoreRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	fake.put("media", "users/a.png", []byte("png"), "image/png", map[string]string{"owner": "alice"})
+	s := softDeleteTestService(t, fake)
+
+	if _, err := s.DeleteObject(ctx, &mediabase_v1.DeleteObjectRequest{ObjectKey: "users/a.png"}); err != nil {
+		t.Fatalf("DeleteObject: %v", err)
+	}
+	if _, err := fake.object("media", "users/a.png"); err == nil {
+		t.Error("deleted object still at its key")
+	}
+	trashed, err := fake.object("media", "trash/users/a.png")
+	if err != nil {
+		t.Fatalf("deleted object not in the trash: %v", err)
+	}
+	if trashed.metadata[storage.OriginalKeyMetadataKey] != "users/a.png" || trashed.metadata["owner"] != "alice" {
+		t.Errorf("trash metadata %v, want the original key and the object's metadata", trashed.metadata)
+	}
+
+	resp, err := s.RestoreObject(ctx, &mediabase_v1.RestoreObjectRequest{ObjectKey: "users/a.png"})
+	if err != nil {
+		t.Fatalf("RestoreObject: %v", err)
+	}
+	if resp.ObjectKey != "users/a.png" || resp.Size != 3 {
+		t.Errorf("restored %q of %d bytes, want users/a.png of 3", resp.ObjectKey, resp.Size)
+	}
+	restored, err := fake.object("media", "users/a.png")
+	if err != nil {
+		t.Fatalf("object not restored: %v", err)
+	}
+	if string(restored.data) != "png" || restored.contentType != "image/png" {
+		t.Errorf("restored %q as %s, want the original content", restored.data, restored.contentType)
+	}
+	if _, ok := restored.metadata[storage.OriginalKeyMetadataKey]; ok || restored.metadata["owner"] != "alice" {
+		t.Errorf("restored metadata %v, want the original metadata only", restored.metadata)
+	}
+	if _, err := fake.object("media", "trash/users/a.png"); err == nil {
+		t.Error("restored object still in the trash")
+	}
+}
+
+func TestSoftDeleteOfTrashedObjectIsPermanent(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	fake.put("media", "trash/a.png", []byte("png"), "image/png", nil)
+	s := softDeleteTestService(t, fake)
+
+	if _, err := s.DeleteObject(ctx, &mediabase_v1.DeleteObjectRequest{ObjectKey: "trash/a.png"}); err != nil {
+		t.Fatalf("DeleteObject: %v", err)
+	}
+	fake.mu.Lock()
+	remaining := len(fake.buckets["media"])
+	fake.mu.Unlock()
+	if remaining != 0 {
+		t.Errorf("%d objects left after deleting from the trash, want none", remaining)
+	}
+}
+
+func TestRestoreObjectErrors(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	fake.put("media", "trash/a.png", []byte("old"), "image/png", nil)
+	fake.put("media", "a.png", []byte("new"), "image/png", nil)
+	s := softDeleteTestService(t, fake)
+
+	for _, tc := range []struct {
+		key  string
+		want codes.Code
+	}{
+		{"missing.png", codes.NotFound},
+		{"trash/a.png", codes.InvalidArgument},
+		{"", codes.InvalidArgument},
+		// An object uploaded under the key after the delete is never overwritten
+		{"a.png", codes.AlreadyExists},
+	} {
+		_, err := s.RestoreObject(ctx, &mediabase_v1.RestoreObjectRequest{ObjectKey: tc.key})
+		if status.Code(err) != tc.want {
+			t.Errorf("restore %q: error = %v, want %s", tc.key, err, tc.want)
+		}
+	}
+	if current, _ := fake.object("media", "a.png"); string(current.data) != "new" {
+		t.Error("restore overwrote a newer object")
+	}
+
+	_, err := newTestService(t, testConfig(), fake).RestoreObject(ctx, &mediabase_v1.RestoreObjectRequest{ObjectKey: "a.png"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("restore without soft delete: error = %v, want FAILED_PRECONDITION", err)
+	}
+}
+
+func TestHardDeleteWithoutSoftDelete(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	fake.put("media", "a.png", []byte("png"), "image/png", nil)
+	s := newTestService(t, testConfig(), fake)
+
+	if _, err := s.DeleteObject(ctx, &mediabase_v1.DeleteObjectRequest{ObjectKey: "a.png"}); err != nil {
+		t.Fatalf("DeleteObject: %v", err)
+	}
+	if _, err := fake.object("media", "trash/a.png"); err == nil {
+		t.Error("object moved to the trash with soft delete off")
+	}
+	if got := fake.callCount("CopyObject"); got != 0 {
+		t.Errorf("%d copies for a hard delete", got)
+	}
+}
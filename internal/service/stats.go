@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultBucketStatsTimeout is used when BucketStatsTimeout is not set
+const defaultBucketStatsTimeout = 30 * time.Second
+
+// defaultMaxScanObjects is used when MaxScanObjects is not set
+const defaultMaxScanObjects = 1_000_000
+
+// GetBucketStats counts the objects in a bucket, optionally under a prefix, and sums their sizes.
+// Storage keeps no counters, so this lists every object. The scan stops at the requested maximum,
+// the configured cap or the configured timeout, in which case the partial totals are reported as
+// truncated. Stopping the iteration cancels the storage listing.
+func (s *Service) GetBucketStats(ctx context.Context, req *mediabase_v1.GetBucketStatsRequest) (*mediabase_v1.GetBucketStatsResponse, error) {
+	ctx = withLogFields(ctx, req.BucketName, "")
+	logDebug(ctx, "GetBucketStats request received, bucket: %s, prefix: %s, max_objects: %d", req.BucketName, req.Prefix, req.MaxObjects)
+
+	if err := s.validateBucket(req.BucketName); err != nil {
+		return nil, err
+	}
+	if req.MaxObjects < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "max_objects must not be negative")
+	}
+
+	maxObjects := s.maxScanObjects
+	if req.MaxObjects > 0 && req.MaxObjects < maxObjects {
+		maxObjects = req.MaxObjects
+	}
+
+	scanCtx, cancel := context.WithTimeout(ctx, s.bucketStatsTimeout)
+	defer cancel()
+
+	resp := &mediabase_v1.GetBucketStatsResponse{}
+	for info, err := range s.storage.ListObjects(scanCtx, req.BucketName, req.Prefix) {
+		if err != nil {
+			// Running out of scan time is a truncated result, unless the caller went away
+			if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+				resp.Truncated = true
+				break
+			}
+			logger.Error(ctx, "Failed to list objects: %v", err)
+			return nil, storageError("failed to list objects", err)
+		}
+		if resp.ObjectCount == maxObjects {
+			resp.Truncated = true
+			break
+		}
+		resp.ObjectCount++
+		resp.TotalBytes += info.Size
+	}
+
+	logDebug(ctx, "Bucket stats counted: %s, objects: %d, bytes: %d, truncated: %v", req.BucketName, resp.ObjectCount, resp.TotalBytes, resp.Truncated)
+
+	return resp, nil
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// manyObjectsStorage returns a fake holding n two-byte objects under users/ and one under other/
+func manyObjectsStorage(n int) *fakeStorage {
+	fake := newFakeStorage("media")
+	for i := range n {
+		fake.put("media", fmt.Sprintf("users/%05d.png", i), []byte("ab"), "image/png", nil)
+	}
+	fake.put("media", "other/a.png", []byte("abc"), "image/png", nil)
+	return fake
+}
+
+func TestGetBucketStatsCountsObjects(t *testing.T) {
+	s := newTestService(t, testConfig(), manyObjectsStorage(50))
+
+	resp, err := s.GetBucketStats(context.Background(), &mediabase_v1.GetBucketStatsRequest{BucketName: "media"})
+	if err != nil {
+		t.Fatalf("GetBucketStats: %v", err)
+	}
+	if resp.ObjectCount != 51 || resp.TotalBytes != 103 || resp.Truncated {
+		t.Errorf("stats = %+v, want 51 objects of 103 bytes, complete", resp)
+	}
+
+	resp, err = s.GetBucketStats(context.Background(), &mediabase_v1.GetBucketStatsRequest{BucketName: "media", Prefix: "other/"})
+	if err != nil {
+		t.Fatalf("GetBucketStats: %v", err)
+	}
+	if resp.ObjectCount != 1 || resp.TotalBytes != 3 {
+		t.Errorf("stats under other/ = %+v, want 1 object of 3 bytes", resp)
+	}
+}
+
+func TestGetBucketStatsCapsScans(t *testing.T) {
+	ctx := context.Background()
+	cfg := testConfig()
+	cfg.MaxScanObjects = 1000
+	s := newTestService(t, cfg, manyObjectsStorage(5000))
+
+	for _, tc := range []struct {
+		name       string
+		maxObjects int64
+		want       int64
+	}{
+		{"server cap", 0, 1000},
+		{"request above the cap", 2000, 1000},
+		{"request below the cap", 10, 10},
+	} {
+		resp, err := s.GetBucketStats(ctx, &mediabase_v1.GetBucketStatsRequest{BucketName: "media", Prefix: "users/", MaxObjects: tc.maxObjects})
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if resp.ObjectCount != tc.want || resp.TotalBytes != 2*tc.want || !resp.Truncated {
+			t.Errorf("%s: stats = %+v, want %d objects, truncated", tc.name, resp, tc.want)
+		}
+	}
+
+	// A scan ending exactly at the cap is complete
+	resp, err := s.GetBucketStats(ctx, &mediabase_v1.GetBucketStatsRequest{BucketName: "media", Prefix: "other/", MaxObjects: 1})
+	if err != nil || resp.Truncated {
+		t.Errorf("scan of exactly max_objects: %+v, %v, want it complete", resp, err)
+	}
+
+	_, err = s.GetBucketStats(ctx, &mediabase_v1.GetBucketStatsRequest{BucketName: "media", MaxObjects: -1})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("negative max_objects: error = %v, want INVALID_ARGUMENT", err)
+	}
+}
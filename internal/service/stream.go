@@ -0,0 +1,278 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"github.com/gofreego/mediabase/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// sniffLength is the number of leading bytes http.DetectContentType considers
+const sniffLength = 512
+
+// UploadObject streams chunks from the client into storage through a pipe, so the file is
+// never held in memory. The upload is aborted as soon as it exceeds the maximum file size
+// for its content type.
+func (s *Service) UploadObject(stream mediabase_v1.MediabaseService_UploadObjectServer) error {
+	ctx := stream.Context()
+	defer s.trackStream()()
+
+	first, err := stream.Recv()
+	if err != nil {
+		return receiveError("failed to receive upload metadata", err)
+	}
+	meta := first.GetMetadata()
+	if meta == nil {
+		return status.Errorf(codes.InvalidArgument, "the first message of an upload must contain metadata")
+	}
+
+	ctx = withLogFields(ctx, meta.BucketName, "")
+	logDebug(ctx, "UploadObject request received, bucket: %s, content_type: %s, size: %d, detect_content_type: %v", meta.BucketName, meta.ContentType, meta.Size, meta.DetectContentType)
+
+	if err := s.resolveBucket(&meta.BucketName); err != nil {
+		return err
+	}
+
+	// Unknown sizes make storage upload in parts as data arrives
+	objectSize := int64(-1)
+	if meta.Size > 0 {
+		objectSize = meta.Size
+	}
+
+	// The limit is tightened once the content type is known
+	chunks := &chunkReceiver{stream: stream, maxSize: s.maxFileSize, declaredSize: objectSize}
+
+	// With detection enabled, the leading bytes are read before anything is sent to storage
+	contentType := meta.ContentType
+	var head []byte
+	if meta.DetectContentType {
+		head, err = chunks.readHead(sniffLength)
+		if err != nil {
+			return err
+		}
+		detected, err := detectContentType(head)
+		if err != nil {
+			return err
+		}
+		if contentType != "" && contentType != detected {
+			return status.Errorf(codes.InvalidArgument, "declared content type %s does not match detected content type %s", contentType, detected)
+		}
+		contentType = detected
+		logDebug(ctx, "Detected content type %s for streaming upload", contentType)
+	}
+
+	// Validate content type
+	contentType, err = s.resolveContentType(contentType)
+	if err != nil {
+		return err
+	}
+	if !s.isValidContentType(contentType) {
+		return status.Errorf(codes.InvalidArgument, "invalid content type: %s", contentType)
+	}
+
+	// Validate the encryption key override
+	if meta.KmsKeyId != "" {
+		if err := s.requireCapability(s.storage.Capabilities().ServerSideEncryption, "KMS keys"); err != nil {
+			return err
+		}
+	}
+
+	// Validate the file name against the naming rules
+	if meta.FileName != "" {
+		fileName, err := s.checkFileName(ctx, meta.FileName)
+		if err != nil {
+			return err
+		}
+		meta.FileName = fileName
+	}
+
+	// Validate sizes against the server limit for this content type
+	chunks.maxSize = s.maxFileSizeFor(contentType)
+	if meta.Size > chunks.maxSize || chunks.received > chunks.maxSize {
+		return status.Errorf(codes.InvalidArgument, "file size exceeds server maximum allowed size %d for %s", chunks.maxSize, contentType)
+	}
+
+	// Generate object key; the content hash is not known before streaming
+	objectKey, err := s.generateObjectKey(ctx, KeyInput{
+		Path:        meta.Path,
+		FileName:    meta.FileName,
+		ContentType: contentType,
+	})
+	if err != nil {
+		return err
+	}
+	ctx = withLogFields(ctx, meta.BucketName, objectKey)
+
+	// Unknown sizes may use the whole limit
+	reserved := chunks.maxSize
+	if objectSize >= 0 {
+		reserved = objectSize
+	}
+	if err := s.quotas.check(ctx, meta.BucketName, reserved); err != nil {
+		return err
+	}
+	replaced := s.replacedSize(ctx, meta.BucketName, objectKey)
+
+	// The slot is held while the client sends, since storage reads the upload as it arrives
+	release, err := s.puts.acquire(ctx)
+	if err != nil {
+		return err
+	}
+
+	pr, pw := io.Pipe()
+	done := make(chan error, 1)
+	var etag string
+	go func() {
+		defer release()
+		var err error
+		etag, err = s.storage.PutObject(ctx, meta.BucketName, objectKey, pr, objectSize, contentType, storage.UploadOptions{
+			Tags:     s.markConfirmed(meta.BucketName, objectKey, nil),
+			KMSKeyID: meta.KmsKeyId,
+		})
+		// Unblock the writer if storage stopped reading early
+		pr.CloseWithError(err)
+		done <- err
+	}()
+
+	// abort stops the storage upload before the object is committed and waits for it to return
+	abort := func(cause error) error {
+		pw.CloseWithError(cause)
+		<-done
+		return cause
+	}
+
+	// write forwards content to storage, reporting the storage error if it stopped reading
+	write := func(p []byte) error {
+		if _, err := pw.Write(p); err != nil {
+			if storageErr := <-done; storageErr != nil {
+				err = storageErr
+			}
+			logger.Error(ctx, "Failed to upload object: %v", err)
+			return storageError("failed to upload object", err)
+		}
+		return nil
+	}
+
+	if len(head) > 0 {
+		if err := write(head); err != nil {
+			return err
+		}
+	}
+
+	for {
+		chunk, err := chunks.next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return abort(err)
+		}
+		if err := write(chunk); err != nil {
+			return err
+		}
+	}
+
+	if objectSize >= 0 && chunks.received != objectSize {
+		return abort(status.Errorf(codes.InvalidArgument, "received %d bytes, declared size is %d", chunks.received, objectSize))
+	}
+
+	pw.Close()
+	if err := <-done; err != nil {
+		logger.Error(ctx, "Failed to upload object: %v", err)
+		return storageError("failed to upload object", err)
+	}
+
+	logDebug(ctx, "Object streamed to storage successfully: %s in bucket: %s, bytes: %d", objectKey, meta.BucketName, chunks.received)
+	s.quotas.record(meta.BucketName, objectKey, chunks.received, replaced, time.Now())
+
+	return stream.SendAndClose(&mediabase_v1.UploadObjectResponse{
+		ObjectKey: objectKey,
+		Size:      chunks.received,
+		Etag:      etag,
+	})
+}
+
+// chunkReceiver reads content chunks from an upload stream and enforces the size limits
+type chunkReceiver struct {
+	stream       mediabase_v1.MediabaseService_UploadObjectServer
+	maxSize      int64
+	declaredSize int64
+	received     int64
+}
+
+// next returns the next content chunk, or io.EOF once the client has finished sending
+func (c *chunkReceiver) next() ([]byte, error) {
+	req, err := c.stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return nil, io.EOF
+	}
+	if err != nil {
+		return nil, receiveError("failed to receive upload chunk", err)
+	}
+	if req.GetMetadata() != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "metadata may only be sent in the first message")
+	}
+
+	chunk := req.GetChunk()
+	c.received += int64(len(chunk))
+	if c.received > c.maxSize {
+		return nil, status.Errorf(codes.InvalidArgument, "upload exceeds server maximum allowed size %d", c.maxSize)
+	}
+	if c.declaredSize >= 0 && c.received > c.declaredSize {
+		return nil, status.Errorf(codes.InvalidArgument, "upload exceeds declared size %d", c.declaredSize)
+	}
+	return chunk, nil
+}
+
+// readHead collects chunks until at least n bytes have arrived or the stream ends
+func (c *chunkReceiver) readHead(n int) ([]byte, error) {
+	var head bytes.Buffer
+	for head.Len() < n {
+		chunk, err := c.next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		head.Write(chunk)
+	}
+	return head.Bytes(), nil
+}
+
+// detectContentType sniffs the media type of content from its leading bytes, without parameters
+func detectContentType(head []byte) (string, error) {
+	if len(head) == 0 {
+		return "", status.Errorf(codes.InvalidArgument, "cannot detect the content type of an empty upload")
+	}
+	mediaType, _, err := mime.ParseMediaType(http.DetectContentType(head))
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "failed to detect content type: %v", err)
+	}
+	return mediaType, nil
+}
+
+// receiveError converts a failed receive from the client into a status. Status errors keep
+// their code; a stream the client ended before sending metadata is invalid, and anything else
+// means the client went away.
+func receiveError(msg string, err error) error {
+	if st, ok := status.FromError(err); ok {
+		return status.Errorf(st.Code(), "%s: %s", msg, st.Message())
+	}
+	switch {
+	case errors.Is(err, io.EOF):
+		return status.Errorf(codes.InvalidArgument, "%s: the stream ended early", msg)
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Errorf(codes.DeadlineExceeded, "%s: %v", msg, err)
+	}
+	return status.Errorf(codes.Canceled, "%s: %v", msg, err)
+}
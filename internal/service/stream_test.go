@@ -0,0 +1,143 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getObjectStream records the messages GetObject sends. After failAfter messages, if set, the
+// client goes away: the context is cancelled and further sends fail as they do in gRPC.
+type getObjectStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	cancel    context.CancelFunc
+	failAfter int
+	metadata  []*mediabase_v1.GetObjectMetadata
+	chunks    [][]byte
+}
+
+func newGetObjectStream() *getObjectStream {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &getObjectStream{ctx: ctx, cancel: cancel}
+}
+
+func (g *getObjectStream) Context() context.Context {
+	return g.ctx
+}
+
+func (g *getObjectStream) Send(resp *mediabase_v1.GetObjectResponse) error {
+	if err := g.ctx.Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+	if metadata := resp.GetMetadata(); metadata != nil {
+		g.metadata = append(g.metadata, metadata)
+	} else {
+		g.chunks = append(g.chunks, bytes.Clone(resp.GetChunk()))
+	}
+	if g.failAfter > 0 && len(g.metadata)+len(g.chunks) >= g.failAfter {
+		g.cancel()
+	}
+	return nil
+}
+
+func streamTestService(t *testing.T, fake *fakeStorage, chunkSize int) *Service {
+	cfg := testConfig()
+	cfg.DownloadChunkSize = chunkSize
+	cfg.AutoDeleteOnDownloadPrefixes = []string{"once/"}
+	return newTestService(t, cfg, fake)
+}
+
+func TestGetObjectStreamsMetadataThenChunks(t *testing.T) {
+	fake := newFakeStorage("media")
+	content := []byte("0123456789abcdefghij")
+	fake.put("media", "a.png", content, "image/png", nil)
+	s := streamTestService(t, fake, 8)
+
+	stream := newGetObjectStream()
+	if err := s.GetObject(&mediabase_v1.GetObjectRequest{ObjectKey: "a.png"}, stream); err != nil {
+		t.Fatalf("GetObject: %v", err)
+	}
+	if len(stream.metadata) != 1 {
+		t.Fatalf("%d metadata messages, want one", len(stream.metadata))
+	}
+	if m := stream.metadata[0]; m.ContentType != "image/png" || m.Size != 20 || m.Length != 20 {
+		t.Errorf("metadata = %+v, want image/png of 20 bytes", m)
+	}
+	var sizes []int
+	for _, chunk := range stream.chunks {
+		sizes = append(sizes, len(chunk))
+	}
+	if len(sizes) != 3 || sizes[0] != 8 || sizes[1] != 8 || sizes[2] != 4 {
+		t.Errorf("chunk sizes %v, want 8, 8 and 4", sizes)
+	}
+	if got := bytes.Join(stream.chunks, nil); !bytes.Equal(got, content) {
+		t.Errorf("streamed %q, want %q", got, content)
+	}
+}
+
+func TestGetObjectStreamsRanges(t *testing.T) {
+	fake := newFakeStorage("media")
+	fake.put("media", "a.png", []byte("0123456789"), "image/png", nil)
+	s := streamTestService(t, fake, 4)
+
+	for _, tc := range []struct {
+		offset, length int64
+		want           string
+	}{
+		{2, 5, "23456"},
+		{7, 0, "789"},
+		{4, 100, "456789"},
+		{10, 0, ""},
+	} {
+		stream := newGetObjectStream()
+		err := s.GetObject(&mediabase_v1.GetObjectRequest{ObjectKey: "a.png", Offset: tc.offset, Length: tc.length}, stream)
+		if err != nil {
+			t.Errorf("offset %d length %d: %v", tc.offset, tc.length, err)
+			continue
+		}
+		if got := string(bytes.Join(stream.chunks, nil)); got != tc.want {
+			t.Errorf("offset %d length %d: streamed %q, want %q", tc.offset, tc.length, got, tc.want)
+		}
+		if stream.metadata[0].Length != int64(len(tc.want)) {
+			t.Errorf("offset %d length %d: metadata length %d, want %d", tc.offset, tc.length, stream.metadata[0].Length, len(tc.want))
+		}
+	}
+
+	for _, req := range []*mediabase_v1.GetObjectRequest{
+		{ObjectKey: "a.png", Offset: 11},
+		{ObjectKey: "a.png", Offset: -1},
+		{ObjectKey: "missing.png"},
+	} {
+		err := s.GetObject(req, newGetObjectStream())
+		if code := status.Code(err); code != codes.OutOfRange && code != codes.InvalidArgument && code != codes.NotFound {
+			t.Errorf("offset %d of %s: error = %v, want it rejected", req.Offset, req.ObjectKey, err)
+		}
+	}
+}
+
+func TestGetObjectStopsWhenClientGoesAway(t *testing.T) {
+	fake := newFakeStorage("media")
+	content := bytes.Repeat([]byte("x"), 64)
+	fake.put("media", "once/a.png", content, "image/png", nil)
+	s := streamTestService(t, fake, 4)
+
+	// The metadata and two chunks arrive before the client disconnects
+	stream := newGetObjectStream()
+	stream.failAfter = 3
+	err := s.GetObject(&mediabase_v1.GetObjectRequest{ObjectKey: "once/a.png"}, stream)
+	if status.Code(err) != codes.Canceled {
+		t.Errorf("error = %v, want CANCELED", err)
+	}
+	if len(stream.chunks) != 2 {
+		t.Errorf("%d chunks sent, want the stream to stop after the disconnect", len(stream.chunks))
+	}
+	if _, err := fake.object("media", "once/a.png"); err != nil {
+		t.Error("object auto-deleted after an aborted download")
+	}
+}
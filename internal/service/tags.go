@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetObjectTags replaces the tags of an object
+func (s *Service) SetObjectTags(ctx context.Context, req *mediabase_v1.SetObjectTagsRequest) (*mediabase_v1.SetObjectTagsResponse, error) {
+	ctx = withLogFields(ctx, req.BucketName, req.ObjectKey)
+	logDebug(ctx, "SetObjectTags request received, bucket: %s, object_key: %s, tags: %d", req.BucketName, req.ObjectKey, len(req.Tags))
+
+	if err := s.resolveBucket(&req.BucketName); err != nil {
+		return nil, err
+	}
+
+	if err := s.requireCapability(s.storage.Capabilities().ObjectTagging, "object tags"); err != nil {
+		return nil, err
+	}
+
+	// Validate tags
+	if err := validateTags(req.Tags); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tags: %v", err)
+	}
+
+	// Replacing the tags must not drop the confirmation the orphan cleanup looks for
+	tags := req.Tags
+	if s.orphanCleanup.covers(req.BucketName, s.normalizeKey(req.ObjectKey)) {
+		existing, err := s.storage.GetObjectTags(ctx, req.BucketName, req.ObjectKey)
+		if err != nil {
+			logger.Error(ctx, "Failed to get object tags: %v", err)
+			return nil, storageError("failed to get object tags", err)
+		}
+		if existing[confirmedTagKey] != "" {
+			if len(req.Tags) >= maxObjectTags {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid tags: at most %d tags are allowed on confirmed uploads, one is reserved", maxObjectTags-1)
+			}
+			tags = s.markConfirmed(req.BucketName, req.ObjectKey, req.Tags)
+		}
+	}
+
+	err := s.storage.SetObjectTags(ctx, req.BucketName, req.ObjectKey, tags)
+	if err != nil {
+		logger.Error(ctx, "Failed to set object tags: %v", err)
+		return nil, storageError("failed to set object tags", err)
+	}
+
+	logDebug(ctx, "Object tags set successfully: %s", req.ObjectKey)
+
+	return &mediabase_v1.SetObjectTagsResponse{
+		Success: true,
+	}, nil
+}
+
+// GetObjectTags returns the tags of an object
+func (s *Service) GetObjectTags(ctx context.Context, req *mediabase_v1.GetObjectTagsRequest) (*mediabase_v1.GetObjectTagsResponse, error) {
+	ctx = withLogFields(ctx, req.BucketName, req.ObjectKey)
+	logDebug(ctx, "GetObjectTags request received, bucket: %s, object_key: %s", req.BucketName, req.ObjectKey)
+
+	if err := s.resolveBucket(&req.BucketName); err != nil {
+		return nil, err
+	}
+
+	if err := s.requireCapability(s.storage.Capabilities().ObjectTagging, "object tags"); err != nil {
+		return nil, err
+	}
+
+	tags, err := s.storage.GetObjectTags(ctx, req.BucketName, req.ObjectKey)
+	if err != nil {
+		logger.Error(ctx, "Failed to get object tags: %v", err)
+		return nil, storageError("failed to get object tags", err)
+	}
+
+	return &mediabase_v1.GetObjectTagsResponse{
+		Tags: tags,
+	}, nil
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"github.com/gofreego/mediabase/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultTagSearchMaxResults is used when TagSearchConfig.MaxResults is not set
+	defaultTagSearchMaxResults = 100
+	// defaultTagSearchWorkers is used when TagSearchConfig.Workers is not set
+	defaultTagSearchWorkers = 8
+	// defaultTagSearchTimeout is used when TagSearchConfig.Timeout is not set
+	defaultTagSearchTimeout = 30 * time.Second
+)
+
+// TagSearchConfig bounds the scans of FindObjectsByTag. The number of objects a scan lists is
+// capped by MaxScanObjects.
+type TagSearchConfig struct {
+	// MaxResults caps the objects one search returns, whatever max_results it asks for (defaults to 100)
+	MaxResults int32 `yaml:"MaxResults"`
+	// Workers is the number of objects whose tags are read at once (defaults to 8)
+	Workers int `yaml:"Workers"`
+	// Timeout bounds how long a search scans before reporting a truncated result (defaults to 30s)
+	Timeout time.Duration `yaml:"Timeout"`
+}
+
+// validate rejects bounds the service cannot honour
+func (c TagSearchConfig) validate() error {
+	if c.MaxResults < 0 || c.Workers < 0 || c.Timeout < 0 {
+		return errors.New("TagSearch must not be negative")
+	}
+	return nil
+}
+
+// withDefaults fills in the bounds that are not set
+func (c TagSearchConfig) withDefaults() TagSearchConfig {
+	if c.MaxResults == 0 {
+		c.MaxResults = defaultTagSearchMaxResults
+	}
+	if c.Workers == 0 {
+		c.Workers = defaultTagSearchWorkers
+	}
+	if c.Timeout == 0 {
+		c.Timeout = defaultTagSearchTimeout
+	}
+	return c
+}
+
+// FindObjectsByTag finds the objects under a prefix that carry all requested tags. Storage
+// cannot search tags, so this lists the objects and reads the tags of each, a bounded number at
+// a time. The scan stops once enough matches are found, at the configured scan cap or at the
+// timeout, in which case the result is reported as truncated.
+func (s *Service) FindObjectsByTag(ctx context.Context, req *mediabase_v1.FindObjectsByTagRequest) (*mediabase_v1.FindObjectsByTagResponse, error) {
+	ctx = withLogFields(ctx, req.BucketName, "")
+	logDebug(ctx, "FindObjectsByTag request received, bucket: %s, prefix: %s, tags: %d, max_results: %d", req.BucketName, req.Prefix, len(req.Tags), req.MaxResults)
+
+	if err := s.resolveBucket(&req.BucketName); err != nil {
+		return nil, err
+	}
+	if err := s.requireCapability(s.storage.Capabilities().ObjectTagging, "object tags"); err != nil {
+		return nil, err
+	}
+	if len(req.Tags) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "at least one tag is required")
+	}
+	if err := validateTags(req.Tags); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tags: %v", err)
+	}
+	if req.MaxResults < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "max_results must not be negative")
+	}
+
+	maxResults := s.tagSearch.MaxResults
+	if req.MaxResults > 0 && req.MaxResults < maxResults {
+		maxResults = req.MaxResults
+	}
+
+	// Cancelling the scan stops the listing and the tag reads still running
+	scanCtx, cancel := context.WithTimeout(ctx, s.tagSearch.Timeout)
+	defer cancel()
+
+	var (
+		mu        sync.Mutex
+		matches   []*mediabase_v1.TaggedObject
+		truncated bool
+		tagsErr   error
+	)
+	keys := make(chan string)
+	var wg sync.WaitGroup
+	for range s.tagSearch.Workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range keys {
+				tags, err := s.storage.GetObjectTags(scanCtx, req.BucketName, key)
+
+				mu.Lock()
+				switch {
+				case errors.Is(err, storage.ErrObjectNotFound):
+					// Deleted since it was listed
+				case err != nil:
+					// Reads cut short by a stopped scan are not failures
+					if scanCtx.Err() == nil && tagsErr == nil {
+						tagsErr = err
+						cancel()
+					}
+				case hasTags(tags, req.Tags) && len(matches) < int(maxResults):
+					matches = append(matches, &mediabase_v1.TaggedObject{ObjectKey: key, Tags: tags})
+					if len(matches) == int(maxResults) {
+						truncated = true
+						cancel()
+					}
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	var scanned int64
+	var listErr error
+	for info, err := range s.storage.ListObjects(scanCtx, req.BucketName, req.Prefix) {
+		if err != nil {
+			listErr = err
+			break
+		}
+		if scanned == s.maxScanObjects {
+			mu.Lock()
+			truncated = true
+			mu.Unlock()
+			break
+		}
+		select {
+		case keys <- info.Key:
+			scanned++
+			continue
+		case <-scanCtx.Done():
+		}
+		break
+	}
+	close(keys)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+	if tagsErr != nil {
+		logger.Error(ctx, "Failed to get object tags: %v", tagsErr)
+		return nil, storageError("failed to get object tags", tagsErr)
+	}
+	// A listing cut short by a stopped scan is not a failure
+	if listErr != nil && scanCtx.Err() == nil {
+		logger.Error(ctx, "Failed to list objects: %v", listErr)
+		return nil, storageError("failed to list objects", listErr)
+	}
+	if errors.Is(scanCtx.Err(), context.DeadlineExceeded) {
+		truncated = true
+	}
+
+	slices.SortFunc(matches, func(a, b *mediabase_v1.TaggedObject) int {
+		return strings.Compare(a.ObjectKey, b.ObjectKey)
+	})
+
+	logDebug(ctx, "Objects found by tag: %d, scanned: %d, truncated: %v", len(matches), scanned, truncated)
+
+	return &mediabase_v1.FindObjectsByTagResponse{
+		Objects:        matches,
+		Truncated:      truncated,
+		ScannedObjects: scanned,
+	}, nil
+}
+
+// hasTags reports whether tags include every wanted tag with its value
+func hasTags(tags, wanted map[string]string) bool {
+	for k, v := range wanted {
+		if value, ok := tags[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
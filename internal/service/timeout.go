@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/gofreego/mediabase/internal/storage"
+)
+
+// StorageTimeoutConfig bounds how long a single storage call of each operation class may take,
+// so slow storage fails requests with DEADLINE_EXCEEDED instead of hanging them until the client
+// gives up. A zero timeout leaves the calls of its class unbounded.
+type StorageTimeoutConfig struct {
+	// Presign bounds generating presigned and public URLs
+	Presign time.Duration `yaml:"Presign"`
+	// Stat bounds object and bucket existence checks and object lookups
+	Stat time.Duration `yaml:"Stat"`
+	// Put bounds uploads and server-side copies. Streamed uploads are written while the client
+	// sends them, so the timeout must leave room for the slowest expected client.
+	Put time.Duration `yaml:"Put"`
+	// Delete bounds object and object version deletes
+	Delete time.Duration `yaml:"Delete"`
+}
+
+// validate rejects negative timeouts
+func (c StorageTimeoutConfig) validate() error {
+	if c.Presign < 0 || c.Stat < 0 || c.Put < 0 || c.Delete < 0 {
+		return errors.New("StorageTimeouts must not be negative")
+	}
+	return nil
+}
+
+// enabled reports whether any operation class is bounded
+func (c StorageTimeoutConfig) enabled() bool {
+	return c.Presign > 0 || c.Stat > 0 || c.Put > 0 || c.Delete > 0
+}
+
+// timeoutStorage wraps a backend so every call of a bounded operation class runs under
+// context.WithTimeout. Calls returning readers are passed through, since the read outlives
+// the call, as are listings and bucket configuration.
+type timeoutStorage struct {
+	storage.Storage
+	timeouts StorageTimeoutConfig
+}
+
+// withTimeout derives the context of a storage call bounded by timeout, if set
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+func (t *timeoutStorage) GeneratePresignedUploadURL(ctx context.Context, bucketName, objectKey, contentType string, expiryDuration time.Duration, maxSize int64, opts storage.UploadOptions) (string, map[string]string, error) {
+	ctx, cancel := withTimeout(ctx, t.timeouts.Presign)
+	defer cancel()
+	return t.Storage.GeneratePresignedUploadURL(ctx, bucketName, objectKey, contentType, expiryDuration, maxSize, opts)
+}
+
+func (t *timeoutStorage) GeneratePresignedPutURL(ctx context.Context, bucketName, objectKey, contentType string, expiryDuration time.Duration, size int64, opts storage.UploadOptions) (string, map[string]string, error) {
+	ctx, cancel := withTimeout(ctx, t.timeouts.Presign)
+	defer cancel()
+	return t.Storage.GeneratePresignedPutURL(ctx, bucketName, objectKey, contentType, expiryDuration, size, opts)
+}
+
+func (t *timeoutStorage) GeneratePresignedDownloadURL(ctx context.Context, bucketName, objectKey string, expiryDuration time.Duration, opts storage.DownloadOptions) (string, error) {
+	ctx, cancel := withTimeout(ctx, t.timeouts.Presign)
+	defer cancel()
+	return t.Storage.GeneratePresignedDownloadURL(ctx, bucketName, objectKey, expiryDuration, opts)
+}
+
+func (t *timeoutStorage) GeneratePresignedHeadURL(ctx context.Context, bucketName, objectKey string, expiryDuration time.Duration, versionID string) (string, error) {
+	ctx, cancel := withTimeout(ctx, t.timeouts.Presign)
+	defer cancel()
+	return t.Storage.GeneratePresignedHeadURL(ctx, bucketName, objectKey, expiryDuration, versionID)
+}
+
+func (t *timeoutStorage) GeneratePresignedDeleteURL(ctx context.Context, bucketName, objectKey string, expiryDuration time.Duration) (string, error) {
+	ctx, cancel := withTimeout(ctx, t.timeouts.Presign)
+	defer cancel()
+	return t.Storage.GeneratePresignedDeleteURL(ctx, bucketName, objectKey, expiryDuration)
+}
+
+func (t *timeoutStorage) PublicObjectURL(ctx context.Context, bucketName, objectKey string) (string, error) {
+	ctx, cancel := withTimeout(ctx, t.timeouts.Presign)
+	defer cancel()
+	return t.Storage.PublicObjectURL(ctx, bucketName, objectKey)
+}
+
+func (t *timeoutStorage) BucketExists(ctx context.Context, bucketName string) (bool, error) {
+	ctx, cancel := withTimeout(ctx, t.timeouts.Stat)
+	defer cancel()
+	return t.Storage.BucketExists(ctx, bucketName)
+}
+
+func (t *timeoutStorage) ObjectExists(ctx context.Context, bucketName, objectKey string) (bool, error) {
+	ctx, cancel := withTimeout(ctx, t.timeouts.Stat)
+	defer cancel()
+	return t.Storage.ObjectExists(ctx, bucketName, objectKey)
+}
+
+func (t *timeoutStorage) StatObject(ctx context.Context, bucketName, objectKey string) (*storage.ObjectInfo, error) {
+	ctx, cancel := withTimeout(ctx, t.timeouts.Stat)
+	defer cancel()
+	return t.Storage.StatObject(ctx, bucketName, objectKey)
+}
+
+func (t *timeoutStorage) PutObject(ctx context.Context, bucketName, objectKey string, reader io.Reader, objectSize int64, contentType string, opts storage.UploadOptions) (string, error) {
+	ctx, cancel := withTimeout(ctx, t.timeouts.Put)
+	defer cancel()
+	return t.Storage.PutObject(ctx, bucketName, objectKey, reader, objectSize, contentType, opts)
+}
+
+func (t *timeoutStorage) CopyObject(ctx context.Context, bucketName, srcKey, dstKey string, opts storage.CopyOptions) error {
+	ctx, cancel := withTimeout(ctx, t.timeouts.Put)
+	defer cancel()
+	return t.Storage.CopyObject(ctx, bucketName, srcKey, dstKey, opts)
+}
+
+func (t *timeoutStorage) DeleteObject(ctx context.Context, bucketName, objectKey string) error {
+	ctx, cancel := withTimeout(ctx, t.timeouts.Delete)
+	defer cancel()
+	return t.Storage.DeleteObject(ctx, bucketName, objectKey)
+}
+
+func (t *timeoutStorage) DeleteObjectVersion(ctx context.Context, bucketName, objectKey, versionID string) error {
+	ctx, cancel := withTimeout(ctx, t.timeouts.Delete)
+	defer cancel()
+	return t.Storage.DeleteObjectVersion(ctx, bucketName, objectKey, versionID)
+}
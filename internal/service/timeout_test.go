@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"github.com/gofreego/mediabase/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// slowStorage blocks the calls of its slow methods until their context is done, as storage that
+// stopped answering would
+type slowStorage struct {
+	*fakeStorage
+	slow map[string]bool
+}
+
+func (s *slowStorage) wait(ctx context.Context, method string) error {
+	if !s.slow[method] {
+		return nil
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (s *slowStorage) GeneratePresignedUploadURL(ctx context.Context, bucketName, objectKey, contentType string, expiryDuration time.Duration, maxSize int64, opts storage.UploadOptions) (string, map[string]string, error) {
+	if err := s.wait(ctx, "GeneratePresignedUploadURL"); err != nil {
+		return "", nil, err
+	}
+	return s.fakeStorage.GeneratePresignedUploadURL(ctx, bucketName, objectKey, contentType, expiryDuration, maxSize, opts)
+}
+
+func (s *slowStorage) StatObject(ctx context.Context, bucketName, objectKey string) (*storage.ObjectInfo, error) {
+	if err := s.wait(ctx, "StatObject"); err != nil {
+		return nil, err
+	}
+	return s.fakeStorage.StatObject(ctx, bucketName, objectKey)
+}
+
+func (s *slowStorage) PutObject(ctx context.Context, bucketName, objectKey string, reader io.Reader, objectSize int64, contentType string, opts storage.UploadOptions) (string, error) {
+	if err := s.wait(ctx, "PutObject"); err != nil {
+		return "", err
+	}
+	return s.fakeStorage.PutObject(ctx, bucketName, objectKey, reader, objectSize, contentType, opts)
+}
+
+func (s *slowStorage) DeleteObject(ctx context.Context, bucketName, objectKey string) error {
+	if err := s.wait(ctx, "DeleteObject"); err != nil {
+		return err
+	}
+	return s.fakeStorage.DeleteObject(ctx, bucketName, objectKey)
+}
+
+func timeoutTestService(t *testing.T, timeouts StorageTimeoutConfig, slow ...string) *Service {
+	fake := newFakeStorage("media")
+	fake.put("media", "a.png", []byte("png"), "image/png", nil)
+	st := &slowStorage{fakeStorage: fake, slow: make(map[string]bool)}
+	for _, method := range slow {
+		st.slow[method] = true
+	}
+	cfg := testConfig()
+	cfg.StorageTimeouts = timeouts
+	return newTestService(t, cfg, st)
+}
+
+func TestStorageTimeoutsReportDeadlineExceeded(t *testing.T) {
+	ctx := context.Background()
+	const timeout = 20 * time.Millisecond
+
+	for _, tc := range []struct {
+		name     string
+		timeouts StorageTimeoutConfig
+		method   string
+		call     func(s *Service) error
+	}{
+		{"presign", StorageTimeoutConfig{Presign: timeout}, "GeneratePresignedUploadURL", func(s *Service) error {
+			_, err := s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{ContentType: "image/png"})
+			return err
+		}},
+		{"stat", StorageTimeoutConfig{Stat: timeout}, "StatObject", func(s *Service) error {
+			_, err := s.GetObjectMetadata(ctx, &mediabase_v1.GetObjectMetadataRequest{ObjectKey: "a.png"})
+			return err
+		}},
+		{"put", StorageTimeoutConfig{Put: timeout}, "PutObject", func(s *Service) error {
+			_, err := s.PutObject(ctx, &mediabase_v1.PutObjectRequest{ContentType: "image/png", Content: []byte("png")})
+			return err
+		}},
+		{"delete", StorageTimeoutConfig{Delete: timeout}, "DeleteObject", func(s *Service) error {
+			_, err := s.DeleteObject(ctx, &mediabase_v1.DeleteObjectRequest{ObjectKey: "a.png"})
+			return err
+		}},
+	} {
+		s := timeoutTestService(t, tc.timeouts, tc.method)
+		start := time.Now()
+		err := tc.call(s)
+		if status.Code(err) != codes.DeadlineExceeded {
+			t.Errorf("%s: error = %v, want DEADLINE_EXCEEDED", tc.name, err)
+		}
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("%s: took %s, want it to give up after the timeout", tc.name, elapsed)
+		}
+	}
+}
+
+func TestStorageTimeoutsOnlyBoundTheirClass(t *testing.T) {
+	ctx := context.Background()
+	// Deletes are bounded and stats are not, so a stat that completes is unaffected by the timeout
+	s := timeoutTestService(t, StorageTimeoutConfig{Delete: time.Millisecond})
+
+	if _, err := s.GetObjectMetadata(ctx, &mediabase_v1.GetObjectMetadataRequest{ObjectKey: "a.png"}); err != nil {
+		t.Errorf("GetObjectMetadata: %v", err)
+	}
+	if _, err := s.DeleteObject(ctx, &mediabase_v1.DeleteObjectRequest{ObjectKey: "a.png"}); err != nil {
+		t.Errorf("DeleteObject within the timeout: %v", err)
+	}
+}
+
+func TestStorageTimeoutConfigValidate(t *testing.T) {
+	cfg := testConfig()
+	cfg.StorageTimeouts = StorageTimeoutConfig{Stat: -time.Second}
+	if err := cfg.Validate(); err == nil {
+		t.Error("negative timeout accepted")
+	}
+	cfg.StorageTimeouts = StorageTimeoutConfig{Stat: time.Second, Put: time.Minute}
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("valid timeouts rejected: %v", err)
+	}
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gofreego/goutils/logger"
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"github.com/gofreego/mediabase/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultTrashPrefix is used when SoftDelete.TrashPrefix is not set
+const defaultTrashPrefix = "trash/"
+
+// SoftDeleteConfig controls what DeleteObject does with deleted objects
+type SoftDeleteConfig struct {
+	// Enabled moves deleted objects under TrashPrefix instead of removing them
+	Enabled bool `yaml:"Enabled"`
+	// TrashPrefix is the key prefix of deleted objects; it must end with a slash (defaults to trash/)
+	TrashPrefix string `yaml:"TrashPrefix"`
+}
+
+// RestoreObject moves a soft-deleted object back to its original key
+func (s *Service) RestoreObject(ctx context.Context, req *mediabase_v1.RestoreObjectRequest) (*mediabase_v1.RestoreObjectResponse, error) {
+	ctx = withLogFields(ctx, req.BucketName, req.ObjectKey)
+	logDebug(ctx, "RestoreObject request received, bucket: %s, object_key: %s", req.BucketName, req.ObjectKey)
+
+	if err := s.resolveBucket(&req.BucketName); err != nil {
+		return nil, err
+	}
+
+	if !s.softDelete.Enabled {
+		return nil, status.Errorf(codes.FailedPrecondition, "soft delete is not enabled")
+	}
+	if req.ObjectKey == "" || s.inTrash(req.ObjectKey) {
+		return nil, status.Errorf(codes.InvalidArgument, "object_key must be the key the object had before it was deleted")
+	}
+
+	trashKey := s.trashKey(req.ObjectKey)
+	info, err := s.storage.StatObject(ctx, req.BucketName, trashKey)
+	if err != nil {
+		logger.Error(ctx, "Failed to stat object: %v", err)
+		return nil, storageError("failed to find object in trash", err)
+	}
+
+	// Never overwrite an object uploaded under the same key after the delete
+	exists, err := s.storage.ObjectExists(ctx, req.BucketName, req.ObjectKey)
+	if err != nil {
+		logger.Error(ctx, "Failed to check object existence: %v", err)
+		return nil, storageError("failed to check object existence", err)
+	}
+	if exists {
+		return nil, status.Errorf(codes.AlreadyExists, "object %s already exists in bucket: %s", req.ObjectKey, req.BucketName)
+	}
+
+	metadata := make(map[string]string, len(info.UserMetadata))
+	for k, v := range info.UserMetadata {
+		if k != storage.OriginalKeyMetadataKey {
+			metadata[k] = v
+		}
+	}
+	if err := s.moveObject(ctx, req.BucketName, trashKey, req.BucketName, req.ObjectKey, info, metadata); err != nil {
+		return nil, err
+	}
+
+	logDebug(ctx, "Object restored successfully: %s in bucket: %s", req.ObjectKey, req.BucketName)
+
+	return &mediabase_v1.RestoreObjectResponse{
+		ObjectKey: req.ObjectKey,
+		Size:      info.Size,
+	}, nil
+}
+
+// moveToTrash moves an object under the trash prefix, recording its original key
+func (s *Service) moveToTrash(ctx context.Context, bucketName, objectKey string) error {
+	trashKey := s.trashKey(objectKey)
+	if err := s.validateObjectKey(trashKey); err != nil {
+		return err
+	}
+
+	info, err := s.storage.StatObject(ctx, bucketName, objectKey)
+	if err != nil {
+		logger.Error(ctx, "Failed to stat object: %v", err)
+		return storageError("failed to delete object", err)
+	}
+
+	metadata := make(map[string]string, len(info.UserMetadata)+1)
+	for k, v := range info.UserMetadata {
+		metadata[k] = v
+	}
+	metadata[storage.OriginalKeyMetadataKey] = objectKey
+	return s.moveObject(ctx, bucketName, objectKey, bucketName, trashKey, info, metadata)
+}
+
+// moveObject copies an object with the given user metadata, possibly into another bucket,
+// and deletes the source. If the delete fails, the copy is left in place and the error reported.
+func (s *Service) moveObject(ctx context.Context, bucketName, srcKey, dstBucket, dstKey string, info *storage.ObjectInfo, metadata map[string]string) error {
+	opts := storage.CopyOptions{
+		DestinationBucket: dstBucket,
+		ReplaceMetadata:   true,
+		ContentType:       info.ContentType,
+		CacheControl:      metadata[storage.CacheControlMetadataKey],
+		Metadata:          metadata,
+	}
+	if err := s.storage.CopyObject(ctx, bucketName, srcKey, dstKey, opts); err != nil {
+		logger.Error(ctx, "Failed to copy object %s to %s: %v", srcKey, dstKey, err)
+		return storageError("failed to move object", err)
+	}
+	if err := s.confirmCopy(ctx, bucketName, srcKey, dstBucket, dstKey); err != nil {
+		logger.Error(ctx, "Failed to mark %s as confirmed: %v", dstKey, err)
+		return storageError("failed to move object", err)
+	}
+	if err := s.storage.DeleteObject(ctx, bucketName, srcKey); err != nil {
+		logger.Error(ctx, "Failed to delete object %s after copying it to %s: %v", srcKey, dstKey, err)
+		return storageError("failed to move object", err)
+	}
+	return nil
+}
+
+// trashKey returns the key of an object once it is in the trash
+func (s *Service) trashKey(objectKey string) string {
+	return s.softDelete.TrashPrefix + objectKey
+}
+
+// inTrash checks if an object key lies under the trash prefix
+func (s *Service) inTrash(objectKey string) bool {
+	return strings.HasPrefix(s.normalizeKey(objectKey), s.softDelete.TrashPrefix)
+}
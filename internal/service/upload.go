@@ -1,14 +1,22 @@
 package service
 
 import (
+	"cmp"
 	"context"
+	"crypto/sha256"
+	"errors"
 	"fmt"
+	"net/netip"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/gofreego/goutils/logger"
 	"github.com/gofreego/mediabase/api/mediabase_v1"
-	"github.com/google/uuid"
+	"github.com/gofreego/mediabase/internal/policy"
+	"github.com/gofreego/mediabase/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -17,123 +25,697 @@ const (
 	defaultDownloadExpiry = 3600 * time.Second // 1 hour for download
 )
 
+// defaultMaxPresignExpiry is the longest expiry S3 and MinIO accept for presigned URLs,
+// used when MaxPresignExpiry is not set
+const defaultMaxPresignExpiry = 7 * 24 * time.Hour
+
+// presignExpiry returns the expiry requested in seconds, or the default when none was requested.
+// Expiries above the maximum are rejected rather than passed to the signer, which would refuse them
+// or produce URLs storage does not honour.
+func (s *Service) presignExpiry(requested int32, defaultExpiry time.Duration) (time.Duration, error) {
+	if requested < 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "expires_in must not be negative")
+	}
+	if requested == 0 {
+		return min(defaultExpiry, s.maxPresignExpiry), nil
+	}
+	expiry := time.Duration(requested) * time.Second
+	if expiry > s.maxPresignExpiry {
+		return 0, status.Errorf(codes.InvalidArgument, "expires_in %d exceeds the maximum of %d seconds", requested, int64(s.maxPresignExpiry.Seconds()))
+	}
+	return expiry, nil
+}
+
 // PresignUpload generates a presigned URL for uploading a file
 func (s *Service) PresignUpload(ctx context.Context, req *mediabase_v1.PresignUploadRequest) (*mediabase_v1.PresignUploadResponse, error) {
-	logger.Debug(ctx, "PresignUpload request received, bucket: %s, content_type: %s, max_file_size: %d", req.BucketName, req.ContentType, req.MaxFileSize)
+	ctx = withLogFields(ctx, req.BucketName, "")
+	logDebug(ctx, "PresignUpload request received, bucket: %s, content_type: %s, content_type_prefix: %s, min_file_size: %d, max_file_size: %d", req.BucketName, req.ContentType, req.ContentTypePrefix, req.MinFileSize, req.MaxFileSize)
+
+	if err := s.resolveBucket(&req.BucketName); err != nil {
+		return nil, err
+	}
+
+	// A content type prefix leaves the exact type to the uploader, so the checks that need one
+	// are skipped and the size limit covers every type the prefix admits
+	var err error
+	if req.ContentTypePrefix != "" {
+		if req.ContentType != "" {
+			return nil, status.Errorf(codes.InvalidArgument, "content_type cannot be combined with content_type_prefix")
+		}
+		if req.Method == mediabase_v1.UploadMethod_UPLOAD_METHOD_PUT {
+			return nil, status.Errorf(codes.InvalidArgument, "content_type_prefix is only available for POST uploads")
+		}
+		if err := s.checkContentTypePrefix(req.ContentTypePrefix); err != nil {
+			return nil, err
+		}
+	} else {
+		// Validate content type in its canonical form, which is also the one that is signed and stored
+		contentType, err := s.resolveContentType(req.ContentType)
+		if err != nil {
+			return nil, err
+		}
+		req.ContentType = contentType
+		if !s.isValidContentType(req.ContentType) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid content type: %s", req.ContentType)
+		}
+	}
+
+	// Validate that the file name follows the naming rules and does not claim another content type
+	if req.FileName != "" {
+		fileName, err := s.checkFileName(ctx, req.FileName)
+		if err != nil {
+			return nil, err
+		}
+		req.FileName = fileName
+		if req.ContentTypePrefix == "" {
+			if err := s.checkFileExtension(ctx, req.FileName, req.ContentType); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Validate requested sizes; zero selects the server limits
+	if req.MaxFileSize < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "max_file_size must not be negative")
+	}
+	if req.MinFileSize < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "min_file_size must not be negative")
+	}
+
+	// Validate requested max file size against the server limit for this content type
+	maxFileSize := s.maxFileSizeFor(req.ContentType)
+	if req.ContentTypePrefix != "" {
+		maxFileSize = s.maxFileSizeForPrefix(req.ContentTypePrefix)
+	}
+	if req.MaxFileSize > maxFileSize {
+		return nil, status.Errorf(codes.InvalidArgument, "requested max file size %d exceeds server maximum allowed size %d for %s", req.MaxFileSize, maxFileSize, cmp.Or(req.ContentType, req.ContentTypePrefix+"*"))
+	}
+	if req.MaxFileSize > 0 {
+		maxFileSize = req.MaxFileSize
+	}
+
+	// Validate requested min file size against the server minimum and the max file size
+	minFileSize := s.minFileSize
+	if req.MinFileSize > 0 && req.MinFileSize < minFileSize {
+		return nil, status.Errorf(codes.InvalidArgument, "requested min file size %d is below server minimum allowed size %d", req.MinFileSize, minFileSize)
+	}
+	if req.MinFileSize > minFileSize {
+		minFileSize = req.MinFileSize
+	}
+	if minFileSize > maxFileSize {
+		return nil, status.Errorf(codes.InvalidArgument, "min file size %d exceeds max file size %d", minFileSize, maxFileSize)
+	}
+
+	// PUT URLs sign an exact Content-Length, so the caller must state the real size
+	if req.Method == mediabase_v1.UploadMethod_UPLOAD_METHOD_PUT && req.MaxFileSize <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "max_file_size must be set to the exact file size for PUT uploads")
+	}
+
+	// POST policies cannot carry preconditions
+	if req.IfNoneMatch && req.Method != mediabase_v1.UploadMethod_UPLOAD_METHOD_PUT {
+		return nil, status.Errorf(codes.InvalidArgument, "if_none_match is only available for PUT uploads")
+	}
+
+	// Validate the browser response to POST uploads
+	if err := s.validateSuccessAction(req); err != nil {
+		return nil, err
+	}
+
+	expiry, err := s.presignExpiry(req.ExpiresIn, defaultUploadExpiry)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate cache control directives
+	if req.CacheControl != "" {
+		if err := validateCacheControl(req.CacheControl); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cache control: %v", err)
+		}
+	}
+
+	// Validate expected checksum
+	if req.ChecksumSha256 != "" {
+		if err := validateHexDigest(req.ChecksumSha256, sha256.Size); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid SHA-256 checksum: %v", err)
+		}
+	}
+
+	// Validate tags
+	if len(req.Tags) > 0 {
+		if err := s.requireCapability(s.storage.Capabilities().ObjectTagging, "object tags"); err != nil {
+			return nil, err
+		}
+		if err := validateTags(req.Tags); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid tags: %v", err)
+		}
+	}
+
+	// Validate the encryption key override
+	if req.KmsKeyId != "" {
+		if err := s.requireCapability(s.storage.Capabilities().ServerSideEncryption, "KMS keys"); err != nil {
+			return nil, err
+		}
+	}
+
+	uploadOpts := storage.UploadOptions{
+		CacheControl:          req.CacheControl,
+		ChecksumSHA256:        req.ChecksumSha256,
+		Tags:                  req.Tags,
+		Metadata:              req.Metadata,
+		MetadataStartsWith:    req.MetadataStartsWith,
+		IfNoneMatch:           req.IfNoneMatch,
+		KMSKeyID:              req.KmsKeyId,
+		MinSize:               minFileSize,
+		KeyPrefixOnly:         req.PrefixOnly,
+		ContentTypePrefix:     req.ContentTypePrefix,
+		SuccessActionRedirect: req.SuccessActionRedirect,
+		SuccessActionStatus:   int(req.SuccessActionStatus),
+	}
+
+	// Validate application metadata, which shares the S3 size limit with the attributes above.
+	// Starts-with values only count with their prefix, the rest is up to the uploader.
+	if len(req.Metadata) > 0 || len(req.MetadataStartsWith) > 0 {
+		if err := validateMetadata(req.Metadata, recordedMetadataSize(uploadOpts)); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid metadata: %v", err)
+		}
+		if err := validateMetadata(req.MetadataStartsWith, recordedMetadataSize(uploadOpts)+metadataSize(req.Metadata)); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid metadata_starts_with: %v", err)
+		}
+	}
+	if err := s.validateMetadataConditions(req); err != nil {
+		return nil, err
+	}
+
+	// Prefix-constrained uploads leave the final key segment to the uploader
+	if req.PrefixOnly {
+		if req.Method == mediabase_v1.UploadMethod_UPLOAD_METHOD_PUT {
+			return nil, status.Errorf(codes.InvalidArgument, "prefix_only is only available for POST uploads")
+		}
+		if req.FileName != "" || req.Deduplicate || req.IdempotencyKey != "" {
+			return nil, status.Errorf(codes.InvalidArgument, "prefix_only cannot be combined with file_name, deduplicate or idempotency_key")
+		}
+		// Storage takes the key the uploader chooses as it is, bypassing the normalization
+		if s.keyCase != "" {
+			return nil, status.Errorf(codes.FailedPrecondition, "prefix_only is not available while object keys are case-normalized")
+		}
+	}
+
+	// Idempotency keys only affect generated names, which exact file names and hash keys replace
+	if req.IdempotencyKey != "" && (req.FileName != "" || req.Deduplicate) {
+		return nil, status.Errorf(codes.InvalidArgument, "idempotency_key cannot be combined with file_name or deduplicate")
+	}
+
+	// Deduplicated uploads are keyed by content, so an exact file name would defeat them
+	if req.Deduplicate {
+		if req.ChecksumSha256 == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "deduplicate requires checksum_sha256")
+		}
+		if req.FileName != "" {
+			return nil, status.Errorf(codes.InvalidArgument, "deduplicate cannot be combined with file_name")
+		}
+	}
+
+	// Generate object key
+	keyInput := KeyInput{
+		Path:           req.Path,
+		FileName:       req.FileName,
+		ContentType:    req.ContentType,
+		ContentSHA256:  req.ChecksumSha256,
+		IdempotencyKey: req.IdempotencyKey,
+	}
+	var objectKey string
+	if req.PrefixOnly {
+		objectKey, err = uploadKeyPrefix(req.Path)
+		if err == nil {
+			err = s.validateObjectKey(objectKey)
+		}
+	} else if req.Deduplicate {
+		objectKey, err = contentHashKeyGenerator{}.GenerateKey(keyInput)
+		if err == nil {
+			// Callers get the key as it is stored, like generated keys
+			objectKey = s.normalizeKey(objectKey)
+			err = s.validateObjectKey(objectKey)
+		}
+	} else {
+		objectKey, err = s.generateObjectKey(ctx, keyInput)
+	}
+	if err != nil {
+		return nil, err
+	}
+	ctx = withLogFields(ctx, req.BucketName, objectKey)
+
+	// Confirming an upload the orphan cleanup covers adds a tag, which must still fit
+	if len(req.Tags) >= maxObjectTags && s.orphanCleanup.covers(req.BucketName, objectKey) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tags: at most %d tags are allowed under %s, one is reserved for confirmation", maxObjectTags-1, s.orphanCleanup.cfg.Prefix)
+	}
+
+	// A dry run stops after validation, without touching storage
+	if req.DryRun {
+		logDebug(ctx, "PresignUpload dry run succeeded for object: %s in bucket: %s", objectKey, req.BucketName)
+		return &mediabase_v1.PresignUploadResponse{
+			ObjectKey: objectKey,
+			DryRun:    true,
+			FileName:  req.FileName,
+		}, nil
+	}
+
+	// Only valid requests take a token, so rejected ones cannot starve the bucket
+	if err := s.presignRate.allow(ctx, req.BucketName); err != nil {
+		return nil, err
+	}
+
+	if err := s.ensureBucket(ctx, req.BucketName); err != nil {
+		return nil, err
+	}
+
+	// Only exact file names can collide; generated names are unique
+	if req.FileName != "" {
+		objectKey, err = s.resolveCollision(ctx, req.BucketName, objectKey)
+		if err != nil {
+			return nil, err
+		}
+		ctx = withLogFields(ctx, req.BucketName, objectKey)
+	}
 
-	// Validate content type
-	if !s.isValidContentType(req.ContentType) {
-		return nil, fmt.Errorf("invalid content type: %s", req.ContentType)
+	// Identical content already stored under the hash key can be reused.
+	// Two clients uploading the same new content at once both get a URL and write the same
+	// key; the bytes are identical, so the last write wins harmlessly. A client that uploads
+	// content not matching the hash however fails ConfirmUpload, which deletes the shared
+	// object, so deduplicated uploads must always be confirmed and retried on failure.
+	if req.Deduplicate {
+		exists, err := s.storage.ObjectExists(ctx, req.BucketName, objectKey)
+		if err != nil {
+			logger.Error(ctx, "Failed to check object existence: %v", err)
+			return nil, storageError("failed to check object existence", err)
+		}
+		if exists {
+			logDebug(ctx, "Identical content already exists, reusing object: %s in bucket: %s", objectKey, req.BucketName)
+			return &mediabase_v1.PresignUploadResponse{
+				ObjectKey:    objectKey,
+				Deduplicated: true,
+			}, nil
+		}
 	}
 
-	// Validate requested max file size against server hard limit
-	if req.MaxFileSize > s.maxFileSize {
-		return nil, fmt.Errorf("requested max file size %d exceeds server maximum allowed size %d", req.MaxFileSize, s.maxFileSize)
+	// The upload may use its whole size limit, so that is what must fit in the quota. Reused
+	// content adds nothing, so it was answered above.
+	if err := s.quotas.check(ctx, req.BucketName, maxFileSize); err != nil {
+		return nil, err
 	}
 
-	// Generate unique object key
-	objectKey := generateObjectKey(req.Path, req.FileName, req.ContentType)
+	if req.Method != mediabase_v1.UploadMethod_UPLOAD_METHOD_PUT {
+		// Generate presigned URL/POST policy using the tightest applicable max size
+		// This ensures the storage provider strictly enforces this exact limit
+		presignedURL, formData, err := s.storage.GeneratePresignedUploadURL(ctx, req.BucketName, objectKey, req.ContentType, expiry, maxFileSize, uploadOpts)
+		switch {
+		case errors.Is(err, storage.ErrNotSupported) && req.Method == mediabase_v1.UploadMethod_UPLOAD_METHOD_UNSPECIFIED && !usesPostFeatures(req):
+			// Backends without POST uploads, i.e. Azure, take uploads that did not ask for POST as PUTs
+			logDebug(ctx, "Presigned POST uploads are not supported, presigning a PUT upload for object: %s in bucket: %s", objectKey, req.BucketName)
+		case err != nil:
+			logger.Error(ctx, "Failed to generate presigned upload URL: %v", err)
+			return nil, storageError("failed to generate presigned upload URL", err)
+		default:
+			presignedURL, err = s.publicPresignedURL(presignedURL, req.BucketName)
+			if err != nil {
+				logger.Error(ctx, "Failed to move presigned upload URL to the public endpoint: %v", err)
+				return nil, status.Errorf(codes.Internal, "failed to generate presigned upload URL: %v", err)
+			}
+
+			logDebug(ctx, "Presigned upload URL generated successfully for object: %s in bucket: %s", objectKey, req.BucketName)
 
-	// Generate presigned URL/POST policy using the requested max size
-	// This ensures the storage provider strictly enforces this exact limit
-	presignedURL, formData, err := s.storage.GeneratePresignedUploadURL(ctx, req.BucketName, objectKey, req.ContentType, defaultUploadExpiry, req.MaxFileSize)
+			return &mediabase_v1.PresignUploadResponse{
+				PresignedUrl: presignedURL,
+				ObjectKey:    objectKey,
+				ExpiresIn:    int32(expiry.Seconds()),
+				FormData:     formData,
+				FileName:     req.FileName,
+				Method:       mediabase_v1.UploadMethod_UPLOAD_METHOD_POST,
+			}, nil
+		}
+	}
+
+	// Where storage signs the Content-Length, i.e. on S3, it rejects any other size. Explicit
+	// PUT uploads state the exact size; fallback PUTs are only possible on backends that sign
+	// no size, where maxFileSize is the limit.
+	presignedURL, headers, err := s.storage.GeneratePresignedPutURL(ctx, req.BucketName, objectKey, req.ContentType, expiry, maxFileSize, uploadOpts)
 	if err != nil {
-		logger.Error(ctx, "Failed to generate presigned upload URL: %v", err)
-		return nil, fmt.Errorf("failed to generate presigned upload URL: %w", err)
+		logger.Error(ctx, "Failed to generate presigned put URL: %v", err)
+		return nil, storageError("failed to generate presigned put URL", err)
+	}
+	presignedURL, err = s.publicPresignedURL(presignedURL, req.BucketName)
+	if err != nil {
+		logger.Error(ctx, "Failed to move presigned put URL to the public endpoint: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to generate presigned put URL: %v", err)
+	}
+
+	// Storage that signs no headers cannot enforce these, so ConfirmUpload checks them
+	constraints := putUploadRecord{ContentType: req.ContentType, MinSize: minFileSize, MaxSize: maxFileSize}
+	if req.Method == mediabase_v1.UploadMethod_UPLOAD_METHOD_PUT {
+		constraints.MinSize = maxFileSize
+	}
+	if err := s.recordPutUpload(ctx, req.BucketName, objectKey, constraints, expiry); err != nil {
+		return nil, err
 	}
 
-	logger.Debug(ctx, "Presigned upload URL generated successfully for object: %s in bucket: %s", objectKey, req.BucketName)
+	logDebug(ctx, "Presigned put URL generated successfully for object: %s in bucket: %s", objectKey, req.BucketName)
 
 	return &mediabase_v1.PresignUploadResponse{
 		PresignedUrl: presignedURL,
 		ObjectKey:    objectKey,
-		ExpiresIn:    int32(defaultUploadExpiry.Seconds()),
-		FormData:     formData,
+		ExpiresIn:    int32(expiry.Seconds()),
+		Headers:      headers,
+		FileName:     req.FileName,
+		Method:       mediabase_v1.UploadMethod_UPLOAD_METHOD_PUT,
 	}, nil
 }
 
+// usesPostFeatures reports whether an upload asks for features only POST policies can express
+func usesPostFeatures(req *mediabase_v1.PresignUploadRequest) bool {
+	return req.ContentTypePrefix != "" || req.PrefixOnly || len(req.MetadataStartsWith) > 0 ||
+		req.SuccessActionRedirect != "" || req.SuccessActionStatus != 0
+}
+
+// uploadKeyPrefix returns the key prefix of a prefix-constrained upload to path, with a trailing slash
+func uploadKeyPrefix(path string) (string, error) {
+	prefix := strings.Trim(filepath.Join(path, ""), "/")
+	if prefix == "" || prefix == "." {
+		return "", status.Errorf(codes.InvalidArgument, "prefix_only requires a path naming a folder")
+	}
+	return prefix + "/", nil
+}
+
 // PresignDownload generates a presigned URL for downloading a file
 func (s *Service) PresignDownload(ctx context.Context, req *mediabase_v1.PresignDownloadRequest) (*mediabase_v1.PresignDownloadResponse, error) {
-	logger.Debug(ctx, "PresignDownload request received, bucket: %s, object_key: %s", req.BucketName, req.ObjectKey)
+	ctx = withLogFields(ctx, req.BucketName, req.ObjectKey)
+	logDebug(ctx, "PresignDownload request received, bucket: %s, object_key: %s, version_id: %s", req.BucketName, req.ObjectKey, req.VersionId)
+
+	if err := s.resolveBucket(&req.BucketName); err != nil {
+		return nil, err
+	}
 
-	// Check if object exists
-	exists, err := s.storage.ObjectExists(ctx, req.BucketName, req.ObjectKey)
+	if req.CacheControl != "" {
+		if err := validateCacheControl(req.CacheControl); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cache control: %v", err)
+		}
+	}
+
+	if req.ContentType != "" {
+		if err := validateMediaType(req.ContentType); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid content type override: %v", err)
+		}
+	}
+
+	if req.AllowedClientIp != "" {
+		clientIP, err := s.validateAllowedClientIP(req.AllowedClientIp)
+		if err != nil {
+			return nil, err
+		}
+		req.AllowedClientIp = clientIP
+	}
+
+	expiry, err := s.presignExpiry(req.ExpiresIn, defaultDownloadExpiry)
 	if err != nil {
-		logger.Error(ctx, "Failed to check object existence: %v", err)
-		return nil, fmt.Errorf("failed to check object existence: %w", err)
+		return nil, err
+	}
+
+	// The proxy serves the latest version with its recorded headers
+	if req.Proxy {
+		if s.proxyDownload.secret == nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "proxy downloads need ProxyDownload.Secret to be configured")
+		}
+		if req.VersionId != "" || req.CacheControl != "" || req.ContentType != "" || req.AllowedClientIp != "" {
+			return nil, status.Errorf(codes.InvalidArgument, "proxy cannot be combined with version_id, cache_control, content_type or allowed_client_ip")
+		}
 	}
 
-	if !exists {
-		return nil, fmt.Errorf("object not found: %s in bucket: %s", req.ObjectKey, req.BucketName)
+	// Only valid requests take a token, so rejected ones cannot starve the bucket
+	if err := s.presignRate.allow(ctx, req.BucketName); err != nil {
+		return nil, err
+	}
+
+	if req.Proxy {
+		if _, err := s.StatObject(ctx, req.BucketName, req.ObjectKey); err != nil {
+			return nil, err
+		}
+		logDebug(ctx, "Signed proxy download URL generated for object: %s", req.ObjectKey)
+		s.logAccess(ctx, AccessPresignDownload, req.BucketName, req.ObjectKey, "", 0)
+		return &mediabase_v1.PresignDownloadResponse{
+			PresignedUrl: s.signProxyDownload(req.BucketName, req.ObjectKey, expiry),
+			ExpiresIn:    int32(expiry.Seconds()),
+		}, nil
+	}
+
+	cacheControl := req.CacheControl
+	if req.VersionId != "" {
+		// A specific version may still exist after the latest one was deleted
+		if err := s.requireVersion(ctx, req.BucketName, req.ObjectKey, req.VersionId); err != nil {
+			return nil, err
+		}
+	} else {
+		// Check that the object exists and pick up the Cache-Control value recorded at upload time,
+		// which presigned POST uploads can only store as metadata
+		info, err := s.StatObject(ctx, req.BucketName, req.ObjectKey)
+		if err != nil {
+			return nil, err
+		}
+		if cacheControl == "" {
+			cacheControl = info.UserMetadata[storage.CacheControlMetadataKey]
+		}
 	}
 
 	// Generate presigned URL
-	presignedURL, err := s.storage.GeneratePresignedDownloadURL(ctx, req.BucketName, req.ObjectKey, defaultDownloadExpiry)
+	presignedURL, err := s.storage.GeneratePresignedDownloadURL(ctx, req.BucketName, req.ObjectKey, expiry, storage.DownloadOptions{
+		VersionID:       req.VersionId,
+		CacheControl:    cacheControl,
+		ContentType:     req.ContentType,
+		AllowedClientIP: req.AllowedClientIp,
+	})
 	if err != nil {
 		logger.Error(ctx, "Failed to generate presigned download URL: %v", err)
-		return nil, fmt.Errorf("failed to generate presigned download URL: %w", err)
+		return nil, storageError("failed to generate presigned download URL", err)
+	}
+	if s.cdnBaseURL != "" {
+		presignedURL, err = rebaseURL(presignedURL, s.cdnBaseURL)
+		if err != nil {
+			logger.Error(ctx, "Failed to rebase presigned download URL: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to generate presigned download URL: %v", err)
+		}
+	} else {
+		presignedURL, err = s.publicPresignedURL(presignedURL, req.BucketName)
+		if err != nil {
+			logger.Error(ctx, "Failed to move presigned download URL to the public endpoint: %v", err)
+			return nil, status.Errorf(codes.Internal, "failed to generate presigned download URL: %v", err)
+		}
 	}
 
-	logger.Debug(ctx, "Presigned download URL generated successfully for object: %s", req.ObjectKey)
+	logDebug(ctx, "Presigned download URL generated successfully for object: %s", req.ObjectKey)
+	s.logAccess(ctx, AccessPresignDownload, req.BucketName, req.ObjectKey, req.VersionId, 0)
 
 	return &mediabase_v1.PresignDownloadResponse{
 		PresignedUrl: presignedURL,
-		ExpiresIn:    int32(defaultDownloadExpiry.Seconds()),
+		ExpiresIn:    int32(expiry.Seconds()),
 	}, nil
 }
 
-// DeleteObject deletes a file from storage
+// DeleteObject deletes a file from storage. Retries carrying the idempotency key of an earlier
+// delete get its response.
 func (s *Service) DeleteObject(ctx context.Context, req *mediabase_v1.DeleteObjectRequest) (*mediabase_v1.DeleteObjectResponse, error) {
-	logger.Debug(ctx, "DeleteObject request received, bucket: %s, object_key: %s", req.BucketName, req.ObjectKey)
+	return idempotent(ctx, s, "DeleteObject", req.IdempotencyKey, req, &mediabase_v1.DeleteObjectResponse{}, func() (*mediabase_v1.DeleteObjectResponse, error) {
+		return s.deleteObject(ctx, req)
+	})
+}
+
+func (s *Service) deleteObject(ctx context.Context, req *mediabase_v1.DeleteObjectRequest) (*mediabase_v1.DeleteObjectResponse, error) {
+	ctx = withLogFields(ctx, req.BucketName, req.ObjectKey)
+	logDebug(ctx, "DeleteObject request received, bucket: %s, object_key: %s, version_id: %s, fail_if_missing: %v", req.BucketName, req.ObjectKey, req.VersionId, req.FailIfMissing)
+
+	if err := s.resolveBucket(&req.BucketName); err != nil {
+		return nil, err
+	}
+
+	// Storage deletes are idempotent, so a missing object is only reported when asked for
+	if req.FailIfMissing || s.failDeleteIfMissing {
+		if err := s.requireDeletable(ctx, req.BucketName, req.ObjectKey, req.VersionId); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.VersionId != "" {
+		if err := s.requireCapability(s.storage.Capabilities().Versioning, "object versions"); err != nil {
+			return nil, err
+		}
+
+		// Permanently delete the specific version
+		err := s.storage.DeleteObjectVersion(ctx, req.BucketName, req.ObjectKey, req.VersionId)
+		if err != nil {
+			logger.Error(ctx, "Failed to delete object version: %v", err)
+			return nil, storageError("failed to delete object version", err)
+		}
+
+		logDebug(ctx, "Object version deleted successfully: %s, version_id: %s", req.ObjectKey, req.VersionId)
+		s.logAccess(ctx, AccessDelete, req.BucketName, req.ObjectKey, req.VersionId, 0)
+
+		return &mediabase_v1.DeleteObjectResponse{
+			Success: true,
+		}, nil
+	}
+
+	// Objects already in the trash are deleted for good
+	if s.softDelete.Enabled && !s.inTrash(req.ObjectKey) {
+		if err := s.moveToTrash(ctx, req.BucketName, req.ObjectKey); err != nil {
+			return nil, err
+		}
+
+		logDebug(ctx, "Object moved to trash: %s", req.ObjectKey)
+		s.logAccess(ctx, AccessDelete, req.BucketName, req.ObjectKey, "", 0)
+
+		return &mediabase_v1.DeleteObjectResponse{
+			Success: true,
+		}, nil
+	}
 
 	// Delete the object
 	err := s.storage.DeleteObject(ctx, req.BucketName, req.ObjectKey)
 	if err != nil {
 		logger.Error(ctx, "Failed to delete object: %v", err)
-		return nil, fmt.Errorf("failed to delete object: %w", err)
+		return nil, storageError("failed to delete object", err)
 	}
 
-	logger.Debug(ctx, "Object deleted successfully: %s", req.ObjectKey)
+	logDebug(ctx, "Object deleted successfully: %s", req.ObjectKey)
+	s.logAccess(ctx, AccessDelete, req.BucketName, req.ObjectKey, "", 0)
 
 	return &mediabase_v1.DeleteObjectResponse{
 		Success: true,
 	}, nil
 }
 
-// CreateBucket creates a bucket and optionally sets it to public read
+// requireDeletable checks that the object, or the given version of it, exists before a delete.
+// Unlike requireVersion it accepts delete markers, since those can be deleted too.
+func (s *Service) requireDeletable(ctx context.Context, bucketName, objectKey, versionID string) error {
+	if versionID == "" {
+		exists, err := s.storage.ObjectExists(ctx, bucketName, objectKey)
+		if err != nil {
+			logger.Error(ctx, "Failed to check object existence: %v", err)
+			return storageError("failed to check object existence", err)
+		}
+		if !exists {
+			return status.Errorf(codes.NotFound, "object %s not found in bucket: %s", objectKey, bucketName)
+		}
+		return nil
+	}
+
+	if err := s.requireCapability(s.storage.Capabilities().Versioning, "object versions"); err != nil {
+		return err
+	}
+	versions, err := s.storage.ListObjectVersions(ctx, bucketName, objectKey)
+	if err != nil {
+		logger.Error(ctx, "Failed to list object versions: %v", err)
+		return storageError("failed to list object versions", err)
+	}
+	for _, v := range versions {
+		if v.VersionID == versionID {
+			return nil
+		}
+	}
+	return status.Errorf(codes.NotFound, "version %s of object %s not found in bucket: %s", versionID, objectKey, bucketName)
+}
+
+// CreateBucket creates a bucket and optionally applies an access policy
 func (s *Service) CreateBucket(ctx context.Context, req *mediabase_v1.CreateBucketRequest) (*mediabase_v1.CreateBucketResponse, error) {
-	logger.Debug(ctx, "CreateBucket request received, bucket_name: %s, is_public: %v", req.BucketName, req.IsPublic)
+	ctx = withLogFields(ctx, req.BucketName, "")
+	logDebug(ctx, "CreateBucket request received, bucket_name: %s, is_public: %v, policy: %s, enable_versioning: %v, enable_object_locking: %v", req.BucketName, req.IsPublic, req.Policy, req.EnableVersioning, req.EnableObjectLocking)
+
+	if err := s.validateBucket(req.BucketName); err != nil {
+		return nil, err
+	}
+
+	// Reject unsupported options before creating anything
+	template, hasPolicy, err := bucketPolicyTemplate(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var bucketPolicy string
+	if hasPolicy {
+		if err := s.requireCapability(s.storage.Capabilities().BucketPolicy, "bucket policies"); err != nil {
+			return nil, err
+		}
+		bucketPolicy, err = policy.Build(template, req.BucketName, req.PolicyPrefix)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid bucket policy: %v", err)
+		}
+	}
+	if req.EnableVersioning {
+		if err := s.requireCapability(s.storage.Capabilities().Versioning, "object versions"); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.EnableObjectLocking {
+		if err := s.requireCapability(s.storage.Capabilities().ObjectLock, "object locks"); err != nil {
+			return nil, err
+		}
+		// Storage leaves an existing bucket as it is, so it would silently stay unlocked
+		exists, err := s.storage.BucketExists(ctx, req.BucketName)
+		if err != nil {
+			logger.Error(ctx, "Failed to check bucket existence: %v", err)
+			return nil, storageError("failed to check bucket existence", err)
+		}
+		if exists {
+			return nil, status.Errorf(codes.FailedPrecondition, "bucket %s already exists, object locking can only be enabled when a bucket is created", req.BucketName)
+		}
+	}
+
+	var corsRule storage.CORSRule
+	if req.Cors != nil {
+		if err := s.requireCapability(s.storage.Capabilities().CORS, "bucket CORS rules"); err != nil {
+			return nil, err
+		}
+		rule, err := s.corsRule(req.Cors)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cors: %v", err)
+		}
+		corsRule = rule
+	}
 
 	// Create bucket if it doesn't exist
-	err := s.storage.CreateBucket(ctx, req.BucketName)
+	err = s.storage.CreateBucket(ctx, req.BucketName, storage.BucketOptions{ObjectLocking: req.EnableObjectLocking})
 	if err != nil {
 		logger.Error(ctx, "Failed to create bucket: %v", err)
-		return nil, fmt.Errorf("failed to create bucket: %w", err)
-	}
-
-	if req.IsPublic {
-		// Set public read policy
-		// This policy allows s3:GetObject for all principals on all objects in the bucket
-		policy := fmt.Sprintf(`{
-			"Version": "2012-10-17",
-			"Statement": [
-				{
-					"Effect": "Allow",
-					"Principal": {"AWS": ["*"]},
-					"Action": ["s3:GetObject"],
-					"Resource": ["arn:aws:s3:::%s/*"]
-				}
-			]
-		}`, req.BucketName)
-
-		err = s.storage.SetBucketPolicy(ctx, req.BucketName, policy)
+		return nil, storageError("failed to create bucket", err)
+	}
+	s.buckets.add(req.BucketName)
+
+	if hasPolicy {
+		err = s.storage.SetBucketPolicy(ctx, req.BucketName, bucketPolicy)
 		if err != nil {
 			logger.Error(ctx, "Failed to set bucket policy: %v", err)
-			return nil, fmt.Errorf("failed to set bucket policy: %w", err)
+			return nil, storageError("failed to set bucket policy", err)
 		}
-		logger.Debug(ctx, "Bucket created and policy set to public read: %s", req.BucketName)
+		logDebug(ctx, "Bucket created and policy set to %s: %s", template, req.BucketName)
 	} else {
-		logger.Debug(ctx, "Bucket created with private policy: %s", req.BucketName)
+		logDebug(ctx, "Bucket created with private policy: %s", req.BucketName)
+	}
+
+	if req.EnableVersioning {
+		err = s.storage.EnableVersioning(ctx, req.BucketName)
+		if err != nil {
+			logger.Error(ctx, "Failed to enable bucket versioning: %v", err)
+			return nil, storageError("failed to enable bucket versioning", err)
+		}
+		logDebug(ctx, "Bucket versioning enabled: %s", req.BucketName)
+	}
+
+	if req.Cors != nil {
+		err = s.storage.SetBucketCORS(ctx, req.BucketName, []storage.CORSRule{corsRule})
+		if err != nil {
+			logger.Error(ctx, "Failed to set bucket CORS: %v", err)
+			if errors.Is(err, storage.ErrNotSupported) {
+				return nil, status.Errorf(codes.Unimplemented, "bucket %s was created, but the storage backend does not support bucket CORS rules", req.BucketName)
+			}
+			return nil, storageError("failed to set bucket CORS", err)
+		}
+		logDebug(ctx, "Bucket CORS set: %s, origins: %v", req.BucketName, corsRule.AllowedOrigins)
 	}
 
 	return &mediabase_v1.CreateBucketResponse{
@@ -141,39 +723,111 @@ func (s *Service) CreateBucket(ctx context.Context, req *mediabase_v1.CreateBuck
 	}, nil
 }
 
+// DeleteBucket removes an empty bucket and forgets that it exists, so the next upload to it
+// checks storage again and may auto-create it
+func (s *Service) DeleteBucket(ctx context.Context, req *mediabase_v1.DeleteBucketRequest) (*mediabase_v1.DeleteBucketResponse, error) {
+	ctx = withLogFields(ctx, req.BucketName, "")
+	logDebug(ctx, "DeleteBucket request received, bucket_name: %s", req.BucketName)
+
+	if err := s.validateBucket(req.BucketName); err != nil {
+		return nil, err
+	}
+
+	// Forgotten even if the delete fails, since storage may have removed the bucket anyway
+	err := s.storage.DeleteBucket(ctx, req.BucketName)
+	s.buckets.forget(req.BucketName)
+	if err != nil {
+		logger.Error(ctx, "Failed to delete bucket: %v", err)
+		return nil, storageError("failed to delete bucket", err)
+	}
+
+	logDebug(ctx, "Bucket deleted: %s", req.BucketName)
+
+	return &mediabase_v1.DeleteBucketResponse{
+		Success: true,
+	}, nil
+}
+
 // Helper functions
 
-// isValidContentType checks if the content type is allowed
-func (s *Service) isValidContentType(contentType string) bool {
-	return s.allowedContentTypes[contentType]
+// requireCapability returns an Unimplemented error when the storage backend lacks the named feature
+func (s *Service) requireCapability(supported bool, feature string) error {
+	if !supported {
+		return status.Errorf(codes.Unimplemented, "%s are not supported by the configured storage backend", feature)
+	}
+	return nil
 }
 
-// generateObjectKey creates a unique object key with proper extension under the given path
-func generateObjectKey(path, fileName, contentType string) string {
-	var name string
-	if fileName != "" {
-		name = fileName
-	} else {
-		// Generate UUID for unique filename
-		id := uuid.New().String()
-
-		// Determine file extension based on content type
-		var ext string
-		switch contentType {
-		case "image/jpeg":
-			ext = ".jpg"
-		case "image/png":
-			ext = ".png"
-		case "image/webp":
-			ext = ".webp"
-		default:
-			ext = ".bin"
+// validateAllowedClientIP checks that a download can be restricted to a client IP and returns
+// the address in canonical form. Behind a CDN storage sees the CDN's address instead of the
+// client's, so the restriction would lock out everyone.
+func (s *Service) validateAllowedClientIP(clientIP string) (string, error) {
+	if err := s.requireCapability(s.storage.Capabilities().ClientIPRestriction, "client IP restrictions"); err != nil {
+		return "", err
+	}
+	ip, err := netip.ParseAddr(clientIP)
+	if err != nil || ip.Zone() != "" {
+		return "", status.Errorf(codes.InvalidArgument, "invalid allowed_client_ip: %s", clientIP)
+	}
+	if s.cdnBaseURL != "" {
+		return "", status.Errorf(codes.FailedPrecondition, "client IP restrictions cannot be enforced for downloads served through the CDN")
+	}
+	return ip.Unmap().String(), nil
+}
+
+// bucketPolicyTemplate selects the policy template requested for a new bucket.
+// is_public is kept as shorthand for the public-read template.
+func bucketPolicyTemplate(req *mediabase_v1.CreateBucketRequest) (policy.Template, bool, error) {
+	switch req.Policy {
+	case mediabase_v1.BucketPolicy_BUCKET_POLICY_UNSPECIFIED:
+		if req.IsPublic {
+			return policy.PublicRead, true, nil
 		}
-		name = id + ext
+		return "", false, nil
+	case mediabase_v1.BucketPolicy_BUCKET_POLICY_PUBLIC_READ:
+		return policy.PublicRead, true, nil
+	case mediabase_v1.BucketPolicy_BUCKET_POLICY_READ_WRITE:
+		return policy.ReadWrite, true, nil
+	case mediabase_v1.BucketPolicy_BUCKET_POLICY_READ_ONLY_PREFIX:
+		return policy.ReadOnlyPrefix, true, nil
+	}
+	return "", false, status.Errorf(codes.InvalidArgument, "unsupported bucket policy: %s", req.Policy)
+}
+
+// corsRule builds the bucket CORS rule for a request, falling back to the configured defaults
+func (s *Service) corsRule(req *mediabase_v1.CorsRule) (storage.CORSRule, error) {
+	rule := storage.CORSRule{
+		AllowedOrigins: s.cors.AllowedOrigins,
+		AllowedMethods: s.cors.AllowedMethods,
+		AllowedHeaders: s.cors.AllowedHeaders,
+		ExposeHeaders:  s.cors.ExposeHeaders,
+		MaxAgeSeconds:  s.cors.MaxAgeSeconds,
+	}
+	if len(req.AllowedOrigins) > 0 {
+		rule.AllowedOrigins = req.AllowedOrigins
+	}
+	if len(req.AllowedMethods) > 0 {
+		rule.AllowedMethods = req.AllowedMethods
+	}
+	if len(req.AllowedHeaders) > 0 {
+		rule.AllowedHeaders = req.AllowedHeaders
 	}
 
-	if path != "" {
-		return filepath.Join(path, name)
+	if len(rule.AllowedOrigins) == 0 {
+		return rule, fmt.Errorf("no allowed origins given and none configured")
+	}
+	if err := validateCORSMethods(rule.AllowedMethods); err != nil {
+		return rule, err
+	}
+	return rule, nil
+}
+
+// isValidContentType checks if the content type is allowed, exactly or by a wildcard entry such
+// as "image/*"
+func (s *Service) isValidContentType(contentType string) bool {
+	if s.allowedContentTypes[contentType] {
+		return true
 	}
-	return name
+	mediaType, _, ok := strings.Cut(contentType, "/")
+	return ok && s.allowedContentTypes[mediaType+"/*"]
 }
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"github.com/gofreego/mediabase/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPresignUploadReportsMethod(t *testing.T) {
+	ctx := context.Background()
+	s := newTestService(t, testConfig(), newFakeStorage("media"))
+
+	resp, err := s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{ContentType: "image/png"})
+	if err != nil {
+		t.Fatalf("PresignUpload: %v", err)
+	}
+	if resp.Method != mediabase_v1.UploadMethod_UPLOAD_METHOD_POST || resp.FormData == nil {
+		t.Errorf("default upload got method %s, want POST with form data", resp.Method)
+	}
+
+	resp, err = s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{ContentType: "image/png", MaxFileSize: 100, Method: mediabase_v1.UploadMethod_UPLOAD_METHOD_PUT})
+	if err != nil {
+		t.Fatalf("PresignUpload: %v", err)
+	}
+	if resp.Method != mediabase_v1.UploadMethod_UPLOAD_METHOD_PUT {
+		t.Errorf("PUT upload got method %s", resp.Method)
+	}
+}
+
+func TestPresignUploadFallsBackToPutWithoutPost(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	fake.errs["GeneratePresignedUploadURL"] = fmt.Errorf("%w: presigned POST uploads", storage.ErrNotSupported)
+	s := newTestService(t, testConfig(), fake)
+
+	resp, err := s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{ContentType: "image/png"})
+	if err != nil {
+		t.Fatalf("PresignUpload: %v", err)
+	}
+	if resp.Method != mediabase_v1.UploadMethod_UPLOAD_METHOD_PUT || resp.Headers["Content-Type"] != "image/png" || resp.PresignedUrl == "" {
+		t.Errorf("default upload got %+v, want a PUT upload", resp)
+	}
+	if got := fake.maxSizes[resp.ObjectKey]; got != 1<<20 {
+		t.Errorf("fallback PUT limit = %d, want the server maximum", got)
+	}
+
+	for _, tc := range []struct {
+		name string
+		req  *mediabase_v1.PresignUploadRequest
+	}{
+		{"explicit POST", &mediabase_v1.PresignUploadRequest{ContentType: "image/png", Method: mediabase_v1.UploadMethod_UPLOAD_METHOD_POST}},
+		{"metadata starts-with", &mediabase_v1.PresignUploadRequest{ContentType: "image/png", MetadataStartsWith: map[string]string{"owner": "team-"}}},
+		{"success action", &mediabase_v1.PresignUploadRequest{ContentType: "image/png", SuccessActionStatus: 201}},
+	} {
+		if _, err := s.PresignUpload(ctx, tc.req); status.Code(err) != codes.Unimplemented {
+			t.Errorf("%s: error = %v, want UNIMPLEMENTED", tc.name, err)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package service
+
+import (
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gofreego/mediabase/internal/policy"
+	"github.com/gofreego/mediabase/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validateBucketName rejects bucket names that break the S3 naming rules before they reach storage
+func validateBucketName(name string) error {
+	if err := policy.ValidateBucketName(name); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid bucket_name: %v", err)
+	}
+	return nil
+}
+
+// resolveBucket fills in the configured default bucket when name is empty and validates the result
+func (s *Service) resolveBucket(name *string) error {
+	if *name == "" {
+		*name = s.defaultBucket
+	}
+	if *name == "" {
+		return status.Errorf(codes.InvalidArgument, "bucket_name is required when no default bucket is configured")
+	}
+	return s.validateBucket(*name)
+}
+
+// validateBucket checks a bucket name and, when an allow-list is configured, that the bucket is on it
+func (s *Service) validateBucket(name string) error {
+	if err := validateBucketName(name); err != nil {
+		return err
+	}
+	if len(s.allowedBuckets) > 0 && !s.allowedBuckets[name] {
+		return status.Errorf(codes.PermissionDenied, "bucket is not allowed: %s", name)
+	}
+	return nil
+}
+
+// cacheControlDirectives lists the response directives accepted in a Cache-Control value
+// and whether each one requires a numeric (delta-seconds) argument
+var cacheControlDirectives = map[string]bool{
+	"public":                 false,
+	"private":                false,
+	"no-cache":               false,
+	"no-store":               false,
+	"no-transform":           false,
+	"must-revalidate":        false,
+	"proxy-revalidate":       false,
+	"must-understand":        false,
+	"immutable":              false,
+	"max-age":                true,
+	"s-maxage":               true,
+	"stale-while-revalidate": true,
+	"stale-if-error":         true,
+}
+
+// validateCacheControl checks that the value is a well-formed list of known Cache-Control directives
+func validateCacheControl(value string) error {
+	for _, part := range strings.Split(value, ",") {
+		directive := strings.TrimSpace(part)
+		if directive == "" {
+			return fmt.Errorf("empty directive in %q", value)
+		}
+
+		name, arg, hasArg := strings.Cut(directive, "=")
+		name = strings.ToLower(strings.TrimSpace(name))
+		numeric, known := cacheControlDirectives[name]
+		if !known {
+			return fmt.Errorf("unsupported directive %q", name)
+		}
+
+		if numeric {
+			if !hasArg {
+				return fmt.Errorf("directive %q requires a value", name)
+			}
+			if _, err := strconv.ParseUint(strings.TrimSpace(arg), 10, 32); err != nil {
+				return fmt.Errorf("directive %q requires a non-negative integer value", name)
+			}
+		} else if hasArg {
+			return fmt.Errorf("directive %q does not take a value", name)
+		}
+	}
+	return nil
+}
+
+// validateMediaType checks that the value is a well-formed type/subtype media type, optionally with parameters
+func validateMediaType(value string) error {
+	mediaType, _, err := mime.ParseMediaType(value)
+	if err != nil {
+		return err
+	}
+	if typ, subtype, ok := strings.Cut(mediaType, "/"); !ok || typ == "" || subtype == "" {
+		return fmt.Errorf("%q is not of the form type/subtype", value)
+	}
+	return nil
+}
+
+// validateHexDigest checks that the value is a hex-encoded digest of the given byte size
+func validateHexDigest(value string, size int) error {
+	sum, err := hex.DecodeString(value)
+	if err != nil || len(sum) != size {
+		return fmt.Errorf("expected %d hex characters, got %q", size*2, value)
+	}
+	return nil
+}
+
+// S3 object tagging limits
+const (
+	maxObjectTags     = 10
+	maxTagKeyLength   = 128
+	maxTagValueLength = 256
+)
+
+// tagCharacters matches the characters S3 allows in tag keys and values
+var tagCharacters = regexp.MustCompile(`^[\p{L}\p{Z}\p{N}_.:/=+\-@]*$`)
+
+// validateTags checks tags against S3 tagging limits
+func validateTags(tags map[string]string) error {
+	if len(tags) > maxObjectTags {
+		return fmt.Errorf("at most %d tags are allowed, got %d", maxObjectTags, len(tags))
+	}
+	for k, v := range tags {
+		if k == confirmedTagKey {
+			return fmt.Errorf("tag key %q is reserved", k)
+		}
+		if k == "" || utf8.RuneCountInString(k) > maxTagKeyLength {
+			return fmt.Errorf("tag key %q must be between 1 and %d characters", k, maxTagKeyLength)
+		}
+		if utf8.RuneCountInString(v) > maxTagValueLength {
+			return fmt.Errorf("value of tag %q must be at most %d characters", k, maxTagValueLength)
+		}
+		if !tagCharacters.MatchString(k) || !tagCharacters.MatchString(v) {
+			return fmt.Errorf("tag %q contains unsupported characters", k)
+		}
+	}
+	return nil
+}
+
+// maxUserMetadataSize is the S3 limit on the combined size of user metadata keys and values
+const maxUserMetadataSize = 2048
+
+// metadataKeyPattern matches metadata keys that survive header canonicalization unchanged
+var metadataKeyPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// reservedMetadataKeys are recorded by the service itself and cannot be set by callers
+var reservedMetadataKeys = map[string]bool{
+	storage.CacheControlMetadataKey:   true,
+	storage.ChecksumSHA256MetadataKey: true,
+	storage.TagsMetadataKey:           true,
+	storage.OriginalKeyMetadataKey:    true,
+}
+
+// validateMetadata checks application metadata against S3 limits. reservedSize is the space
+// already taken by metadata the service records itself, which counts towards the same limit.
+func validateMetadata(metadata map[string]string, reservedSize int) error {
+	size := reservedSize
+	for k, v := range metadata {
+		if !metadataKeyPattern.MatchString(k) {
+			return fmt.Errorf("metadata key %q must contain only lower-case letters, digits and hyphens", k)
+		}
+		if reservedMetadataKeys[k] {
+			return fmt.Errorf("metadata key %q is reserved", k)
+		}
+		for _, r := range v {
+			if r < 0x20 || r > 0x7e {
+				return fmt.Errorf("value of metadata key %q must contain only printable ASCII characters", k)
+			}
+		}
+		size += len(k) + len(v)
+	}
+	if size > maxUserMetadataSize {
+		return fmt.Errorf("metadata takes %d bytes, at most %d are allowed including cache control, checksum and tags", size, maxUserMetadataSize)
+	}
+	return nil
+}
+
+// metadataSize returns the user metadata space taken by application metadata
+func metadataSize(metadata map[string]string) int {
+	size := 0
+	for k, v := range metadata {
+		size += len(k) + len(v)
+	}
+	return size
+}
+
+// recordedMetadataSize returns the user metadata space taken by the attributes a presigned upload records
+func recordedMetadataSize(opts storage.UploadOptions) int {
+	size := 0
+	if opts.CacheControl != "" {
+		size += len(storage.CacheControlMetadataKey) + len(opts.CacheControl)
+	}
+	if opts.ChecksumSHA256 != "" {
+		size += len(storage.ChecksumSHA256MetadataKey) + len(opts.ChecksumSHA256)
+	}
+	if len(opts.Tags) > 0 {
+		size += len(storage.TagsMetadataKey) + len(storage.EncodeTags(opts.Tags))
+	}
+	return size
+}
+
+// corsMethods lists the HTTP methods S3 accepts in a CORS rule
+var corsMethods = map[string]bool{
+	"GET":    true,
+	"PUT":    true,
+	"POST":   true,
+	"DELETE": true,
+	"HEAD":   true,
+}
+
+// validateCORSMethods checks that every method is allowed in an S3 CORS rule
+func validateCORSMethods(methods []string) error {
+	for _, m := range methods {
+		if !corsMethods[m] {
+			return fmt.Errorf("unsupported CORS method %q", m)
+		}
+	}
+	return nil
+}
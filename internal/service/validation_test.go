@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/gofreego/mediabase/api/mediabase_v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidateCacheControl(t *testing.T) {
+	for _, value := range []string{
+		"public, max-age=31536000, immutable",
+		"no-store",
+		"Private,Max-Age=0",
+		"s-maxage=60, stale-while-revalidate=30",
+	} {
+		if err := validateCacheControl(value); err != nil {
+			t.Errorf("%q rejected: %v", value, err)
+		}
+	}
+	for _, value := range []string{
+		"",
+		"public,,max-age=1",
+		"max-age",
+		"max-age=-1",
+		"max-age=soon",
+		"immutable=1",
+		"x-custom",
+		"public\r\nX-Injected: 1",
+	} {
+		if err := validateCacheControl(value); err == nil {
+			t.Errorf("%q accepted", value)
+		}
+	}
+}
+
+func TestPresignUploadCacheControl(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	s := newTestService(t, testConfig(), fake)
+
+	resp, err := s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{BucketName: "media", ContentType: "image/png", CacheControl: "public, max-age=86400"})
+	if err != nil {
+		t.Fatalf("PresignUpload: %v", err)
+	}
+	if got := fake.uploadOptions[resp.ObjectKey].CacheControl; got != "public, max-age=86400" {
+		t.Errorf("cache control passed to storage = %q", got)
+	}
+
+	_, err = s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{BucketName: "media", ContentType: "image/png", CacheControl: "forever"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("invalid cache control: error = %v, want INVALID_ARGUMENT", err)
+	}
+	if got := fake.callCount("GeneratePresignedUploadURL"); got != 1 {
+		t.Errorf("storage presigned %d uploads, want the invalid one rejected first", got)
+	}
+}
+
+func TestPutObjectStoresCacheControl(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	s := newTestService(t, testConfig(), fake)
+
+	resp, err := s.PutObject(ctx, &mediabase_v1.PutObjectRequest{BucketName: "media", FileName: "a.png", ContentType: "image/png", Content: []byte("png"), CacheControl: "public, max-age=60"})
+	if err != nil {
+		t.Fatalf("PutObject: %v", err)
+	}
+	info, err := s.StatObject(ctx, "media", resp.ObjectKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := info.UserMetadata["cache-control"]; got != "public, max-age=60" {
+		t.Errorf("stored cache control = %q", got)
+	}
+}
+
+func TestInvalidBucketNamesRejectedBeforeStorage(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeStorage("media")
+	fake.caps.BucketPolicy = true
+	s := newTestService(t, testConfig(), fake)
+	const bad = "Bad_Bucket"
+
+	for name, call := range map[string]func() error{
+		"CreateBucket": func() error {
+			_, err := s.CreateBucket(ctx, &mediabase_v1.CreateBucketRequest{BucketName: bad, IsPublic: true})
+			return err
+		},
+		"PresignUpload": func() error {
+			_, err := s.PresignUpload(ctx, &mediabase_v1.PresignUploadRequest{BucketName: bad, ContentType: "image/png"})
+			return err
+		},
+		"PresignDownload": func() error {
+			_, err := s.PresignDownload(ctx, &mediabase_v1.PresignDownloadRequest{BucketName: bad, ObjectKey: "a.png"})
+			return err
+		},
+		"PutObject": func() error {
+			_, err := s.PutObject(ctx, &mediabase_v1.PutObjectRequest{BucketName: bad, ContentType: "image/png", Content: []byte("x")})
+			return err
+		},
+		"DeleteObject": func() error {
+			_, err := s.DeleteObject(ctx, &mediabase_v1.DeleteObjectRequest{BucketName: bad, ObjectKey: "a.png"})
+			return err
+		},
+		"ConfirmUpload": func() error {
+			_, err := s.ConfirmUpload(ctx, &mediabase_v1.ConfirmUploadRequest{BucketName: bad, ObjectKey: "a.png"})
+			return err
+		},
+		"CopyObject": func() error {
+			_, err := s.CopyObject(ctx, &mediabase_v1.CopyObjectRequest{BucketName: bad, SourceKey: "a.png", DestinationKey: "b.png"})
+			return err
+		},
+		"StatObject": func() error {
+			_, err := s.StatObject(ctx, bad, "a.png")
+			return err
+		},
+		"GetObjectTags": func() error {
+			_, err := s.GetObjectTags(ctx, &mediabase_v1.GetObjectTagsRequest{BucketName: bad, ObjectKey: "a.png"})
+			return err
+		},
+	} {
+		err := call()
+		if status.Code(err) != codes.InvalidArgument || !strings.Contains(err.Error(), "bucket_name") {
+			t.Errorf("%s: error = %v, want INVALID_ARGUMENT naming bucket_name", name, err)
+		}
+	}
+	if len(fake.calls) != 0 {
+		t.Errorf("invalid bucket names reached storage: %v", fake.calls)
+	}
+}
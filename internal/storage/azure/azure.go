@@ -0,0 +1,775 @@
+package azure
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"iter"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	azpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blockblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/sas"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/service"
+	"github.com/gofreego/mediabase/internal/policy"
+	"github.com/gofreego/mediabase/internal/storage"
+)
+
+const (
+	// blockSize is the size of the blocks uploads are staged in
+	blockSize = 8 << 20
+	// copyPollInterval is how often a pending server-side copy is checked
+	copyPollInterval = 500 * time.Millisecond
+)
+
+// AzureStorage implements the Storage interface using the Azure Blob Storage SDK.
+// Buckets map to containers and objects to block blobs.
+type AzureStorage struct {
+	// mu guards client, cred and config, which are replaced when the credentials are updated
+	mu       sync.RWMutex
+	client   *service.Client
+	cred     *service.SharedKeyCredential
+	config   storage.Config
+	endpoint *url.URL
+}
+
+func init() {
+	storage.Register(storage.ProviderAzure, func(config storage.Config) (storage.Storage, error) {
+		return NewAzureStorage(config)
+	})
+}
+
+// NewAzureStorage creates a new Azure Blob Storage instance. The account comes from the
+// connection string if set, otherwise AccessKeyID is the account name and SecretAccessKey its key.
+func NewAzureStorage(config storage.Config) (*AzureStorage, error) {
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid storage config: %w", err)
+	}
+
+	account, key := config.AccessKeyID, config.SecretAccessKey
+	if config.ConnectionString != "" {
+		settings, err := parseConnectionString(config.ConnectionString)
+		if err != nil {
+			return nil, fmt.Errorf("invalid azure connection string: %w", err)
+		}
+		account, key = settings.account, settings.key
+		config.Endpoint = settings.endpoint
+	}
+
+	endpoint, err := resolveEndpoint(config, account)
+	if err != nil {
+		return nil, err
+	}
+	client, cred, err := newClient(endpoint, account, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AzureStorage{
+		client:   client,
+		cred:     cred,
+		config:   config,
+		endpoint: endpoint,
+	}, nil
+}
+
+// newClient creates a Blob service client authorized with an account key
+func newClient(endpoint *url.URL, account, key string) (*service.Client, *service.SharedKeyCredential, error) {
+	cred, err := service.NewSharedKeyCredential(account, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid azure account key: %w", err)
+	}
+	client, err := service.NewClientWithSharedKeyCredential(endpoint.String(), cred, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create azure client: %w", err)
+	}
+	return client, cred, nil
+}
+
+// current returns the client and the credential SAS tokens are signed with
+func (a *AzureStorage) current() (*service.Client, *service.SharedKeyCredential) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.client, a.cred
+}
+
+// blobClient returns the client of a blob
+func (a *AzureStorage) blobClient(bucketName, objectKey string) *blob.Client {
+	client, _ := a.current()
+	return client.NewContainerClient(bucketName).NewBlobClient(objectKey)
+}
+
+// UpdateCredentials replaces the account key after checking it with a container listing.
+// The account itself cannot change, since the endpoint is derived from it.
+func (a *AzureStorage) UpdateCredentials(ctx context.Context, accessKeyID, secretAccessKey string) error {
+	_, cred := a.current()
+	if accessKeyID != cred.AccountName() {
+		return fmt.Errorf("invalid credentials: account %s does not match the configured account %s", accessKeyID, cred.AccountName())
+	}
+	client, updated, err := newClient(a.endpoint, accessKeyID, secretAccessKey)
+	if err != nil {
+		return fmt.Errorf("invalid credentials: %w", err)
+	}
+
+	pager := client.NewListContainersPager(&service.ListContainersOptions{MaxResults: to.Ptr[int32](1)})
+	if _, err := pager.NextPage(ctx); err != nil {
+		return fmt.Errorf("failed to verify credentials: %w", translateError(err))
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.client = client
+	a.cred = updated
+	a.config.AccessKeyID = accessKeyID
+	a.config.SecretAccessKey = secretAccessKey
+	return nil
+}
+
+// encryptionScope returns the encryption scope of a new object: the override or the configured
+// SSE-KMS scope. Blobs are always encrypted at rest, so SSE-S3 needs no scope.
+func (a *AzureStorage) encryptionScope(kmsKeyID string) (string, error) {
+	a.mu.RLock()
+	config := a.config.Encryption
+	a.mu.RUnlock()
+
+	if kmsKeyID != "" && config.Type != storage.EncryptionSSEKMS {
+		return "", fmt.Errorf("%w: an encryption scope needs %s encryption, which is not configured", storage.ErrNotSupported, storage.EncryptionSSEKMS)
+	}
+	if kmsKeyID == "" && config.Type == storage.EncryptionSSEKMS {
+		kmsKeyID = config.KMSKeyID
+	}
+	return kmsKeyID, nil
+}
+
+// scopeInfo wraps an encryption scope for the SDK, which leaves out a nil scope
+func scopeInfo(scope string) *blob.CPKScopeInfo {
+	if scope == "" {
+		return nil
+	}
+	return &blob.CPKScopeInfo{EncryptionScope: &scope}
+}
+
+// encryptionType returns the configured encryption
+func (a *AzureStorage) encryptionType() string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.config.Encryption.Type
+}
+
+// userMetadataPrefix marks a header as user metadata
+const userMetadataPrefix = "x-ms-meta-"
+
+// encodeMetadataKey maps a metadata key onto a valid Azure metadata name. Names must be C#
+// identifiers, so hyphens become underscores and a leading digit is escaped with an underscore.
+func encodeMetadataKey(key string) string {
+	name := strings.ReplaceAll(key, "-", "_")
+	if name != "" && name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+	return name
+}
+
+// decodeMetadataKey reverses encodeMetadataKey
+func decodeMetadataKey(name string) string {
+	name = strings.ToLower(name)
+	if len(name) > 1 && name[0] == '_' && name[1] >= '0' && name[1] <= '9' {
+		name = name[1:]
+	}
+	return strings.ReplaceAll(name, "_", "-")
+}
+
+// encodeMetadata maps user metadata onto Azure metadata names
+func encodeMetadata(metadata map[string]string) map[string]*string {
+	encoded := make(map[string]*string, len(metadata))
+	for k, v := range metadata {
+		encoded[encodeMetadataKey(k)] = to.Ptr(v)
+	}
+	return encoded
+}
+
+// decodeMetadata reverses encodeMetadata
+func decodeMetadata(metadata map[string]*string) map[string]string {
+	decoded := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		if v != nil {
+			decoded[decodeMetadataKey(k)] = *v
+		}
+	}
+	return decoded
+}
+
+// GeneratePresignedUploadURL is not supported: the Blob service has no browser POST uploads
+// and SAS tokens cannot limit the content length. Use presigned PUT uploads instead.
+func (a *AzureStorage) GeneratePresignedUploadURL(ctx context.Context, bucketName, objectKey, contentType string, expiryDuration time.Duration, maxSize int64, opts storage.UploadOptions) (string, map[string]string, error) {
+	return "", nil, fmt.Errorf("%w: presigned POST uploads on azure, use PUT", storage.ErrNotSupported)
+}
+
+// GeneratePresignedPutURL creates a SAS URL allowing a Put Blob request on the object. SAS
+// tokens do not sign headers, so unlike on S3 the size, content type and metadata are only
+// returned as the headers to send and are not enforced by storage; check them on confirmation.
+// With IfNoneMatch the token lacks write permission, so existing blobs cannot be overwritten.
+func (a *AzureStorage) GeneratePresignedPutURL(ctx context.Context, bucketName, objectKey, contentType string, expiryDuration time.Duration, size int64, opts storage.UploadOptions) (string, map[string]string, error) {
+	scope, err := a.encryptionScope(opts.KMSKeyID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	headers := map[string]string{
+		"x-ms-blob-type": "BlockBlob",
+		"Content-Type":   contentType,
+	}
+	// Metadata is recorded the same way as on S3 so ConfirmUpload handles both
+	if opts.CacheControl != "" {
+		headers["x-ms-blob-cache-control"] = opts.CacheControl
+		headers[userMetadataPrefix+encodeMetadataKey(storage.CacheControlMetadataKey)] = opts.CacheControl
+	}
+	if opts.ChecksumSHA256 != "" {
+		headers[userMetadataPrefix+encodeMetadataKey(storage.ChecksumSHA256MetadataKey)] = strings.ToLower(opts.ChecksumSHA256)
+	}
+	if len(opts.Tags) > 0 {
+		headers[userMetadataPrefix+encodeMetadataKey(storage.TagsMetadataKey)] = storage.EncodeTags(opts.Tags)
+	}
+	for k, v := range opts.Metadata {
+		headers[userMetadataPrefix+encodeMetadataKey(k)] = v
+	}
+
+	sasOpts := sasOptions{
+		permissions:     sas.BlobPermissions{Create: true, Write: true},
+		expiry:          expiryDuration,
+		encryptionScope: scope,
+	}
+	if opts.IfNoneMatch {
+		sasOpts.permissions.Write = false
+		headers["If-None-Match"] = "*"
+	}
+
+	presignedURL, err := a.sasURL(bucketName, objectKey, sasOpts)
+	if err != nil {
+		return "", nil, err
+	}
+	return presignedURL, headers, nil
+}
+
+// GeneratePresignedDownloadURL creates a read SAS URL for downloading a file
+func (a *AzureStorage) GeneratePresignedDownloadURL(ctx context.Context, bucketName, objectKey string, expiryDuration time.Duration, opts storage.DownloadOptions) (string, error) {
+	if opts.VersionID != "" {
+		return "", fmt.Errorf("%w: object versions on azure", storage.ErrNotSupported)
+	}
+	// SAS tokens only accept IPv4 addresses
+	if opts.AllowedClientIP != "" {
+		if ip, err := netip.ParseAddr(opts.AllowedClientIP); err != nil || !ip.Unmap().Is4() {
+			return "", fmt.Errorf("%w: client IP restriction to %s on azure, only IPv4 addresses are", storage.ErrNotSupported, opts.AllowedClientIP)
+		}
+	}
+	return a.sasURL(bucketName, objectKey, sasOptions{
+		permissions:  sas.BlobPermissions{Read: true},
+		expiry:       expiryDuration,
+		ipAddress:    opts.AllowedClientIP,
+		cacheControl: opts.CacheControl,
+		contentType:  opts.ContentType,
+	})
+}
+
+// GeneratePresignedHeadURL creates a read SAS URL, which also authorizes HEAD requests
+func (a *AzureStorage) GeneratePresignedHeadURL(ctx context.Context, bucketName, objectKey string, expiryDuration time.Duration, versionID string) (string, error) {
+	if versionID != "" {
+		return "", fmt.Errorf("%w: object versions on azure", storage.ErrNotSupported)
+	}
+	return a.sasURL(bucketName, objectKey, sasOptions{
+		permissions: sas.BlobPermissions{Read: true},
+		expiry:      expiryDuration,
+	})
+}
+
+// GeneratePresignedDeleteURL creates a delete SAS URL for a Delete Blob request
+func (a *AzureStorage) GeneratePresignedDeleteURL(ctx context.Context, bucketName, objectKey string, expiryDuration time.Duration) (string, error) {
+	return a.sasURL(bucketName, objectKey, sasOptions{
+		permissions: sas.BlobPermissions{Delete: true},
+		expiry:      expiryDuration,
+	})
+}
+
+// DeleteObject removes a blob with its snapshots; deleting a missing blob succeeds, as on S3
+func (a *AzureStorage) DeleteObject(ctx context.Context, bucketName, objectKey string) error {
+	_, err := a.blobClient(bucketName, objectKey).Delete(ctx, &blob.DeleteOptions{
+		DeleteSnapshots: to.Ptr(blob.DeleteSnapshotsOptionTypeInclude),
+	})
+	if err != nil && !bloberror.HasCode(err, bloberror.BlobNotFound) {
+		return fmt.Errorf("failed to delete object: %w", translateError(err))
+	}
+	return nil
+}
+
+// PutObject uploads a file directly to storage, staged as blocks and committed with the blob
+// headers. The Blob service cannot verify caller-supplied digests of whole blobs, so the
+// streamed bytes are hashed instead, and a mismatch fails the upload before it is committed.
+func (a *AzureStorage) PutObject(ctx context.Context, bucketName, objectKey string, reader io.Reader, objectSize int64, contentType string, opts storage.UploadOptions) (string, error) {
+	scope, err := a.encryptionScope(opts.KMSKeyID)
+	if err != nil {
+		return "", err
+	}
+
+	if opts.ChecksumSHA256 != "" || opts.ContentMD5 != "" {
+		verifier := &checksumReader{reader: reader}
+		if opts.ChecksumSHA256 != "" {
+			if sum, err := hex.DecodeString(opts.ChecksumSHA256); err != nil || len(sum) != sha256.Size {
+				return "", fmt.Errorf("invalid SHA-256 checksum: %s", opts.ChecksumSHA256)
+			}
+			verifier.digests = append(verifier.digests, digest{"SHA-256", sha256.New(), strings.ToLower(opts.ChecksumSHA256)})
+		}
+		if opts.ContentMD5 != "" {
+			verifier.digests = append(verifier.digests, digest{"MD5", md5.New(), strings.ToLower(opts.ContentMD5)})
+		}
+		reader = verifier
+	}
+
+	client, _ := a.current()
+	uploadOpts := &blockblob.UploadStreamOptions{
+		BlockSize: blockSize,
+		HTTPHeaders: &blob.HTTPHeaders{
+			BlobContentType: to.Ptr(contentType),
+		},
+		Metadata:     encodeMetadata(opts.Metadata),
+		Tags:         opts.Tags,
+		CPKScopeInfo: scopeInfo(scope),
+	}
+	if opts.CacheControl != "" {
+		uploadOpts.HTTPHeaders.BlobCacheControl = to.Ptr(opts.CacheControl)
+	}
+	resp, err := client.NewContainerClient(bucketName).NewBlockBlobClient(objectKey).UploadStream(ctx, reader, uploadOpts)
+	if err != nil {
+		if errors.Is(err, storage.ErrChecksumMismatch) {
+			return "", err
+		}
+		return "", fmt.Errorf("failed to put object: %w", translateError(err))
+	}
+	if resp.ETag == nil {
+		return "", nil
+	}
+	return storage.NormalizeETag(string(*resp.ETag)), nil
+}
+
+// digest is an expected hex-encoded digest of streamed content
+type digest struct {
+	name string
+	hash hash.Hash
+	want string
+}
+
+// checksumReader hashes the bytes read through it and fails instead of reporting the end of
+// the content when a digest does not match
+type checksumReader struct {
+	reader  io.Reader
+	digests []digest
+	err     error
+}
+
+func (r *checksumReader) Read(p []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	n, err := r.reader.Read(p)
+	for _, d := range r.digests {
+		d.hash.Write(p[:n])
+	}
+	if err == io.EOF {
+		for _, d := range r.digests {
+			if hex.EncodeToString(d.hash.Sum(nil)) != d.want {
+				r.err = fmt.Errorf("%w: %s does not match", storage.ErrChecksumMismatch, d.name)
+				return 0, r.err
+			}
+		}
+	}
+	return n, err
+}
+
+// GetObject downloads a file from storage
+func (a *AzureStorage) GetObject(ctx context.Context, bucketName, objectKey string) (io.ReadCloser, error) {
+	resp, err := a.blobClient(bucketName, objectKey).DownloadStream(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object: %w", translateError(err))
+	}
+	return resp.Body, nil
+}
+
+// GetObjectRange retrieves a byte range of an object along with the total object size
+func (a *AzureStorage) GetObjectRange(ctx context.Context, bucketName, objectKey string, offset, length int64) (io.ReadCloser, int64, error) {
+	if offset < 0 || length == 0 {
+		return nil, 0, fmt.Errorf("invalid range: offset %d, length %d", offset, length)
+	}
+
+	// A count of zero reads to the end
+	blobRange := blob.HTTPRange{Offset: offset}
+	if length > 0 {
+		blobRange.Count = length
+	}
+	resp, err := a.blobClient(bucketName, objectKey).DownloadStream(ctx, &blob.DownloadStreamOptions{Range: blobRange})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get object range: %w", translateError(err))
+	}
+
+	var totalSize int64
+	if resp.ContentLength != nil {
+		totalSize = *resp.ContentLength
+	}
+	if resp.ContentRange != nil {
+		// Content-Range: bytes <start>-<end>/<total>
+		_, total, found := strings.Cut(*resp.ContentRange, "/")
+		if size, err := strconv.ParseInt(total, 10, 64); found && err == nil {
+			totalSize = size
+		}
+	}
+	return resp.Body, totalSize, nil
+}
+
+// ObjectExists checks if an object exists in storage
+func (a *AzureStorage) ObjectExists(ctx context.Context, bucketName, objectKey string) (bool, error) {
+	_, err := a.StatObject(ctx, bucketName, objectKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check object existence: %w", err)
+	}
+	return true, nil
+}
+
+// StatObject fetches the properties and user metadata of a blob
+func (a *AzureStorage) StatObject(ctx context.Context, bucketName, objectKey string) (*storage.ObjectInfo, error) {
+	resp, err := a.blobClient(bucketName, objectKey).GetProperties(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat object: %w", translateError(err))
+	}
+
+	info := &storage.ObjectInfo{
+		Key:          objectKey,
+		Size:         deref(resp.ContentLength),
+		ContentType:  deref(resp.ContentType),
+		LastModified: deref(resp.LastModified),
+		UserMetadata: decodeMetadata(resp.Metadata),
+	}
+	if resp.ETag != nil {
+		info.ETag = storage.NormalizeETag(string(*resp.ETag))
+	}
+	if a.encryptionType() == storage.EncryptionSSEKMS {
+		info.KMSKeyID = deref(resp.EncryptionScope)
+	}
+	return info, nil
+}
+
+// deref returns the value of an optional response field, or its zero value when it is missing
+func deref[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
+
+// CopyObject copies a blob server-side with Copy Blob, which copies the properties, metadata
+// and, explicitly passed along, the tags of the source. Replaced attributes are set on the copy
+// afterwards, since Copy Blob cannot set properties.
+func (a *AzureStorage) CopyObject(ctx context.Context, bucketName, srcKey, dstKey string, opts storage.CopyOptions) error {
+	dstBucket := bucketName
+	if opts.DestinationBucket != "" {
+		dstBucket = opts.DestinationBucket
+	}
+	// Copies are new objects, so they are encrypted like uploads
+	scope, err := a.encryptionScope("")
+	if err != nil {
+		return err
+	}
+	srcTags, err := a.GetObjectTags(ctx, bucketName, srcKey)
+	if err != nil {
+		return fmt.Errorf("failed to copy object: %w", err)
+	}
+
+	// The SDK has no option for the encryption scope of a copy, so it is sent as a header
+	copyCtx := ctx
+	if scope != "" {
+		copyCtx = azpolicy.WithHTTPHeader(ctx, http.Header{"x-ms-encryption-scope": {scope}})
+	}
+	dst := a.blobClient(dstBucket, dstKey)
+	resp, err := dst.StartCopyFromURL(copyCtx, a.blobClient(bucketName, srcKey).URL(), &blob.StartCopyFromURLOptions{BlobTags: srcTags})
+	if err != nil {
+		return fmt.Errorf("failed to copy object: %w", translateError(err))
+	}
+
+	// Copies within an account usually complete synchronously
+	copyStatus := deref(resp.CopyStatus)
+	for copyStatus == blob.CopyStatusTypePending {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("failed to copy object: %w", ctx.Err())
+		case <-time.After(copyPollInterval):
+		}
+		props, err := dst.GetProperties(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("failed to copy object: %w", translateError(err))
+		}
+		copyStatus = deref(props.CopyStatus)
+		if copyStatus != blob.CopyStatusTypePending && copyStatus != blob.CopyStatusTypeSuccess {
+			return fmt.Errorf("failed to copy object: copy %s: %s", copyStatus, deref(props.CopyStatusDescription))
+		}
+	}
+
+	if opts.ReplaceMetadata {
+		// Set Blob Properties clears every property it is not given
+		headers := blob.HTTPHeaders{BlobContentType: to.Ptr(opts.ContentType)}
+		if opts.CacheControl != "" {
+			headers.BlobCacheControl = to.Ptr(opts.CacheControl)
+		}
+		if _, err := dst.SetHTTPHeaders(ctx, headers, nil); err != nil {
+			return fmt.Errorf("failed to set copy properties: %w", translateError(err))
+		}
+
+		// Without metadata, Set Blob Metadata removes all metadata
+		if _, err := dst.SetMetadata(ctx, encodeMetadata(opts.Metadata), &blob.SetMetadataOptions{CPKScopeInfo: scopeInfo(scope)}); err != nil {
+			return fmt.Errorf("failed to set copy metadata: %w", translateError(err))
+		}
+	}
+	return nil
+}
+
+// ListUploadedParts is not supported: the Blob service has no multipart uploads
+func (a *AzureStorage) ListUploadedParts(ctx context.Context, bucketName, objectKey, uploadID string) ([]storage.UploadedPart, error) {
+	return nil, fmt.Errorf("%w: multipart uploads on azure", storage.ErrNotSupported)
+}
+
+// BucketUsage sums the sizes of the blobs in a container
+func (a *AzureStorage) BucketUsage(ctx context.Context, bucketName string) (int64, error) {
+	var total int64
+	for object, err := range a.ListObjects(ctx, bucketName, "") {
+		if err != nil {
+			return 0, err
+		}
+		total += object.Size
+	}
+	return total, nil
+}
+
+// ListObjects lists the blobs under a prefix, page by page as the caller iterates
+func (a *AzureStorage) ListObjects(ctx context.Context, bucketName, prefix string) iter.Seq2[storage.ObjectInfo, error] {
+	return func(yield func(storage.ObjectInfo, error) bool) {
+		client, _ := a.current()
+		listOpts := &container.ListBlobsFlatOptions{}
+		if prefix != "" {
+			listOpts.Prefix = &prefix
+		}
+		pager := client.NewContainerClient(bucketName).NewListBlobsFlatPager(listOpts)
+		for pager.More() {
+			page, err := pager.NextPage(ctx)
+			if err != nil {
+				yield(storage.ObjectInfo{}, fmt.Errorf("failed to list objects: %w", translateError(err)))
+				return
+			}
+			if page.Segment == nil {
+				return
+			}
+			for _, item := range page.Segment.BlobItems {
+				info := storage.ObjectInfo{Key: deref(item.Name)}
+				if props := item.Properties; props != nil {
+					info.Size = deref(props.ContentLength)
+					info.ContentType = deref(props.ContentType)
+					info.LastModified = deref(props.LastModified)
+					if props.ETag != nil {
+						info.ETag = storage.NormalizeETag(string(*props.ETag))
+					}
+				}
+				if !yield(info, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// BucketExists checks if a container exists
+func (a *AzureStorage) BucketExists(ctx context.Context, bucketName string) (bool, error) {
+	client, _ := a.current()
+	_, err := client.NewContainerClient(bucketName).GetProperties(ctx, nil)
+	if err != nil {
+		if bloberror.HasCode(err, bloberror.ContainerNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check bucket existence: %w", translateError(err))
+	}
+	return true, nil
+}
+
+// CreateBucket creates a new private container if it doesn't exist
+func (a *AzureStorage) CreateBucket(ctx context.Context, bucketName string, opts storage.BucketOptions) error {
+	if opts.ObjectLocking {
+		return fmt.Errorf("%w: object lock on azure", storage.ErrNotSupported)
+	}
+	client, _ := a.current()
+	_, err := client.NewContainerClient(bucketName).Create(ctx, nil)
+	if err != nil && !bloberror.HasCode(err, bloberror.ContainerAlreadyExists) {
+		return fmt.Errorf("failed to create bucket: %w", translateError(err))
+	}
+	return nil
+}
+
+// DeleteBucket removes an empty container. Azure deletes containers with their blobs, so the
+// container is checked for blobs first; a blob uploaded in between is deleted with it.
+func (a *AzureStorage) DeleteBucket(ctx context.Context, bucketName string) error {
+	for _, err := range a.ListObjects(ctx, bucketName, "") {
+		if err != nil {
+			return fmt.Errorf("failed to delete bucket: %w", err)
+		}
+		return fmt.Errorf("failed to delete bucket: %w", storage.ErrBucketNotEmpty)
+	}
+	client, _ := a.current()
+	if _, err := client.NewContainerClient(bucketName).Delete(ctx, nil); err != nil {
+		return fmt.Errorf("failed to delete bucket: %w", translateError(err))
+	}
+	return nil
+}
+
+// SetBucketPolicy maps the policy onto the public access level of the container: the public-read
+// policy allows anonymous reads of all blobs and an empty policy makes the container private.
+// Containers cannot express other policies.
+func (a *AzureStorage) SetBucketPolicy(ctx context.Context, bucketName string, bucketPolicy string) error {
+	publicRead, err := policy.PublicReadPolicy(bucketName)
+	if err != nil {
+		return err
+	}
+
+	accessOpts := &container.SetAccessPolicyOptions{}
+	switch bucketPolicy {
+	case publicRead:
+		accessOpts.Access = to.Ptr(container.PublicAccessTypeBlob)
+	case "":
+		// Without an access level the container is private
+	default:
+		return fmt.Errorf("%w: azure containers only support public-read or private access", storage.ErrNotSupported)
+	}
+
+	client, _ := a.current()
+	if _, err := client.NewContainerClient(bucketName).SetAccessPolicy(ctx, accessOpts); err != nil {
+		return fmt.Errorf("failed to set bucket policy: %w", translateError(err))
+	}
+	return nil
+}
+
+// GetBucketPolicy reports the public-read policy for containers allowing anonymous reads of
+// their blobs and an empty policy for private containers
+func (a *AzureStorage) GetBucketPolicy(ctx context.Context, bucketName string) (string, error) {
+	client, _ := a.current()
+	resp, err := client.NewContainerClient(bucketName).GetAccessPolicy(ctx, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get bucket policy: %w", translateError(err))
+	}
+
+	switch deref(resp.BlobPublicAccess) {
+	case container.PublicAccessTypeBlob, container.PublicAccessTypeContainer:
+		return policy.PublicReadPolicy(bucketName)
+	}
+	return "", nil
+}
+
+// SetObjectACL is not supported: public access is only configurable per container
+func (a *AzureStorage) SetObjectACL(ctx context.Context, bucketName, objectKey string, acl storage.ObjectACL) error {
+	return fmt.Errorf("%w: object ACLs on azure", storage.ErrNotSupported)
+}
+
+// GetObjectACL is not supported: public access is only configurable per container
+func (a *AzureStorage) GetObjectACL(ctx context.Context, bucketName, objectKey string) (storage.ObjectACL, error) {
+	return "", fmt.Errorf("%w: object ACLs on azure", storage.ErrNotSupported)
+}
+
+// PublicObjectURL builds the unsigned URL of a blob
+func (a *AzureStorage) PublicObjectURL(ctx context.Context, bucketName, objectKey string) (string, error) {
+	return a.blobClient(bucketName, objectKey).URL(), nil
+}
+
+// SetObjectTags replaces the index tags of a blob
+func (a *AzureStorage) SetObjectTags(ctx context.Context, bucketName, objectKey string, objectTags map[string]string) error {
+	if _, err := a.blobClient(bucketName, objectKey).SetTags(ctx, objectTags, nil); err != nil {
+		return fmt.Errorf("failed to set object tags: %w", translateError(err))
+	}
+	return nil
+}
+
+// GetObjectTags fetches the index tags of a blob
+func (a *AzureStorage) GetObjectTags(ctx context.Context, bucketName, objectKey string) (map[string]string, error) {
+	resp, err := a.blobClient(bucketName, objectKey).GetTags(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object tags: %w", translateError(err))
+	}
+	tags := make(map[string]string, len(resp.BlobTagSet))
+	for _, tag := range resp.BlobTagSet {
+		if tag != nil && tag.Key != nil {
+			tags[*tag.Key] = deref(tag.Value)
+		}
+	}
+	return tags, nil
+}
+
+// EnableVersioning is not supported: blob versioning is an account-wide setting
+func (a *AzureStorage) EnableVersioning(ctx context.Context, bucketName string) error {
+	return fmt.Errorf("%w: bucket versioning on azure", storage.ErrNotSupported)
+}
+
+// SuspendVersioning is not supported: blob versioning is an account-wide setting
+func (a *AzureStorage) SuspendVersioning(ctx context.Context, bucketName string) error {
+	return fmt.Errorf("%w: bucket versioning on azure", storage.ErrNotSupported)
+}
+
+// ListObjectVersions is not supported: blob versioning is an account-wide setting
+func (a *AzureStorage) ListObjectVersions(ctx context.Context, bucketName, objectKey string) ([]storage.ObjectVersion, error) {
+	return nil, fmt.Errorf("%w: object versions on azure", storage.ErrNotSupported)
+}
+
+// DeleteObjectVersion is not supported: blob versioning is an account-wide setting
+func (a *AzureStorage) DeleteObjectVersion(ctx context.Context, bucketName, objectKey, versionID string) error {
+	return fmt.Errorf("%w: object versions on azure", storage.ErrNotSupported)
+}
+
+// SetObjectRetention is not supported: immutability policies are managed per container or account
+func (a *AzureStorage) SetObjectRetention(ctx context.Context, bucketName, objectKey, versionID string, retention storage.ObjectRetention) error {
+	return fmt.Errorf("%w: object lock on azure", storage.ErrNotSupported)
+}
+
+// SetObjectLegalHold is not supported, like SetObjectRetention
+func (a *AzureStorage) SetObjectLegalHold(ctx context.Context, bucketName, objectKey, versionID string, hold bool) error {
+	return fmt.Errorf("%w: object lock on azure", storage.ErrNotSupported)
+}
+
+// SetBucketLifecycle is not supported: lifecycle management is an account-wide policy
+func (a *AzureStorage) SetBucketLifecycle(ctx context.Context, bucketName, prefix string, expirationDays int) error {
+	return fmt.Errorf("%w: bucket lifecycle rules on azure", storage.ErrNotSupported)
+}
+
+// SetBucketCORS is not supported: CORS rules apply to the whole Blob service of an account
+func (a *AzureStorage) SetBucketCORS(ctx context.Context, bucketName string, rules []storage.CORSRule) error {
+	return fmt.Errorf("%w: bucket CORS rules on azure", storage.ErrNotSupported)
+}
+
+// Capabilities reports the features supported by Azure Blob Storage
+func (a *AzureStorage) Capabilities() storage.Capabilities {
+	return storage.Capabilities{
+		// Limited to public-read and private containers
+		BucketPolicy:  true,
+		ObjectTagging: true,
+		// SSE-KMS selects an encryption scope; blobs are always encrypted at rest
+		ServerSideEncryption: true,
+		// Enforced through the signed IP range of SAS tokens
+		ClientIPRestriction: true,
+	}
+}
@@ -0,0 +1,285 @@
+package azure
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"maps"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gofreego/mediabase/internal/storage"
+)
+
+func TestPutObjectStoresBlobAttributes(t *testing.T) {
+	server, state := newBlobServer(t)
+	a := newTestStorage(t, server.URL, storage.EncryptionConfig{Type: storage.EncryptionSSEKMS, KMSKeyID: "media-scope"})
+	ctx := context.Background()
+	if err := a.CreateBucket(ctx, "media", storage.BucketOptions{}); err != nil {
+		t.Fatalf("CreateBucket: %v", err)
+	}
+	content := []byte("hello world")
+	sum := sha256.Sum256(content)
+	metadata := map[string]string{storage.ChecksumSHA256MetadataKey: hex.EncodeToString(sum[:]), "1st-owner": "alice"}
+	tags := map[string]string{"stage": "raw"}
+
+	etag, err := a.PutObject(ctx, "media", "a.txt", bytes.NewReader(content), int64(len(content)), "text/plain", storage.UploadOptions{
+		Metadata:       metadata,
+		CacheControl:   "max-age=60",
+		Tags:           tags,
+		ChecksumSHA256: hex.EncodeToString(sum[:]),
+	})
+	if err != nil {
+		t.Fatalf("PutObject: %v", err)
+	}
+	if got := state.blobs["media/a.txt"]; got == nil || !bytes.Equal(got.data, content) {
+		t.Fatalf("stored %v, want %q", got, content)
+	}
+
+	info, err := a.StatObject(ctx, "media", "a.txt")
+	if err != nil {
+		t.Fatalf("StatObject: %v", err)
+	}
+	if info.ETag != etag || info.Size != int64(len(content)) || info.ContentType != "text/plain" {
+		t.Errorf("stat %+v, want ETag %s, size %d and text/plain", info, etag, len(content))
+	}
+	if !maps.Equal(info.UserMetadata, metadata) {
+		t.Errorf("metadata %v, want %v", info.UserMetadata, metadata)
+	}
+	if info.KMSKeyID != "media-scope" {
+		t.Errorf("KMSKeyID %q, want the encryption scope", info.KMSKeyID)
+	}
+	if got := state.blobs["media/a.txt"].header.Get("Cache-Control"); got != "max-age=60" {
+		t.Errorf("Cache-Control %q, want max-age=60", got)
+	}
+
+	got, err := a.GetObjectTags(ctx, "media", "a.txt")
+	if err != nil {
+		t.Fatalf("GetObjectTags: %v", err)
+	}
+	if !maps.Equal(got, tags) {
+		t.Errorf("tags %v, want %v", got, tags)
+	}
+}
+
+func TestPutObjectRejectsChecksumMismatchBeforeCommit(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		size int
+	}{
+		{"single block", 16},
+		{"staged blocks", blockSize + 16},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			server, state := newBlobServer(t)
+			a := newTestStorage(t, server.URL, storage.EncryptionConfig{})
+			ctx := context.Background()
+			if err := a.CreateBucket(ctx, "media", storage.BucketOptions{}); err != nil {
+				t.Fatalf("CreateBucket: %v", err)
+			}
+			content := bytes.Repeat([]byte("a"), tc.size)
+			other := md5.Sum([]byte("other"))
+
+			_, err := a.PutObject(ctx, "media", "a.bin", bytes.NewReader(content), int64(len(content)), "application/octet-stream",
+				storage.UploadOptions{ContentMD5: hex.EncodeToString(other[:])})
+			if !errors.Is(err, storage.ErrChecksumMismatch) {
+				t.Fatalf("PutObject error %v, want ErrChecksumMismatch", err)
+			}
+			if _, ok := state.blobs["media/a.bin"]; ok {
+				t.Error("the mismatching blob was committed")
+			}
+		})
+	}
+}
+
+func TestGetObjectRangeReportsTotalSize(t *testing.T) {
+	server, _ := newBlobServer(t)
+	a := newTestStorage(t, server.URL, storage.EncryptionConfig{})
+	ctx := context.Background()
+	if err := a.CreateBucket(ctx, "media", storage.BucketOptions{}); err != nil {
+		t.Fatalf("CreateBucket: %v", err)
+	}
+	if _, err := a.PutObject(ctx, "media", "a.txt", strings.NewReader("hello world"), 11, "text/plain", storage.UploadOptions{}); err != nil {
+		t.Fatalf("PutObject: %v", err)
+	}
+
+	body, total, err := a.GetObjectRange(ctx, "media", "a.txt", 6, 5)
+	if err != nil {
+		t.Fatalf("GetObjectRange: %v", err)
+	}
+	defer body.Close()
+	data, _ := io.ReadAll(body)
+	if string(data) != "world" || total != 11 {
+		t.Errorf("range %q of %d, want world of 11", data, total)
+	}
+}
+
+func TestMissingObjectsAreNotFound(t *testing.T) {
+	server, _ := newBlobServer(t)
+	a := newTestStorage(t, server.URL, storage.EncryptionConfig{})
+	ctx := context.Background()
+
+	if exists, err := a.BucketExists(ctx, "media"); err != nil || exists {
+		t.Errorf("BucketExists = %v, %v, want false", exists, err)
+	}
+	if _, err := a.StatObject(ctx, "media", "a.txt"); !errors.Is(err, storage.ErrBucketNotFound) {
+		t.Errorf("StatObject in a missing container: %v, want ErrBucketNotFound", err)
+	}
+	if err := a.CreateBucket(ctx, "media", storage.BucketOptions{}); err != nil {
+		t.Fatalf("CreateBucket: %v", err)
+	}
+	if _, err := a.StatObject(ctx, "media", "a.txt"); !errors.Is(err, storage.ErrObjectNotFound) {
+		t.Errorf("StatObject: %v, want ErrObjectNotFound", err)
+	}
+	if exists, err := a.ObjectExists(ctx, "media", "a.txt"); err != nil || exists {
+		t.Errorf("ObjectExists = %v, %v, want false", exists, err)
+	}
+	if err := a.DeleteObject(ctx, "media", "a.txt"); err != nil {
+		t.Errorf("DeleteObject of a missing blob: %v", err)
+	}
+}
+
+func TestCopyObjectKeepsTagsAndReplacesMetadata(t *testing.T) {
+	server, state := newBlobServer(t)
+	a := newTestStorage(t, server.URL, storage.EncryptionConfig{})
+	ctx := context.Background()
+	if err := a.CreateBucket(ctx, "media", storage.BucketOptions{}); err != nil {
+		t.Fatalf("CreateBucket: %v", err)
+	}
+	if _, err := a.PutObject(ctx, "media", "a.txt", strings.NewReader("hello"), 5, "text/plain", storage.UploadOptions{
+		Metadata: map[string]string{"owner": "alice"},
+		Tags:     map[string]string{"stage": "raw"},
+	}); err != nil {
+		t.Fatalf("PutObject: %v", err)
+	}
+
+	err := a.CopyObject(ctx, "media", "a.txt", "b.txt", storage.CopyOptions{
+		ReplaceMetadata: true,
+		ContentType:     "text/markdown",
+		Metadata:        map[string]string{"owner": "bob"},
+	})
+	if err != nil {
+		t.Fatalf("CopyObject: %v", err)
+	}
+	if got := string(state.blobs["media/b.txt"].data); got != "hello" {
+		t.Errorf("copy holds %q, want hello", got)
+	}
+	info, err := a.StatObject(ctx, "media", "b.txt")
+	if err != nil {
+		t.Fatalf("StatObject: %v", err)
+	}
+	if info.ContentType != "text/markdown" || info.UserMetadata["owner"] != "bob" {
+		t.Errorf("copy has %s and %v, want the replaced attributes", info.ContentType, info.UserMetadata)
+	}
+	if tags := state.blobs["media/b.txt"].tags; tags["stage"] != "raw" {
+		t.Errorf("copy tags %v, want the source tags", tags)
+	}
+}
+
+func TestDeleteBucketRejectsNonEmptyContainers(t *testing.T) {
+	server, state := newBlobServer(t)
+	a := newTestStorage(t, server.URL, storage.EncryptionConfig{})
+	ctx := context.Background()
+	if err := a.CreateBucket(ctx, "media", storage.BucketOptions{}); err != nil {
+		t.Fatalf("CreateBucket: %v", err)
+	}
+	if _, err := a.PutObject(ctx, "media", "a.txt", strings.NewReader("hello"), 5, "text/plain", storage.UploadOptions{}); err != nil {
+		t.Fatalf("PutObject: %v", err)
+	}
+
+	if err := a.DeleteBucket(ctx, "media"); !errors.Is(err, storage.ErrBucketNotEmpty) {
+		t.Fatalf("DeleteBucket: %v, want ErrBucketNotEmpty", err)
+	}
+	if usage, err := a.BucketUsage(ctx, "media"); err != nil || usage != 5 {
+		t.Errorf("BucketUsage = %d, %v, want 5", usage, err)
+	}
+	if err := a.DeleteObject(ctx, "media", "a.txt"); err != nil {
+		t.Fatalf("DeleteObject: %v", err)
+	}
+	if err := a.DeleteBucket(ctx, "media"); err != nil {
+		t.Fatalf("DeleteBucket of an empty container: %v", err)
+	}
+	if state.containers["media"] {
+		t.Error("container was not deleted")
+	}
+}
+
+func TestPresignedURLsCarrySASPermissions(t *testing.T) {
+	a := newTestStorage(t, "https://media.blob.example.com", storage.EncryptionConfig{})
+	ctx := context.Background()
+
+	putURL, headers, err := a.GeneratePresignedPutURL(ctx, "media", "a.txt", "text/plain", 0, 5, storage.UploadOptions{IfNoneMatch: true})
+	if err != nil {
+		t.Fatalf("GeneratePresignedPutURL: %v", err)
+	}
+	query := sasQuery(t, putURL)
+	if query.Get("sp") != "c" || query.Get("sr") != "b" || query.Get("spr") != "https" || query.Get("sig") == "" {
+		t.Errorf("PUT SAS %v, want a signed create-only blob SAS over HTTPS", query)
+	}
+	if headers["If-None-Match"] != "*" || headers["x-ms-blob-type"] != "BlockBlob" {
+		t.Errorf("PUT headers %v, want If-None-Match and the blob type", headers)
+	}
+
+	downloadURL, err := a.GeneratePresignedDownloadURL(ctx, "media", "a.txt", 0, storage.DownloadOptions{AllowedClientIP: "203.0.113.7", ContentType: "image/png"})
+	if err != nil {
+		t.Fatalf("GeneratePresignedDownloadURL: %v", err)
+	}
+	query = sasQuery(t, downloadURL)
+	if query.Get("sp") != "r" || query.Get("sip") != "203.0.113.7" || query.Get("rsct") != "image/png" {
+		t.Errorf("download SAS %v, want read access from the client IP with the content type override", query)
+	}
+
+	_, err = a.GeneratePresignedDownloadURL(ctx, "media", "a.txt", 0, storage.DownloadOptions{AllowedClientIP: "2001:db8::1"})
+	if !errors.Is(err, storage.ErrNotSupported) {
+		t.Errorf("IPv6 restriction: %v, want ErrNotSupported", err)
+	}
+}
+
+func sasQuery(t *testing.T, rawURL string) url.Values {
+	t.Helper()
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		t.Fatalf("invalid URL %s: %v", rawURL, err)
+	}
+	if u.Path != "/media/a.txt" {
+		t.Errorf("URL path %s, want /media/a.txt", u.Path)
+	}
+	return u.Query()
+}
+
+func TestUpdateCredentialsSwapsVerifiedKeys(t *testing.T) {
+	server, _ := newBlobServer(t)
+	a := newTestStorage(t, server.URL, storage.EncryptionConfig{})
+	oldClient := a.client
+
+	if err := a.UpdateCredentials(context.Background(), devStoreAccount, "bmV3LWtleQ=="); err != nil {
+		t.Fatalf("UpdateCredentials: %v", err)
+	}
+	if a.config.SecretAccessKey != "bmV3LWtleQ==" || a.client == oldClient {
+		t.Error("client and config keep the old key")
+	}
+}
+
+func TestUpdateCredentialsKeepsKeysStorageRejects(t *testing.T) {
+	server, state := newBlobServer(t)
+	a := newTestStorage(t, server.URL, storage.EncryptionConfig{})
+	oldClient := a.client
+	state.rejectAuth = true
+
+	err := a.UpdateCredentials(context.Background(), devStoreAccount, "bmV3LWtleQ==")
+	if !errors.Is(err, storage.ErrAccessDenied) {
+		t.Fatalf("UpdateCredentials: %v, want ErrAccessDenied", err)
+	}
+	if a.config.SecretAccessKey != devStoreKey || a.client != oldClient {
+		t.Error("rejected key was swapped in")
+	}
+
+	if err := a.UpdateCredentials(context.Background(), "otheraccount", devStoreKey); err == nil {
+		t.Error("UpdateCredentials accepted another account")
+	}
+}
@@ -0,0 +1,124 @@
+package azure
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/gofreego/mediabase/internal/storage"
+)
+
+// Development storage (Azurite) is addressed with a well-known account and key
+const (
+	devStoreAccount  = "devstoreaccount1"
+	devStoreKey      = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+	devStoreEndpoint = "http://127.0.0.1:10000/" + devStoreAccount
+)
+
+// connectionSettings are the parts of a storage connection string the backend uses
+type connectionSettings struct {
+	endpoint string
+	account  string
+	key      string
+}
+
+// parseConnectionString reads the account, key and Blob endpoint of a connection string such as
+// DefaultEndpointsProtocol=https;AccountName=...;AccountKey=...;EndpointSuffix=core.windows.net
+func parseConnectionString(connectionString string) (connectionSettings, error) {
+	values := make(map[string]string)
+	for _, part := range strings.Split(connectionString, ";") {
+		if part = strings.TrimSpace(part); part == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return connectionSettings{}, fmt.Errorf("invalid connection string segment %q", name)
+		}
+		values[strings.ToLower(name)] = value
+	}
+
+	if strings.EqualFold(values["usedevelopmentstorage"], "true") {
+		return connectionSettings{endpoint: devStoreEndpoint, account: devStoreAccount, key: devStoreKey}, nil
+	}
+
+	settings := connectionSettings{
+		endpoint: values["blobendpoint"],
+		account:  values["accountname"],
+		key:      values["accountkey"],
+	}
+	if settings.account == "" || settings.key == "" {
+		return connectionSettings{}, fmt.Errorf("connection string must contain AccountName and AccountKey")
+	}
+	if settings.endpoint == "" {
+		protocol := values["defaultendpointsprotocol"]
+		if protocol == "" {
+			protocol = "https"
+		}
+		suffix := values["endpointsuffix"]
+		if suffix == "" {
+			suffix = "core.windows.net"
+		}
+		settings.endpoint = protocol + "://" + settings.account + ".blob." + suffix
+	}
+	return settings, nil
+}
+
+// resolveEndpoint returns the Blob service URL of a config, adding the scheme to bare hosts
+func resolveEndpoint(config storage.Config, account string) (*url.URL, error) {
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		endpoint = "https://" + account + ".blob.core.windows.net"
+	} else if !strings.Contains(endpoint, "://") {
+		scheme := "http"
+		if config.UseSSL {
+			scheme = "https"
+		}
+		endpoint = scheme + "://" + endpoint
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid azure endpoint: %w", err)
+	}
+	// Development storage carries the account in the path, which container paths are appended to
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/"
+	u.RawPath = ""
+	return u, nil
+}
+
+// publicAccessNotPermitted is returned for public containers in accounts that disallow public
+// access; the SDK has no constant for it
+const publicAccessNotPermitted bloberror.Code = "PublicAccessNotPermitted"
+
+// errorCode returns the Blob service error code of err, or "" for other errors
+func errorCode(err error) bloberror.Code {
+	var e *azcore.ResponseError
+	if errors.As(err, &e) {
+		return bloberror.Code(e.ErrorCode)
+	}
+	return ""
+}
+
+// translateError marks known failures with the storage sentinel errors,
+// so callers can detect them with errors.Is; other errors are returned unchanged
+func translateError(err error) error {
+	switch errorCode(err) {
+	case bloberror.BlobNotFound:
+		return fmt.Errorf("%w: %v", storage.ErrObjectNotFound, err)
+	case bloberror.ContainerNotFound, bloberror.ContainerBeingDeleted:
+		return fmt.Errorf("%w: %v", storage.ErrBucketNotFound, err)
+	case bloberror.AuthenticationFailed, bloberror.AuthorizationFailure, bloberror.AuthorizationPermissionMismatch,
+		bloberror.InsufficientAccountPermissions, publicAccessNotPermitted:
+		return fmt.Errorf("%w: %v", storage.ErrAccessDenied, err)
+	case bloberror.ServerBusy, bloberror.OperationTimedOut, bloberror.AccountIsDisabled:
+		return fmt.Errorf("%w: %v", storage.ErrQuotaExceeded, err)
+	case bloberror.MD5Mismatch, bloberror.CRC64Mismatch:
+		return fmt.Errorf("%w: %v", storage.ErrChecksumMismatch, err)
+	case bloberror.FeatureVersionMismatch, bloberror.UnsupportedHeader, bloberror.UnsupportedQueryParameter:
+		return fmt.Errorf("%w: %v", storage.ErrNotSupported, err)
+	}
+	return err
+}
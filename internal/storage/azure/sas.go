@@ -0,0 +1,52 @@
+package azure
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/sas"
+)
+
+// sasOptions are the fields of a blob service SAS
+type sasOptions struct {
+	permissions sas.BlobPermissions
+	expiry      time.Duration
+	// ipAddress restricts the URL to requests from an IPv4 address
+	ipAddress string
+	// encryptionScope encrypts blobs written through the URL with the named scope
+	encryptionScope string
+	// cacheControl and contentType override the headers of responses to reads
+	cacheControl string
+	contentType  string
+}
+
+// sasURL returns the URL of a blob authorized by a service SAS signed with the account key,
+// restricted to HTTPS when the endpoint uses it
+func (a *AzureStorage) sasURL(bucketName, objectKey string, opts sasOptions) (string, error) {
+	client, cred := a.current()
+	blobClient := client.NewContainerClient(bucketName).NewBlobClient(objectKey)
+
+	values := sas.BlobSignatureValues{
+		Protocol:        sas.ProtocolHTTPSandHTTP,
+		ExpiryTime:      time.Now().UTC().Add(opts.expiry),
+		Permissions:     opts.permissions.String(),
+		ContainerName:   bucketName,
+		BlobName:        objectKey,
+		EncryptionScope: opts.encryptionScope,
+		CacheControl:    opts.cacheControl,
+		ContentType:     opts.contentType,
+	}
+	if a.endpoint.Scheme == "https" {
+		values.Protocol = sas.ProtocolHTTPS
+	}
+	if opts.ipAddress != "" {
+		values.IPRange = sas.IPRange{Start: net.ParseIP(opts.ipAddress)}
+	}
+
+	query, err := values.SignWithSharedKey(cred)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign SAS: %w", err)
+	}
+	return blobClient.URL() + "?" + query.Encode(), nil
+}
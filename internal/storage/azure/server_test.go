@@ -0,0 +1,310 @@
+package azure
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofreego/mediabase/internal/storage"
+)
+
+// fakeBlob is a committed blob of the fake Blob service
+type fakeBlob struct {
+	data   []byte
+	header http.Header
+	tags   map[string]string
+	etag   int
+}
+
+// blobServer fakes the parts of the Blob service the backend calls, addressed path style
+type blobServer struct {
+	mu         sync.Mutex
+	containers map[string]bool
+	blobs      map[string]*fakeBlob
+	blocks     map[string][]byte
+	// rejectAuth fails every request as if it were signed with a wrong key
+	rejectAuth bool
+	etags      int
+}
+
+// blobHeaders are the request headers stored with a blob and returned by Get Blob Properties
+var blobHeaders = map[string]string{
+	"X-Ms-Blob-Content-Type":  "Content-Type",
+	"X-Ms-Blob-Cache-Control": "Cache-Control",
+	"X-Ms-Encryption-Scope":   "X-Ms-Encryption-Scope",
+}
+
+func newBlobServer(t *testing.T) (*httptest.Server, *blobServer) {
+	t.Helper()
+	state := &blobServer{
+		containers: make(map[string]bool),
+		blobs:      make(map[string]*fakeBlob),
+		blocks:     make(map[string][]byte),
+	}
+	server := httptest.NewServer(http.HandlerFunc(state.serveHTTP))
+	t.Cleanup(server.Close)
+	return server, state
+}
+
+// newTestStorage connects the backend to a fake Blob service with the development account
+func newTestStorage(t *testing.T, endpoint string, encryption storage.EncryptionConfig) *AzureStorage {
+	t.Helper()
+	a, err := NewAzureStorage(storage.Config{
+		Provider:        storage.ProviderAzure,
+		Endpoint:        endpoint,
+		AccessKeyID:     devStoreAccount,
+		SecretAccessKey: devStoreKey,
+		Encryption:      encryption,
+	})
+	if err != nil {
+		t.Fatalf("NewAzureStorage: %v", err)
+	}
+	return a
+}
+
+func fail(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("x-ms-error-code", code)
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `<?xml version="1.0" encoding="utf-8"?><Error><Code>%s</Code><Message>fake</Message></Error>`, code)
+}
+
+func (s *blobServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return
+	}
+	if s.rejectAuth {
+		fail(w, http.StatusForbidden, "AuthenticationFailed")
+		return
+	}
+
+	query := r.URL.Query()
+	containerName, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if containerName == "" {
+		// List Containers
+		w.Write([]byte(`<?xml version="1.0" encoding="utf-8"?><EnumerationResults><Containers></Containers><NextMarker/></EnumerationResults>`))
+		return
+	}
+	if query.Get("restype") == "container" {
+		s.serveContainer(w, r, containerName)
+		return
+	}
+	if !s.containers[containerName] {
+		fail(w, http.StatusNotFound, "ContainerNotFound")
+		return
+	}
+
+	name := containerName + "/" + key
+	switch {
+	case r.Method == http.MethodPut && query.Get("comp") == "block":
+		s.blocks[query.Get("blockid")] = body
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodPut && query.Get("comp") == "blocklist":
+		var list struct {
+			Latest []string `xml:"Latest"`
+		}
+		if err := xml.Unmarshal(body, &list); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var data []byte
+		for _, id := range list.Latest {
+			data = append(data, s.blocks[id]...)
+		}
+		s.commit(w, r, name, data)
+	case r.Method == http.MethodPut && r.Header.Get("x-ms-copy-source") != "":
+		source, err := url.Parse(r.Header.Get("x-ms-copy-source"))
+		src, ok := s.blobs[strings.TrimPrefix(source.Path, "/")]
+		if err != nil || !ok {
+			fail(w, http.StatusNotFound, "CannotVerifyCopySource")
+			return
+		}
+		header := src.header.Clone()
+		for k := range header {
+			if strings.HasPrefix(k, "X-Ms-Encryption-Scope") {
+				header.Del(k)
+			}
+		}
+		if scope := r.Header.Get("x-ms-encryption-scope"); scope != "" {
+			header.Set("X-Ms-Encryption-Scope", scope)
+		}
+		s.etags++
+		s.blobs[name] = &fakeBlob{data: src.data, header: header, tags: parseTagHeader(r.Header.Get("x-ms-tags")), etag: s.etags}
+		w.Header().Set("ETag", strconv.Quote(strconv.Itoa(s.etags)))
+		w.Header().Set("x-ms-copy-status", "success")
+		w.WriteHeader(http.StatusAccepted)
+	case r.Method == http.MethodPut && query.Get("comp") == "tags":
+		blob, ok := s.blobs[name]
+		if !ok {
+			fail(w, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		var set tagSet
+		if err := xml.Unmarshal(body, &set); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		blob.tags = make(map[string]string)
+		for _, tag := range set.Tags {
+			blob.tags[tag.Key] = tag.Value
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPut && (query.Get("comp") == "properties" || query.Get("comp") == "metadata"):
+		blob, ok := s.blobs[name]
+		if !ok {
+			fail(w, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		for k, v := range r.Header {
+			if query.Get("comp") == "properties" && blobHeaders[k] != "" && k != "X-Ms-Encryption-Scope" {
+				blob.header[blobHeaders[k]] = v
+			}
+		}
+		if query.Get("comp") == "metadata" {
+			for k := range blob.header {
+				if strings.HasPrefix(k, "X-Ms-Meta-") {
+					blob.header.Del(k)
+				}
+			}
+			for k, v := range r.Header {
+				if strings.HasPrefix(k, "X-Ms-Meta-") {
+					blob.header[k] = v
+				}
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodPut:
+		// Put Blob
+		s.commit(w, r, name, body)
+	case r.Method == http.MethodGet && query.Get("comp") == "tags":
+		blob, ok := s.blobs[name]
+		if !ok {
+			fail(w, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		var set tagSet
+		for k, v := range blob.tags {
+			set.Tags = append(set.Tags, tag{Key: k, Value: v})
+		}
+		out, _ := xml.Marshal(set)
+		w.Write(out)
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		blob, ok := s.blobs[name]
+		if !ok {
+			fail(w, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		for k, v := range blob.header {
+			w.Header()[k] = v
+		}
+		w.Header().Set("ETag", strconv.Quote(strconv.Itoa(blob.etag)))
+		w.Header().Set("Last-Modified", time.Unix(0, 0).UTC().Format(http.TimeFormat))
+		data := blob.data
+		status := http.StatusOK
+		if spec := r.Header.Get("x-ms-range"); spec != "" {
+			var start, end int
+			if _, err := fmt.Sscanf(spec, "bytes=%d-%d", &start, &end); err != nil {
+				end = len(data) - 1
+			}
+			end = min(end, len(data)-1)
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(data)))
+			data = data[start : end+1]
+			status = http.StatusPartialContent
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.WriteHeader(status)
+		if r.Method == http.MethodGet {
+			w.Write(data)
+		}
+	case r.Method == http.MethodDelete:
+		if _, ok := s.blobs[name]; !ok {
+			fail(w, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		delete(s.blobs, name)
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// commit stores a blob with the properties, metadata and tags of its request
+func (s *blobServer) commit(w http.ResponseWriter, r *http.Request, name string, data []byte) {
+	header := make(http.Header)
+	for k, v := range r.Header {
+		if target := blobHeaders[k]; target != "" {
+			header[target] = v
+		} else if strings.HasPrefix(k, "X-Ms-Meta-") {
+			header[k] = v
+		}
+	}
+	s.etags++
+	s.blobs[name] = &fakeBlob{data: data, header: header, tags: parseTagHeader(r.Header.Get("x-ms-tags")), etag: s.etags}
+	w.Header().Set("ETag", strconv.Quote(strconv.Itoa(s.etags)))
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (s *blobServer) serveContainer(w http.ResponseWriter, r *http.Request, name string) {
+	query := r.URL.Query()
+	switch {
+	case r.Method == http.MethodPut && query.Get("comp") == "":
+		if s.containers[name] {
+			fail(w, http.StatusConflict, "ContainerAlreadyExists")
+			return
+		}
+		s.containers[name] = true
+		w.WriteHeader(http.StatusCreated)
+	case !s.containers[name]:
+		fail(w, http.StatusNotFound, "ContainerNotFound")
+	case r.Method == http.MethodGet && query.Get("comp") == "list":
+		var b strings.Builder
+		b.WriteString(`<?xml version="1.0" encoding="utf-8"?><EnumerationResults><Blobs>`)
+		for key, blob := range s.blobs {
+			blobName, ok := strings.CutPrefix(key, name+"/")
+			if !ok || !strings.HasPrefix(blobName, query.Get("prefix")) {
+				continue
+			}
+			fmt.Fprintf(&b, `<Blob><Name>%s</Name><Properties><Content-Length>%d</Content-Length><Content-Type>%s</Content-Type><Etag>"%d"</Etag></Properties></Blob>`,
+				blobName, len(blob.data), blob.header.Get("Content-Type"), blob.etag)
+		}
+		b.WriteString(`</Blobs><NextMarker/></EnumerationResults>`)
+		w.Write([]byte(b.String()))
+	case r.Method == http.MethodDelete:
+		delete(s.containers, name)
+		w.WriteHeader(http.StatusAccepted)
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		w.WriteHeader(http.StatusOK)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+type tag struct {
+	Key   string `xml:"Key"`
+	Value string `xml:"Value"`
+}
+
+type tagSet struct {
+	XMLName xml.Name `xml:"Tags"`
+	Tags    []tag    `xml:"TagSet>Tag"`
+}
+
+// parseTagHeader reads the tags set with x-ms-tags, which are query encoded
+func parseTagHeader(header string) map[string]string {
+	values, _ := url.ParseQuery(header)
+	tags := make(map[string]string, len(values))
+	for k := range values {
+		tags[k] = values.Get(k)
+	}
+	return tags
+}
@@ -0,0 +1,37 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"sync"
+)
+
+// copyBufferSize is the size of the buffers objects are streamed through
+const copyBufferSize = 32 << 10
+
+// copyBuffers recycles stream buffers, so concurrent downloads do not allocate one each
+var copyBuffers = sync.Pool{
+	New: func() any {
+		buf := make([]byte, copyBufferSize)
+		return &buf
+	},
+}
+
+// CopyStream copies r into w like io.Copy, but through a pooled buffer instead of allocating one
+// per call. It returns the number of bytes written.
+func CopyStream(w io.Writer, r io.Reader) (int64, error) {
+	buf := copyBuffers.Get().(*[]byte)
+	defer copyBuffers.Put(buf)
+	return io.CopyBuffer(w, r, *buf)
+}
+
+// CopyObjectTo streams a whole object from the backend into w through a pooled buffer and
+// returns the number of bytes written. Cancelling ctx aborts the read.
+func CopyObjectTo(ctx context.Context, s Storage, bucketName, objectKey string, w io.Writer) (int64, error) {
+	reader, err := s.GetObject(ctx, bucketName, objectKey)
+	if err != nil {
+		return 0, err
+	}
+	defer reader.Close()
+	return CopyStream(w, reader)
+}
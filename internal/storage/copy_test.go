@@ -0,0 +1,100 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+)
+
+// onlyReader and onlyWriter hide ReaderFrom and WriterTo, so copies go through the buffer as
+// they do for network streams
+type onlyReader struct{ io.Reader }
+
+type onlyWriter struct{ io.Writer }
+
+// objectStorage serves a single object; the methods the tests do not reach panic
+type objectStorage struct {
+	Storage
+	data   []byte
+	closed bool
+}
+
+func (o *objectStorage) GetObject(ctx context.Context, bucketName, objectKey string) (io.ReadCloser, error) {
+	if objectKey != "a.png" {
+		return nil, ErrObjectNotFound
+	}
+	return o, nil
+}
+
+func (o *objectStorage) Read(p []byte) (int, error) {
+	if len(o.data) == 0 {
+		return 0, io.EOF
+	}
+	n := copy(p, o.data)
+	o.data = o.data[n:]
+	return n, nil
+}
+
+func (o *objectStorage) Close() error {
+	o.closed = true
+	return nil
+}
+
+func TestCopyStream(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789"), copyBufferSize/5)
+	var out bytes.Buffer
+
+	n, err := CopyStream(onlyWriter{&out}, onlyReader{bytes.NewReader(content)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != int64(len(content)) || !bytes.Equal(out.Bytes(), content) {
+		t.Errorf("copied %d bytes, want all %d unchanged", n, len(content))
+	}
+}
+
+func TestCopyObjectTo(t *testing.T) {
+	ctx := context.Background()
+	st := &objectStorage{data: []byte("png data")}
+	var out bytes.Buffer
+
+	n, err := CopyObjectTo(ctx, st, "media", "a.png", &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 8 || out.String() != "png data" {
+		t.Errorf("copied %d bytes %q, want the object", n, out.String())
+	}
+	if !st.closed {
+		t.Error("object reader left open")
+	}
+
+	if _, err := CopyObjectTo(ctx, st, "media", "missing.png", &out); !errors.Is(err, ErrObjectNotFound) {
+		t.Errorf("missing object: %v, want ErrObjectNotFound", err)
+	}
+}
+
+// benchmarkCopy streams 1MB through copyFn the way a download does
+func benchmarkCopy(b *testing.B, copyFn func(io.Writer, io.Reader) (int64, error)) {
+	content := make([]byte, 1<<20)
+	w := onlyWriter{io.Discard}
+	b.SetBytes(int64(len(content)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		if _, err := copyFn(w, onlyReader{bytes.NewReader(content)}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCopyStream(b *testing.B) {
+	benchmarkCopy(b, CopyStream)
+}
+
+// BenchmarkIOCopy is the baseline of allocating a buffer per copy
+func BenchmarkIOCopy(b *testing.B) {
+	benchmarkCopy(b, io.Copy)
+}
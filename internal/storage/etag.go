@@ -0,0 +1,12 @@
+package storage
+
+import "strings"
+
+// NormalizeETag strips the quotes storage wraps ETags in, so an unchanged object reports the same
+// ETag whichever call returned it. Weak ETags keep their W/ prefix.
+func NormalizeETag(etag string) string {
+	if weak, ok := strings.CutPrefix(etag, "W/"); ok {
+		return "W/" + strings.Trim(weak, `"`)
+	}
+	return strings.Trim(etag, `"`)
+}
@@ -0,0 +1,22 @@
+package storage
+
+import "testing"
+
+func TestNormalizeETag(t *testing.T) {
+	for _, tc := range []struct {
+		etag, want string
+	}{
+		{`"d41d8cd98f00b204e9800998ecf8427e"`, "d41d8cd98f00b204e9800998ecf8427e"},
+		{"d41d8cd98f00b204e9800998ecf8427e", "d41d8cd98f00b204e9800998ecf8427e"},
+		{`"9b2cf535f27731c974343645a3985328-2"`, "9b2cf535f27731c974343645a3985328-2"},
+		{`W/"0x8DC1A2B3C4D5E6F"`, "W/0x8DC1A2B3C4D5E6F"},
+		{"", ""},
+	} {
+		if got := NormalizeETag(tc.etag); got != tc.want {
+			t.Errorf("NormalizeETag(%s) = %s, want %s", tc.etag, got, tc.want)
+		}
+		if got := NormalizeETag(tc.want); got != tc.want {
+			t.Errorf("NormalizeETag(%s) = %s, want it unchanged", tc.want, got)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package storage
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Factory opens a Storage backend from its config
+type Factory func(Config) (Storage, error)
+
+var (
+	factoriesMu sync.RWMutex
+	factories   = make(map[string]Factory)
+)
+
+// Register makes a backend available to NewStorage under a provider name. Backend packages
+// register themselves from init, so a binary only needs to import the backends it supports.
+// Registering a provider twice panics.
+func Register(provider string, factory Factory) {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+	if factory == nil {
+		panic("storage: Register factory is nil for provider " + provider)
+	}
+	if _, dup := factories[provider]; dup {
+		panic("storage: Register called twice for provider " + provider)
+	}
+	factories[provider] = factory
+}
+
+// NewStorage opens the backend of the configured provider
+func NewStorage(config Config) (Storage, error) {
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid storage config: %w", err)
+	}
+
+	provider := config.ResolvedProvider()
+	factoriesMu.RLock()
+	factory, ok := factories[provider]
+	factoriesMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("storage provider %s is not available in this build, available: %v", provider, Providers())
+	}
+	return factory(config)
+}
+
+// Providers lists the registered providers in sorted order
+func Providers() []string {
+	factoriesMu.RLock()
+	defer factoriesMu.RUnlock()
+	providers := make([]string, 0, len(factories))
+	for provider := range factories {
+		providers = append(providers, provider)
+	}
+	sort.Strings(providers)
+	return providers
+}
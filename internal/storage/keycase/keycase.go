@@ -0,0 +1,159 @@
+package keycase
+
+import (
+	"context"
+	"io"
+	"iter"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/gofreego/mediabase/internal/storage"
+)
+
+// Case normalizations of object keys
+const (
+	// Lower lower-cases the whole key
+	Lower = "lower"
+	// Extension lower-cases only the extension of the key
+	Extension = "extension"
+)
+
+// Normalize applies a case normalization to an object key. An unknown mode leaves it unchanged.
+func Normalize(mode, objectKey string) string {
+	switch mode {
+	case Lower:
+		return strings.ToLower(objectKey)
+	case Extension:
+		ext := path.Ext(objectKey)
+		return strings.TrimSuffix(objectKey, ext) + strings.ToLower(ext)
+	}
+	return objectKey
+}
+
+// Storage implements storage.Storage by normalizing the case of every object key before it
+// reaches the wrapped backend, so keys differing only in case name the same object.
+// Listing prefixes are lower-cased in Lower mode; in Extension mode they are passed through,
+// since a prefix may end anywhere in a key. Bucket operations are passed through unchanged.
+type Storage struct {
+	storage.Storage
+	mode string
+}
+
+// New wraps a backend so its object keys are normalized with mode
+func New(backend storage.Storage, mode string) *Storage {
+	return &Storage{
+		Storage: backend,
+		mode:    mode,
+	}
+}
+
+// key returns the backend key of an object
+func (c *Storage) key(objectKey string) string {
+	return Normalize(c.mode, objectKey)
+}
+
+// prefix returns the backend prefix of a listing
+func (c *Storage) prefix(prefix string) string {
+	if c.mode == Lower {
+		return strings.ToLower(prefix)
+	}
+	return prefix
+}
+
+func (c *Storage) GeneratePresignedUploadURL(ctx context.Context, bucketName, objectKey, contentType string, expiryDuration time.Duration, maxSize int64, opts storage.UploadOptions) (string, map[string]string, error) {
+	return c.Storage.GeneratePresignedUploadURL(ctx, bucketName, c.key(objectKey), contentType, expiryDuration, maxSize, opts)
+}
+
+func (c *Storage) GeneratePresignedPutURL(ctx context.Context, bucketName, objectKey, contentType string, expiryDuration time.Duration, size int64, opts storage.UploadOptions) (string, map[string]string, error) {
+	return c.Storage.GeneratePresignedPutURL(ctx, bucketName, c.key(objectKey), contentType, expiryDuration, size, opts)
+}
+
+func (c *Storage) GeneratePresignedDownloadURL(ctx context.Context, bucketName, objectKey string, expiryDuration time.Duration, opts storage.DownloadOptions) (string, error) {
+	return c.Storage.GeneratePresignedDownloadURL(ctx, bucketName, c.key(objectKey), expiryDuration, opts)
+}
+
+func (c *Storage) GeneratePresignedHeadURL(ctx context.Context, bucketName, objectKey string, expiryDuration time.Duration, versionID string) (string, error) {
+	return c.Storage.GeneratePresignedHeadURL(ctx, bucketName, c.key(objectKey), expiryDuration, versionID)
+}
+
+func (c *Storage) GeneratePresignedDeleteURL(ctx context.Context, bucketName, objectKey string, expiryDuration time.Duration) (string, error) {
+	return c.Storage.GeneratePresignedDeleteURL(ctx, bucketName, c.key(objectKey), expiryDuration)
+}
+
+func (c *Storage) DeleteObject(ctx context.Context, bucketName, objectKey string) error {
+	return c.Storage.DeleteObject(ctx, bucketName, c.key(objectKey))
+}
+
+func (c *Storage) PutObject(ctx context.Context, bucketName, objectKey string, reader io.Reader, objectSize int64, contentType string, opts storage.UploadOptions) (string, error) {
+	return c.Storage.PutObject(ctx, bucketName, c.key(objectKey), reader, objectSize, contentType, opts)
+}
+
+func (c *Storage) GetObject(ctx context.Context, bucketName, objectKey string) (io.ReadCloser, error) {
+	return c.Storage.GetObject(ctx, bucketName, c.key(objectKey))
+}
+
+func (c *Storage) GetObjectRange(ctx context.Context, bucketName, objectKey string, offset, length int64) (io.ReadCloser, int64, error) {
+	return c.Storage.GetObjectRange(ctx, bucketName, c.key(objectKey), offset, length)
+}
+
+func (c *Storage) ObjectExists(ctx context.Context, bucketName, objectKey string) (bool, error) {
+	return c.Storage.ObjectExists(ctx, bucketName, c.key(objectKey))
+}
+
+func (c *Storage) StatObject(ctx context.Context, bucketName, objectKey string) (*storage.ObjectInfo, error) {
+	return c.Storage.StatObject(ctx, bucketName, c.key(objectKey))
+}
+
+func (c *Storage) ListObjects(ctx context.Context, bucketName, prefix string) iter.Seq2[storage.ObjectInfo, error] {
+	return c.Storage.ListObjects(ctx, bucketName, c.prefix(prefix))
+}
+
+func (c *Storage) CopyObject(ctx context.Context, bucketName, srcKey, dstKey string, opts storage.CopyOptions) error {
+	return c.Storage.CopyObject(ctx, bucketName, c.key(srcKey), c.key(dstKey), opts)
+}
+
+func (c *Storage) ListUploadedParts(ctx context.Context, bucketName, objectKey, uploadID string) ([]storage.UploadedPart, error) {
+	return c.Storage.ListUploadedParts(ctx, bucketName, c.key(objectKey), uploadID)
+}
+
+func (c *Storage) PublicObjectURL(ctx context.Context, bucketName, objectKey string) (string, error) {
+	return c.Storage.PublicObjectURL(ctx, bucketName, c.key(objectKey))
+}
+
+func (c *Storage) SetObjectACL(ctx context.Context, bucketName, objectKey string, acl storage.ObjectACL) error {
+	return c.Storage.SetObjectACL(ctx, bucketName, c.key(objectKey), acl)
+}
+
+func (c *Storage) GetObjectACL(ctx context.Context, bucketName, objectKey string) (storage.ObjectACL, error) {
+	return c.Storage.GetObjectACL(ctx, bucketName, c.key(objectKey))
+}
+
+func (c *Storage) SetObjectTags(ctx context.Context, bucketName, objectKey string, tags map[string]string) error {
+	return c.Storage.SetObjectTags(ctx, bucketName, c.key(objectKey), tags)
+}
+
+func (c *Storage) GetObjectTags(ctx context.Context, bucketName, objectKey string) (map[string]string, error) {
+	return c.Storage.GetObjectTags(ctx, bucketName, c.key(objectKey))
+}
+
+func (c *Storage) ListObjectVersions(ctx context.Context, bucketName, objectKey string) ([]storage.ObjectVersion, error) {
+	return c.Storage.ListObjectVersions(ctx, bucketName, c.key(objectKey))
+}
+
+func (c *Storage) SetObjectRetention(ctx context.Context, bucketName, objectKey, versionID string, retention storage.ObjectRetention) error {
+	return c.Storage.SetObjectRetention(ctx, bucketName, c.key(objectKey), versionID, retention)
+}
+
+func (c *Storage) SetObjectLegalHold(ctx context.Context, bucketName, objectKey, versionID string, hold bool) error {
+	return c.Storage.SetObjectLegalHold(ctx, bucketName, c.key(objectKey), versionID, hold)
+}
+
+func (c *Storage) DeleteObjectVersion(ctx context.Context, bucketName, objectKey, versionID string) error {
+	return c.Storage.DeleteObjectVersion(ctx, bucketName, c.key(objectKey), versionID)
+}
+
+// SetBucketLifecycle scopes the rule to the normalized prefix
+func (c *Storage) SetBucketLifecycle(ctx context.Context, bucketName, prefix string, expirationDays int) error {
+	return c.Storage.SetBucketLifecycle(ctx, bucketName, c.prefix(prefix), expirationDays)
+}
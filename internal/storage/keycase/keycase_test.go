@@ -0,0 +1,75 @@
+package keycase
+
+import (
+	"context"
+	"iter"
+	"slices"
+	"testing"
+
+	"github.com/gofreego/mediabase/internal/storage"
+)
+
+// keyStorage records the keys the wrapper passes on; the methods the tests do not reach panic
+type keyStorage struct {
+	storage.Storage
+	keys []string
+}
+
+func (k *keyStorage) StatObject(ctx context.Context, bucketName, objectKey string) (*storage.ObjectInfo, error) {
+	k.keys = append(k.keys, objectKey)
+	return &storage.ObjectInfo{Key: objectKey}, nil
+}
+
+func (k *keyStorage) CopyObject(ctx context.Context, bucketName, srcKey, dstKey string, opts storage.CopyOptions) error {
+	k.keys = append(k.keys, srcKey, dstKey)
+	return nil
+}
+
+func (k *keyStorage) ListObjects(ctx context.Context, bucketName, prefix string) iter.Seq2[storage.ObjectInfo, error] {
+	k.keys = append(k.keys, prefix)
+	return func(yield func(storage.ObjectInfo, error) bool) {}
+}
+
+func TestNormalize(t *testing.T) {
+	for _, tc := range []struct {
+		mode, key, want string
+	}{
+		{Lower, "Users/Photo.JPG", "users/photo.jpg"},
+		{Extension, "Users/Photo.JPG", "Users/Photo.jpg"},
+		{Extension, "Users/README", "Users/README"},
+		{Extension, "Users.V2/Photo", "Users.V2/Photo"},
+		{Extension, "Archive.Tar.GZ", "Archive.Tar.gz"},
+		{"", "Users/Photo.JPG", "Users/Photo.JPG"},
+	} {
+		if got := Normalize(tc.mode, tc.key); got != tc.want {
+			t.Errorf("Normalize(%q, %q) = %q, want %q", tc.mode, tc.key, got, tc.want)
+		}
+	}
+}
+
+func TestStorageNormalizesKeys(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		mode string
+		want []string
+	}{
+		{Lower, []string{"a/photo.jpg", "a/photo.jpg", "b/copy.png", "users/"}},
+		{Extension, []string{"A/Photo.jpg", "A/Photo.jpg", "B/Copy.png", "Users/"}},
+	} {
+		backend := &keyStorage{}
+		c := New(backend, tc.mode)
+
+		if _, err := c.StatObject(ctx, "media", "A/Photo.JPG"); err != nil {
+			t.Fatal(err)
+		}
+		if err := c.CopyObject(ctx, "media", "A/Photo.JPG", "B/Copy.PNG", storage.CopyOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		for range c.ListObjects(ctx, "media", "Users/") {
+		}
+		if !slices.Equal(backend.keys, tc.want) {
+			t.Errorf("mode %s: backend saw %q, want %q", tc.mode, backend.keys, tc.want)
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package minio
+
+import (
+	"testing"
+
+	"github.com/gofreego/mediabase/internal/storage"
+)
+
+var _ storage.Storage = (*MinIOStorage)(nil)
+
+func TestCapabilities(t *testing.T) {
+	caps := newPresignStorage(t).Capabilities()
+
+	want := storage.Capabilities{BucketPolicy: true, ObjectTagging: true, Versioning: true, ObjectLock: true, Lifecycle: true, CORS: true, ServerSideEncryption: true, ObjectACL: true}
+	if caps != want {
+		t.Errorf("Capabilities() = %+v, want every optional feature: %+v", caps, want)
+	}
+}
@@ -0,0 +1,88 @@
+package minio
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofreego/mediabase/internal/storage"
+)
+
+// newCredentialServer fakes an S3 endpoint that only accepts requests signed with accessKeyID
+func newCredentialServer(t *testing.T, accessKeyID string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/xml")
+		if !strings.Contains(r.Header.Get("Authorization"), "Credential="+accessKeyID+"/") {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><Error><Code>InvalidAccessKeyId</Code><Message>unknown key</Message></Error>`))
+			return
+		}
+		w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><ListAllMyBucketsResult><Buckets></Buckets></ListAllMyBucketsResult>`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newCredentialStorage(t *testing.T, endpoint string) *MinIOStorage {
+	t.Helper()
+	m, err := NewMinIOStorage(storage.Config{
+		Endpoint:        strings.TrimPrefix(endpoint, "http://"),
+		AccessKeyID:     "old-key",
+		SecretAccessKey: "old-secret",
+		Region:          "us-east-1",
+	})
+	if err != nil {
+		t.Fatalf("NewMinIOStorage: %v", err)
+	}
+	return m
+}
+
+func TestUpdateCredentialsSwapsVerifiedKeys(t *testing.T) {
+	server := newCredentialServer(t, "new-key")
+	m := newCredentialStorage(t, server.URL)
+	oldClient := m.client
+
+	if err := m.UpdateCredentials(context.Background(), "new-key", "new-secret"); err != nil {
+		t.Fatalf("UpdateCredentials: %v", err)
+	}
+	if m.config.AccessKeyID != "new-key" || m.config.SecretAccessKey != "new-secret" {
+		t.Errorf("config keeps %s, want the new keys", m.config.AccessKeyID)
+	}
+	if m.client == oldClient {
+		t.Error("client was not replaced")
+	}
+}
+
+func TestUpdateCredentialsKeepsKeysStorageRejects(t *testing.T) {
+	server := newCredentialServer(t, "old-key")
+	m := newCredentialStorage(t, server.URL)
+	oldClient := m.client
+
+	err := m.UpdateCredentials(context.Background(), "wrong-key", "wrong-secret")
+	if !errors.Is(err, storage.ErrAccessDenied) {
+		t.Fatalf("UpdateCredentials error = %v, want ErrAccessDenied", err)
+	}
+	if m.config.AccessKeyID != "old-key" || m.client != oldClient {
+		t.Error("rejected keys replaced the current ones")
+	}
+	// The old keys keep working
+	if _, err := m.client.ListBuckets(context.Background()); err != nil {
+		t.Errorf("ListBuckets with the old keys: %v", err)
+	}
+}
+
+func TestUpdateCredentialsRejectsEmptyKeys(t *testing.T) {
+	server := newCredentialServer(t, "old-key")
+	m := newCredentialStorage(t, server.URL)
+
+	if err := m.UpdateCredentials(context.Background(), "", "secret"); err == nil {
+		t.Fatal("UpdateCredentials accepted an empty access key")
+	}
+	if m.config.AccessKeyID != "old-key" {
+		t.Error("empty keys replaced the current ones")
+	}
+}
@@ -0,0 +1,72 @@
+package minio
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofreego/mediabase/internal/storage"
+	"github.com/minio/minio-go/v7"
+)
+
+func TestTranslateError(t *testing.T) {
+	for code, want := range map[string]error{
+		"NoSuchKey":                      storage.ErrObjectNotFound,
+		"NoSuchVersion":                  storage.ErrObjectNotFound,
+		"NoSuchBucket":                   storage.ErrBucketNotFound,
+		"BucketNotEmpty":                 storage.ErrBucketNotEmpty,
+		"AccessDenied":                   storage.ErrAccessDenied,
+		"SignatureDoesNotMatch":          storage.ErrAccessDenied,
+		"XMinioAdminBucketQuotaExceeded": storage.ErrQuotaExceeded,
+		"NotImplemented":                 storage.ErrNotSupported,
+	} {
+		err := translateError(minio.ErrorResponse{Code: code, Message: "from storage"})
+		if !errors.Is(err, want) {
+			t.Errorf("%s translated to %v, want %v", code, err, want)
+		}
+	}
+
+	other := minio.ErrorResponse{Code: "InternalError"}
+	if err := translateError(other); !errors.Is(err, other) {
+		t.Errorf("unknown code translated to %v, want it unchanged", err)
+	}
+}
+
+// newErrorServer fakes an S3 endpoint answering every request with the error code and status
+func newErrorServer(t *testing.T, code string, statusCode int) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(statusCode)
+		if r.Method != http.MethodHead {
+			w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><Error><Code>` + code + `</Code><Message>not here</Message></Error>`))
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestStorageReturnsSentinelErrors(t *testing.T) {
+	ctx := context.Background()
+	missingKey := newCredentialStorage(t, newErrorServer(t, "NoSuchKey", http.StatusNotFound).URL)
+	missingBucket := newCredentialStorage(t, newErrorServer(t, "NoSuchBucket", http.StatusNotFound).URL)
+
+	if _, err := missingKey.StatObject(ctx, "media", "a.png"); !errors.Is(err, storage.ErrObjectNotFound) {
+		t.Errorf("StatObject of a missing object: %v, want ErrObjectNotFound", err)
+	}
+	// The object is fetched lazily, so the error may surface on the first read
+	reader, err := missingKey.GetObject(ctx, "media", "a.png")
+	if err == nil {
+		_, err = io.ReadAll(reader)
+		reader.Close()
+	}
+	if !errors.Is(err, storage.ErrObjectNotFound) {
+		t.Errorf("GetObject of a missing object: %v, want ErrObjectNotFound", err)
+	}
+	if err := missingBucket.DeleteObject(ctx, "media", "a.png"); !errors.Is(err, storage.ErrBucketNotFound) {
+		t.Errorf("DeleteObject in a missing bucket: %v, want ErrBucketNotFound", err)
+	}
+}
@@ -0,0 +1,127 @@
+package minio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newEndlessListServer answers every ListObjectsV2 request with a full, truncated page, so a
+// listing only ends when the client stops asking. From page failPage on, if set, access is denied.
+func newEndlessListServer(t *testing.T, pages *atomic.Int64, failPage int64) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := pages.Add(1)
+		w.Header().Set("Content-Type", "application/xml")
+		if failPage > 0 && page >= failPage {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><Error><Code>AccessDenied</Code><Message>denied</Message></Error>`))
+			return
+		}
+		var b strings.Builder
+		b.WriteString(`<?xml version="1.0" encoding="UTF-8"?><ListBucketResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/">`)
+		fmt.Fprintf(&b, `<Name>media</Name><KeyCount>100</KeyCount><MaxKeys>100</MaxKeys><IsTruncated>true</IsTruncated><NextContinuationToken>page-%d</NextContinuationToken>`, page)
+		for i := range 100 {
+			fmt.Fprintf(&b, `<Contents><Key>%06d-%03d.png</Key><Size>1</Size><LastModified>2026-01-01T00:00:00.000Z</LastModified><ETag>"e"</ETag></Contents>`, page, i)
+		}
+		b.WriteString(`</ListBucketResult>`)
+		w.Write([]byte(b.String()))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestListObjectsStopsListingWhenIterationStops(t *testing.T) {
+	var pages atomic.Int64
+	m := newCredentialStorage(t, newEndlessListServer(t, &pages, 0).URL)
+
+	done := make(chan int)
+	go func() {
+		seen := 0
+		for _, err := range m.ListObjects(context.Background(), "media", "") {
+			if err != nil {
+				t.Errorf("ListObjects: %v", err)
+				break
+			}
+			seen++
+			if seen == 150 {
+				break
+			}
+		}
+		done <- seen
+	}()
+
+	select {
+	case seen := <-done:
+		if seen != 150 {
+			t.Errorf("iterated %d objects, want 150", seen)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("ListObjects kept listing after the iteration stopped")
+	}
+
+	// The listing goroutine has finished, so no more pages are requested
+	requested := pages.Load()
+	time.Sleep(50 * time.Millisecond)
+	if pages.Load() != requested {
+		t.Errorf("pages still requested after the iteration stopped")
+	}
+}
+
+func TestListingsDoNotLeakGoroutines(t *testing.T) {
+	baseline := runtime.NumGoroutine()
+	var pages atomic.Int64
+	server := newEndlessListServer(t, &pages, 3)
+	m := newCredentialStorage(t, server.URL)
+
+	for range 5 {
+		// The caller stops early
+		for range m.ListObjects(context.Background(), "media", "") {
+			break
+		}
+
+		// The context ends mid-listing
+		ctx, cancel := context.WithCancel(context.Background())
+		for _, err := range m.ListObjects(ctx, "media", "") {
+			if err != nil {
+				break
+			}
+			cancel()
+		}
+		cancel()
+
+		// The listing fails on a later page
+		pages.Store(0)
+		if _, err := m.BucketUsage(context.Background(), "media"); err == nil {
+			t.Error("BucketUsage ignored a failed page")
+		}
+		pages.Store(0)
+		var err error
+		for _, err = range m.ListObjects(context.Background(), "media", "") {
+			if err != nil {
+				break
+			}
+		}
+		if err == nil {
+			t.Error("ListObjects ignored a failed page")
+		}
+		pages.Store(0)
+	}
+
+	// Closing the server ends the connection goroutines; only leaked listings remain
+	server.Close()
+	deadline := time.Now().Add(5 * time.Second)
+	for runtime.NumGoroutine() > baseline && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if n := runtime.NumGoroutine(); n > baseline {
+		buf := make([]byte, 1<<16)
+		t.Errorf("%d goroutines left over from listings:\n%s", n-baseline, buf[:runtime.Stack(buf, true)])
+	}
+}
@@ -0,0 +1,97 @@
+package minio
+
+import (
+	"context"
+	"encoding/base64"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofreego/mediabase/internal/storage"
+)
+
+// newPresignStorage returns storage that signs offline; the region is set, so no request is made
+func newPresignStorage(t *testing.T) *MinIOStorage {
+	t.Helper()
+	m, err := NewMinIOStorage(storage.Config{
+		Endpoint:        "storage.test:9000",
+		AccessKeyID:     "key",
+		SecretAccessKey: "secret",
+		Region:          "us-east-1",
+	})
+	if err != nil {
+		t.Fatalf("NewMinIOStorage: %v", err)
+	}
+	return m
+}
+
+func TestPresignedUploadLocksCacheControl(t *testing.T) {
+	m := newPresignStorage(t)
+	const cacheControl = "public, max-age=31536000, immutable"
+
+	_, form, err := m.GeneratePresignedUploadURL(context.Background(), "media", "a.png", "image/png", time.Hour, 1<<20, storage.UploadOptions{CacheControl: cacheControl})
+	if err != nil {
+		t.Fatalf("GeneratePresignedUploadURL: %v", err)
+	}
+	field := "x-amz-meta-" + storage.CacheControlMetadataKey
+	if form[field] != cacheControl {
+		t.Errorf("form field %s = %q, want %q", field, form[field], cacheControl)
+	}
+	policy, err := base64.StdEncoding.DecodeString(form["policy"])
+	if err != nil {
+		t.Fatalf("policy: %v", err)
+	}
+	condition := `["eq","$` + field + `","` + cacheControl + `"]`
+	if !strings.Contains(string(policy), condition) {
+		t.Errorf("policy %s does not lock %s", policy, condition)
+	}
+
+	_, form, err = m.GeneratePresignedUploadURL(context.Background(), "media", "b.png", "image/png", time.Hour, 1<<20, storage.UploadOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := form[field]; ok {
+		t.Errorf("form has %s without a requested cache control", field)
+	}
+}
+
+func TestPresignedPutSignsCacheControl(t *testing.T) {
+	m := newPresignStorage(t)
+
+	rawURL, headers, err := m.GeneratePresignedPutURL(context.Background(), "media", "a.png", "image/png", time.Hour, 3, storage.UploadOptions{CacheControl: "no-cache"})
+	if err != nil {
+		t.Fatalf("GeneratePresignedPutURL: %v", err)
+	}
+	if headers["Cache-Control"] != "no-cache" {
+		t.Errorf("headers = %v, want Cache-Control", headers)
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed := u.Query().Get("X-Amz-SignedHeaders")
+	for _, header := range []string{"cache-control", "x-amz-meta-" + storage.CacheControlMetadataKey} {
+		if !strings.Contains(signed, header) {
+			t.Errorf("signed headers %q miss %s", signed, header)
+		}
+	}
+}
+
+func TestPresignedUploadRejectsInvalidSizeRange(t *testing.T) {
+	m := newPresignStorage(t)
+
+	for _, tc := range []struct {
+		name             string
+		minSize, maxSize int64
+	}{
+		{"negative max", 0, -1},
+		{"negative min", -1, 1 << 20},
+		{"min above max", 200, 100},
+	} {
+		_, _, err := m.GeneratePresignedUploadURL(context.Background(), "media", "a.png", "image/png", time.Hour, tc.maxSize, storage.UploadOptions{MinSize: tc.minSize})
+		if err == nil {
+			t.Errorf("%s: signed a policy for range %d-%d", tc.name, tc.minSize, tc.maxSize)
+		}
+	}
+}
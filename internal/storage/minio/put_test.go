@@ -0,0 +1,98 @@
+package minio
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gofreego/mediabase/internal/storage"
+)
+
+// putServer commits single PUTs and completed multipart uploads, and counts aborts
+type putServer struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	parts   []byte
+	aborted int
+}
+
+func newPutServer(t *testing.T) (*httptest.Server, *putServer) {
+	t.Helper()
+	state := &putServer{objects: make(map[string][]byte)}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		state.mu.Lock()
+		defer state.mu.Unlock()
+		query := r.URL.Query()
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return
+		}
+		switch {
+		case r.Method == http.MethodPost && query.Has("uploads"):
+			w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><InitiateMultipartUploadResult><UploadId>u1</UploadId></InitiateMultipartUploadResult>`))
+		case r.Method == http.MethodPut && query.Has("partNumber"):
+			state.parts = append(state.parts, body...)
+			w.Header().Set("ETag", `"part"`)
+		case r.Method == http.MethodPost && query.Has("uploadId"):
+			state.objects[r.URL.Path] = state.parts
+			w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><CompleteMultipartUploadResult><ETag>"stored"</ETag></CompleteMultipartUploadResult>`))
+		case r.Method == http.MethodDelete && query.Has("uploadId"):
+			state.aborted++
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodPut:
+			state.objects[r.URL.Path] = body
+			w.Header().Set("ETag", `"stored"`)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, state
+}
+
+func TestPutObjectStoresMatchingMD5(t *testing.T) {
+	server, state := newPutServer(t)
+	m := newCredentialStorage(t, server.URL)
+	content := []byte("hello world")
+	sum := md5.Sum(content)
+
+	etag, err := m.PutObject(context.Background(), "media", "a.txt", bytes.NewReader(content), int64(len(content)), "text/plain",
+		storage.UploadOptions{ContentMD5: strings.ToUpper(hex.EncodeToString(sum[:]))})
+	if err != nil {
+		t.Fatalf("PutObject: %v", err)
+	}
+	if etag != "stored" {
+		t.Errorf("ETag %q, want stored", etag)
+	}
+	// The body may be sent with chunk signatures around the content
+	if got := state.objects["/media/a.txt"]; !bytes.Contains(got, content) {
+		t.Errorf("stored %q, want %q", got, content)
+	}
+}
+
+func TestPutObjectRejectsMD5MismatchBeforeStoring(t *testing.T) {
+	for name, size := range map[string]int64{"known size": 11, "unknown size": -1} {
+		t.Run(name, func(t *testing.T) {
+			server, state := newPutServer(t)
+			m := newCredentialStorage(t, server.URL)
+			sum := md5.Sum([]byte("something else"))
+
+			_, err := m.PutObject(context.Background(), "media", "a.txt", strings.NewReader("hello world"), size, "text/plain",
+				storage.UploadOptions{ContentMD5: hex.EncodeToString(sum[:])})
+			if !errors.Is(err, storage.ErrChecksumMismatch) {
+				t.Fatalf("PutObject error %v, want ErrChecksumMismatch", err)
+			}
+			if len(state.objects) != 0 {
+				t.Errorf("stored %v, want nothing", state.objects)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package prefix
+
+import (
+	"context"
+	"io"
+	"iter"
+	"strings"
+	"time"
+
+	"github.com/gofreego/mediabase/internal/storage"
+)
+
+// Storage implements storage.Storage by placing every object of the wrapped backend under a
+// fixed key prefix, so deployments can share buckets without seeing each other's objects.
+// Callers use keys without the prefix; it is added on the way in and removed on the way out.
+// Bucket operations are passed through unchanged.
+type Storage struct {
+	storage.Storage
+	prefix string
+}
+
+// New wraps a backend so its objects are stored under prefix
+func New(backend storage.Storage, prefix string) *Storage {
+	return &Storage{
+		Storage: backend,
+		prefix:  prefix,
+	}
+}
+
+// key returns the backend key of an object
+func (p *Storage) key(objectKey string) string {
+	return p.prefix + objectKey
+}
+
+func (p *Storage) GeneratePresignedUploadURL(ctx context.Context, bucketName, objectKey, contentType string, expiryDuration time.Duration, maxSize int64, opts storage.UploadOptions) (string, map[string]string, error) {
+	return p.Storage.GeneratePresignedUploadURL(ctx, bucketName, p.key(objectKey), contentType, expiryDuration, maxSize, opts)
+}
+
+func (p *Storage) GeneratePresignedPutURL(ctx context.Context, bucketName, objectKey, contentType string, expiryDuration time.Duration, size int64, opts storage.UploadOptions) (string, map[string]string, error) {
+	return p.Storage.GeneratePresignedPutURL(ctx, bucketName, p.key(objectKey), contentType, expiryDuration, size, opts)
+}
+
+func (p *Storage) GeneratePresignedDownloadURL(ctx context.Context, bucketName, objectKey string, expiryDuration time.Duration, opts storage.DownloadOptions) (string, error) {
+	return p.Storage.GeneratePresignedDownloadURL(ctx, bucketName, p.key(objectKey), expiryDuration, opts)
+}
+
+func (p *Storage) GeneratePresignedHeadURL(ctx context.Context, bucketName, objectKey string, expiryDuration time.Duration, versionID string) (string, error) {
+	return p.Storage.GeneratePresignedHeadURL(ctx, bucketName, p.key(objectKey), expiryDuration, versionID)
+}
+
+func (p *Storage) GeneratePresignedDeleteURL(ctx context.Context, bucketName, objectKey string, expiryDuration time.Duration) (string, error) {
+	return p.Storage.GeneratePresignedDeleteURL(ctx, bucketName, p.key(objectKey), expiryDuration)
+}
+
+func (p *Storage) DeleteObject(ctx context.Context, bucketName, objectKey string) error {
+	return p.Storage.DeleteObject(ctx, bucketName, p.key(objectKey))
+}
+
+func (p *Storage) PutObject(ctx context.Context, bucketName, objectKey string, reader io.Reader, objectSize int64, contentType string, opts storage.UploadOptions) (string, error) {
+	return p.Storage.PutObject(ctx, bucketName, p.key(objectKey), reader, objectSize, contentType, opts)
+}
+
+func (p *Storage) GetObject(ctx context.Context, bucketName, objectKey string) (io.ReadCloser, error) {
+	return p.Storage.GetObject(ctx, bucketName, p.key(objectKey))
+}
+
+func (p *Storage) GetObjectRange(ctx context.Context, bucketName, objectKey string, offset, length int64) (io.ReadCloser, int64, error) {
+	return p.Storage.GetObjectRange(ctx, bucketName, p.key(objectKey), offset, length)
+}
+
+func (p *Storage) ObjectExists(ctx context.Context, bucketName, objectKey string) (bool, error) {
+	return p.Storage.ObjectExists(ctx, bucketName, p.key(objectKey))
+}
+
+func (p *Storage) StatObject(ctx context.Context, bucketName, objectKey string) (*storage.ObjectInfo, error) {
+	info, err := p.Storage.StatObject(ctx, bucketName, p.key(objectKey))
+	if err != nil {
+		return nil, err
+	}
+	info.Key = strings.TrimPrefix(info.Key, p.prefix)
+	return info, nil
+}
+
+// ListObjects lists the objects under the prefix of the deployment and strips it from their keys
+func (p *Storage) ListObjects(ctx context.Context, bucketName, prefix string) iter.Seq2[storage.ObjectInfo, error] {
+	return func(yield func(storage.ObjectInfo, error) bool) {
+		for info, err := range p.Storage.ListObjects(ctx, bucketName, p.key(prefix)) {
+			info.Key = strings.TrimPrefix(info.Key, p.prefix)
+			if !yield(info, err) {
+				return
+			}
+		}
+	}
+}
+
+// BucketUsage sums the sizes of the deployment's objects only, since the bucket is shared
+func (p *Storage) BucketUsage(ctx context.Context, bucketName string) (int64, error) {
+	var usage int64
+	for info, err := range p.Storage.ListObjects(ctx, bucketName, p.prefix) {
+		if err != nil {
+			return 0, err
+		}
+		usage += info.Size
+	}
+	return usage, nil
+}
+
+func (p *Storage) CopyObject(ctx context.Context, bucketName, srcKey, dstKey string, opts storage.CopyOptions) error {
+	return p.Storage.CopyObject(ctx, bucketName, p.key(srcKey), p.key(dstKey), opts)
+}
+
+func (p *Storage) ListUploadedParts(ctx context.Context, bucketName, objectKey, uploadID string) ([]storage.UploadedPart, error) {
+	return p.Storage.ListUploadedParts(ctx, bucketName, p.key(objectKey), uploadID)
+}
+
+func (p *Storage) PublicObjectURL(ctx context.Context, bucketName, objectKey string) (string, error) {
+	return p.Storage.PublicObjectURL(ctx, bucketName, p.key(objectKey))
+}
+
+func (p *Storage) SetObjectACL(ctx context.Context, bucketName, objectKey string, acl storage.ObjectACL) error {
+	return p.Storage.SetObjectACL(ctx, bucketName, p.key(objectKey), acl)
+}
+
+func (p *Storage) GetObjectACL(ctx context.Context, bucketName, objectKey string) (storage.ObjectACL, error) {
+	return p.Storage.GetObjectACL(ctx, bucketName, p.key(objectKey))
+}
+
+func (p *Storage) SetObjectTags(ctx context.Context, bucketName, objectKey string, tags map[string]string) error {
+	return p.Storage.SetObjectTags(ctx, bucketName, p.key(objectKey), tags)
+}
+
+func (p *Storage) GetObjectTags(ctx context.Context, bucketName, objectKey string) (map[string]string, error) {
+	return p.Storage.GetObjectTags(ctx, bucketName, p.key(objectKey))
+}
+
+func (p *Storage) ListObjectVersions(ctx context.Context, bucketName, objectKey string) ([]storage.ObjectVersion, error) {
+	return p.Storage.ListObjectVersions(ctx, bucketName, p.key(objectKey))
+}
+
+func (p *Storage) SetObjectRetention(ctx context.Context, bucketName, objectKey, versionID string, retention storage.ObjectRetention) error {
+	return p.Storage.SetObjectRetention(ctx, bucketName, p.key(objectKey), versionID, retention)
+}
+
+func (p *Storage) SetObjectLegalHold(ctx context.Context, bucketName, objectKey, versionID string, hold bool) error {
+	return p.Storage.SetObjectLegalHold(ctx, bucketName, p.key(objectKey), versionID, hold)
+}
+
+func (p *Storage) DeleteObjectVersion(ctx context.Context, bucketName, objectKey, versionID string) error {
+	return p.Storage.DeleteObjectVersion(ctx, bucketName, p.key(objectKey), versionID)
+}
+
+// SetBucketLifecycle scopes the rule to the objects under the prefix
+func (p *Storage) SetBucketLifecycle(ctx context.Context, bucketName, prefix string, expirationDays int) error {
+	return p.Storage.SetBucketLifecycle(ctx, bucketName, p.key(prefix), expirationDays)
+}
@@ -0,0 +1,100 @@
+package prefix
+
+import (
+	"context"
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gofreego/mediabase/internal/storage"
+)
+
+// keyStorage records the keys the wrapper passes on; the methods the tests do not reach panic
+type keyStorage struct {
+	storage.Storage
+	keys    []string
+	objects map[string]int64
+}
+
+func (k *keyStorage) StatObject(ctx context.Context, bucketName, objectKey string) (*storage.ObjectInfo, error) {
+	k.keys = append(k.keys, objectKey)
+	return &storage.ObjectInfo{Key: objectKey}, nil
+}
+
+func (k *keyStorage) DeleteObject(ctx context.Context, bucketName, objectKey string) error {
+	k.keys = append(k.keys, objectKey)
+	return nil
+}
+
+func (k *keyStorage) CopyObject(ctx context.Context, bucketName, srcKey, dstKey string, opts storage.CopyOptions) error {
+	k.keys = append(k.keys, srcKey, dstKey)
+	return nil
+}
+
+func (k *keyStorage) SetBucketLifecycle(ctx context.Context, bucketName, prefix string, expirationDays int) error {
+	k.keys = append(k.keys, prefix)
+	return nil
+}
+
+func (k *keyStorage) ListObjects(ctx context.Context, bucketName, prefix string) iter.Seq2[storage.ObjectInfo, error] {
+	k.keys = append(k.keys, prefix)
+	return func(yield func(storage.ObjectInfo, error) bool) {
+		for _, key := range slices.Sorted(maps.Keys(k.objects)) {
+			if strings.HasPrefix(key, prefix) && !yield(storage.ObjectInfo{Key: key, Size: k.objects[key]}, nil) {
+				return
+			}
+		}
+	}
+}
+
+func TestStorageAddsPrefix(t *testing.T) {
+	ctx := context.Background()
+	backend := &keyStorage{}
+	p := New(backend, "staging/")
+
+	info, err := p.StatObject(ctx, "media", "a.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Key != "a.png" {
+		t.Errorf("stat key %q, want it without the prefix", info.Key)
+	}
+	p.DeleteObject(ctx, "media", "b.png")
+	p.CopyObject(ctx, "media", "c.png", "d.png", storage.CopyOptions{})
+	p.SetBucketLifecycle(ctx, "media", "tmp/", 1)
+
+	want := []string{"staging/a.png", "staging/b.png", "staging/c.png", "staging/d.png", "staging/tmp/"}
+	if !slices.Equal(backend.keys, want) {
+		t.Errorf("backend keys %v, want %v", backend.keys, want)
+	}
+}
+
+func TestStorageListsOnlyItsPrefix(t *testing.T) {
+	backend := &keyStorage{objects: map[string]int64{"prod/a.png": 1, "staging/a.png": 2, "staging/users/b.png": 3}}
+	p := New(backend, "staging/")
+
+	var keys []string
+	for info, err := range p.ListObjects(context.Background(), "media", "") {
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys = append(keys, info.Key)
+	}
+	if !slices.Equal(keys, []string{"a.png", "users/b.png"}) {
+		t.Errorf("listed %v, want the deployment's objects without the prefix", keys)
+	}
+}
+
+func TestStorageBucketUsageCountsOnlyItsPrefix(t *testing.T) {
+	backend := &keyStorage{objects: map[string]int64{"prod/a.png": 100, "staging/a.png": 2, "staging/users/b.png": 3}}
+
+	usage, err := New(backend, "staging/").BucketUsage(context.Background(), "media")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usage != 5 {
+		t.Errorf("usage = %d, want 5 from the deployment's objects only", usage)
+	}
+}
@@ -0,0 +1,476 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+	"time"
+
+	"github.com/gofreego/mediabase/internal/storage"
+)
+
+// RouteConfig configures a backend and the requests routed to it
+type RouteConfig struct {
+	// BucketPrefix selects buckets whose name starts with it; empty matches every bucket
+	BucketPrefix string `yaml:"BucketPrefix"`
+	// ContentTypes restricts uploads to these content types; "type/*" matches a whole type.
+	// Empty matches every content type.
+	ContentTypes []string `yaml:"ContentTypes"`
+	// Storage configures the backend
+	Storage storage.Config `yaml:"Storage"`
+}
+
+// Route sends matching requests to a backend
+type Route struct {
+	BucketPrefix string
+	ContentTypes []string
+	Backend      storage.Storage
+}
+
+// Router implements storage.Storage by dispatching to several backends.
+// Uploads go to the first route matching the bucket and content type. Other object operations
+// do not know the content type, so they go to the backend among those serving the bucket that
+// holds the object. Bucket operations are applied to every backend serving the bucket.
+// Requests matching no route use the default backend.
+type Router struct {
+	routes         []Route
+	defaultBackend storage.Storage
+}
+
+// NewRouter creates a router; routes are matched in order
+func NewRouter(defaultBackend storage.Storage, routes ...Route) *Router {
+	return &Router{
+		routes:         routes,
+		defaultBackend: defaultBackend,
+	}
+}
+
+// Build opens the default backend and one backend per route config. Without routes the default
+// backend is returned as is, so single-backend deployments pay no routing overhead.
+func Build(defaultConfig storage.Config, routes []RouteConfig, open func(storage.Config) (storage.Storage, error)) (storage.Storage, error) {
+	defaultBackend, err := open(defaultConfig)
+	if err != nil {
+		return nil, err
+	}
+	if len(routes) == 0 {
+		return defaultBackend, nil
+	}
+
+	built := make([]Route, 0, len(routes))
+	for i, rc := range routes {
+		backend, err := open(rc.Storage)
+		if err != nil {
+			return nil, fmt.Errorf("storage route %d: %w", i, err)
+		}
+		built = append(built, Route{
+			BucketPrefix: rc.BucketPrefix,
+			ContentTypes: rc.ContentTypes,
+			Backend:      backend,
+		})
+	}
+	return NewRouter(defaultBackend, built...), nil
+}
+
+// forUpload returns the backend for a new object
+func (r *Router) forUpload(bucketName, contentType string) storage.Storage {
+	for _, route := range r.routes {
+		if strings.HasPrefix(bucketName, route.BucketPrefix) && matchesContentType(route.ContentTypes, contentType) {
+			return route.Backend
+		}
+	}
+	return r.defaultBackend
+}
+
+// candidates returns the distinct backends that may hold objects of a bucket, in route order
+func (r *Router) candidates(bucketName string) []storage.Storage {
+	var backends []storage.Storage
+	for _, route := range r.routes {
+		if strings.HasPrefix(bucketName, route.BucketPrefix) {
+			backends = appendDistinct(backends, route.Backend)
+		}
+	}
+	return appendDistinct(backends, r.defaultBackend)
+}
+
+// locate returns the backend holding an object. When no backend has it, the first candidate
+// is returned so the operation reports the usual not-found error.
+func (r *Router) locate(ctx context.Context, bucketName, objectKey string) (storage.Storage, error) {
+	backends := r.candidates(bucketName)
+	if len(backends) == 1 {
+		return backends[0], nil
+	}
+	for _, backend := range backends {
+		exists, err := backend.ObjectExists(ctx, bucketName, objectKey)
+		if errors.Is(err, storage.ErrBucketNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return backend, nil
+		}
+	}
+	return backends[0], nil
+}
+
+// locateVersions returns the backend holding versions of an object, which may exist
+// after the latest version was deleted
+func (r *Router) locateVersions(ctx context.Context, bucketName, objectKey string) (storage.Storage, []storage.ObjectVersion, error) {
+	backends := r.candidates(bucketName)
+	for _, backend := range backends {
+		versions, err := backend.ListObjectVersions(ctx, bucketName, objectKey)
+		if errors.Is(err, storage.ErrBucketNotFound) && len(backends) > 1 {
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(versions) > 0 {
+			return backend, versions, nil
+		}
+	}
+	return backends[0], nil, nil
+}
+
+// eachBackend applies a bucket operation to every backend serving the bucket
+func (r *Router) eachBackend(bucketName string, fn func(storage.Storage) error) error {
+	for _, backend := range r.candidates(bucketName) {
+		if err := fn(backend); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Router) GeneratePresignedUploadURL(ctx context.Context, bucketName, objectKey, contentType string, expiryDuration time.Duration, maxSize int64, opts storage.UploadOptions) (string, map[string]string, error) {
+	// A content type prefix is routed like the wildcard pattern it stands for
+	routed := contentType
+	if opts.ContentTypePrefix != "" {
+		routed = opts.ContentTypePrefix + "*"
+	}
+	return r.forUpload(bucketName, routed).GeneratePresignedUploadURL(ctx, bucketName, objectKey, contentType, expiryDuration, maxSize, opts)
+}
+
+func (r *Router) GeneratePresignedPutURL(ctx context.Context, bucketName, objectKey, contentType string, expiryDuration time.Duration, size int64, opts storage.UploadOptions) (string, map[string]string, error) {
+	return r.forUpload(bucketName, contentType).GeneratePresignedPutURL(ctx, bucketName, objectKey, contentType, expiryDuration, size, opts)
+}
+
+func (r *Router) GeneratePresignedDownloadURL(ctx context.Context, bucketName, objectKey string, expiryDuration time.Duration, opts storage.DownloadOptions) (string, error) {
+	backend, err := r.locate(ctx, bucketName, objectKey)
+	if err != nil {
+		return "", err
+	}
+	return backend.GeneratePresignedDownloadURL(ctx, bucketName, objectKey, expiryDuration, opts)
+}
+
+func (r *Router) GeneratePresignedHeadURL(ctx context.Context, bucketName, objectKey string, expiryDuration time.Duration, versionID string) (string, error) {
+	backend, err := r.locate(ctx, bucketName, objectKey)
+	if err != nil {
+		return "", err
+	}
+	return backend.GeneratePresignedHeadURL(ctx, bucketName, objectKey, expiryDuration, versionID)
+}
+
+func (r *Router) GeneratePresignedDeleteURL(ctx context.Context, bucketName, objectKey string, expiryDuration time.Duration) (string, error) {
+	backend, err := r.locate(ctx, bucketName, objectKey)
+	if err != nil {
+		return "", err
+	}
+	return backend.GeneratePresignedDeleteURL(ctx, bucketName, objectKey, expiryDuration)
+}
+
+func (r *Router) DeleteObject(ctx context.Context, bucketName, objectKey string) error {
+	backend, err := r.locate(ctx, bucketName, objectKey)
+	if err != nil {
+		return err
+	}
+	return backend.DeleteObject(ctx, bucketName, objectKey)
+}
+
+func (r *Router) PutObject(ctx context.Context, bucketName, objectKey string, reader io.Reader, objectSize int64, contentType string, opts storage.UploadOptions) (string, error) {
+	return r.forUpload(bucketName, contentType).PutObject(ctx, bucketName, objectKey, reader, objectSize, contentType, opts)
+}
+
+func (r *Router) GetObject(ctx context.Context, bucketName, objectKey string) (io.ReadCloser, error) {
+	backend, err := r.locate(ctx, bucketName, objectKey)
+	if err != nil {
+		return nil, err
+	}
+	return backend.GetObject(ctx, bucketName, objectKey)
+}
+
+func (r *Router) GetObjectRange(ctx context.Context, bucketName, objectKey string, offset, length int64) (io.ReadCloser, int64, error) {
+	backend, err := r.locate(ctx, bucketName, objectKey)
+	if err != nil {
+		return nil, 0, err
+	}
+	return backend.GetObjectRange(ctx, bucketName, objectKey, offset, length)
+}
+
+func (r *Router) ObjectExists(ctx context.Context, bucketName, objectKey string) (bool, error) {
+	backend, err := r.locate(ctx, bucketName, objectKey)
+	if err != nil {
+		return false, err
+	}
+	return backend.ObjectExists(ctx, bucketName, objectKey)
+}
+
+func (r *Router) StatObject(ctx context.Context, bucketName, objectKey string) (*storage.ObjectInfo, error) {
+	backend, err := r.locate(ctx, bucketName, objectKey)
+	if err != nil {
+		return nil, err
+	}
+	return backend.StatObject(ctx, bucketName, objectKey)
+}
+
+// CopyObject copies on the backend holding the source. A copy into another bucket must stay on
+// that backend, since it is done server-side, so it fails when the destination bucket routes the
+// content type elsewhere.
+func (r *Router) CopyObject(ctx context.Context, bucketName, srcKey, dstKey string, opts storage.CopyOptions) error {
+	backend, err := r.locate(ctx, bucketName, srcKey)
+	if err != nil {
+		return err
+	}
+	if opts.DestinationBucket != "" && opts.DestinationBucket != bucketName {
+		if r.forUpload(opts.DestinationBucket, opts.ContentType) != backend {
+			return fmt.Errorf("%w: bucket %s stores %s objects on a different backend than bucket %s", storage.ErrNotSupported, opts.DestinationBucket, opts.ContentType, bucketName)
+		}
+	}
+	return backend.CopyObject(ctx, bucketName, srcKey, dstKey, opts)
+}
+
+// ListUploadedParts asks every backend serving the bucket until one knows the upload
+func (r *Router) ListUploadedParts(ctx context.Context, bucketName, objectKey, uploadID string) ([]storage.UploadedPart, error) {
+	var err error
+	for _, backend := range r.candidates(bucketName) {
+		var parts []storage.UploadedPart
+		parts, err = backend.ListUploadedParts(ctx, bucketName, objectKey, uploadID)
+		if !errors.Is(err, storage.ErrObjectNotFound) && !errors.Is(err, storage.ErrBucketNotFound) {
+			return parts, err
+		}
+	}
+	return nil, err
+}
+
+// BucketUsage sums the usage of the bucket across all backends serving it
+func (r *Router) BucketUsage(ctx context.Context, bucketName string) (int64, error) {
+	var total int64
+	for _, backend := range r.candidates(bucketName) {
+		usage, err := backend.BucketUsage(ctx, bucketName)
+		if errors.Is(err, storage.ErrBucketNotFound) {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		total += usage
+	}
+	return total, nil
+}
+
+// ListObjects lists the objects of the bucket on every backend serving it, one backend after
+// another. Backends without the bucket are skipped unless none of them has it.
+func (r *Router) ListObjects(ctx context.Context, bucketName, prefix string) iter.Seq2[storage.ObjectInfo, error] {
+	return func(yield func(storage.ObjectInfo, error) bool) {
+		backends := r.candidates(bucketName)
+		missing := 0
+		for _, backend := range backends {
+			for info, err := range backend.ListObjects(ctx, bucketName, prefix) {
+				if errors.Is(err, storage.ErrBucketNotFound) {
+					missing++
+					break
+				}
+				if !yield(info, err) || err != nil {
+					return
+				}
+			}
+		}
+		if missing == len(backends) {
+			yield(storage.ObjectInfo{}, fmt.Errorf("failed to list objects: %w", storage.ErrBucketNotFound))
+		}
+	}
+}
+
+// BucketExists reports whether any backend serving the bucket has it
+func (r *Router) BucketExists(ctx context.Context, bucketName string) (bool, error) {
+	for _, backend := range r.candidates(bucketName) {
+		exists, err := backend.BucketExists(ctx, bucketName)
+		if err != nil {
+			return false, err
+		}
+		if exists {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *Router) CreateBucket(ctx context.Context, bucketName string, opts storage.BucketOptions) error {
+	return r.eachBackend(bucketName, func(backend storage.Storage) error {
+		return backend.CreateBucket(ctx, bucketName, opts)
+	})
+}
+
+func (r *Router) DeleteBucket(ctx context.Context, bucketName string) error {
+	return r.eachBackend(bucketName, func(backend storage.Storage) error {
+		return backend.DeleteBucket(ctx, bucketName)
+	})
+}
+
+func (r *Router) SetBucketPolicy(ctx context.Context, bucketName string, policy string) error {
+	return r.eachBackend(bucketName, func(backend storage.Storage) error {
+		return backend.SetBucketPolicy(ctx, bucketName, policy)
+	})
+}
+
+// GetBucketPolicy returns the policy of the first backend serving the bucket; bucket policies
+// are applied to all of them alike
+func (r *Router) GetBucketPolicy(ctx context.Context, bucketName string) (string, error) {
+	return r.candidates(bucketName)[0].GetBucketPolicy(ctx, bucketName)
+}
+
+func (r *Router) PublicObjectURL(ctx context.Context, bucketName, objectKey string) (string, error) {
+	backend, err := r.locate(ctx, bucketName, objectKey)
+	if err != nil {
+		return "", err
+	}
+	return backend.PublicObjectURL(ctx, bucketName, objectKey)
+}
+
+func (r *Router) SetObjectACL(ctx context.Context, bucketName, objectKey string, acl storage.ObjectACL) error {
+	backend, err := r.locate(ctx, bucketName, objectKey)
+	if err != nil {
+		return err
+	}
+	return backend.SetObjectACL(ctx, bucketName, objectKey, acl)
+}
+
+func (r *Router) GetObjectACL(ctx context.Context, bucketName, objectKey string) (storage.ObjectACL, error) {
+	backend, err := r.locate(ctx, bucketName, objectKey)
+	if err != nil {
+		return "", err
+	}
+	return backend.GetObjectACL(ctx, bucketName, objectKey)
+}
+
+func (r *Router) SetObjectTags(ctx context.Context, bucketName, objectKey string, tags map[string]string) error {
+	backend, err := r.locate(ctx, bucketName, objectKey)
+	if err != nil {
+		return err
+	}
+	return backend.SetObjectTags(ctx, bucketName, objectKey, tags)
+}
+
+func (r *Router) GetObjectTags(ctx context.Context, bucketName, objectKey string) (map[string]string, error) {
+	backend, err := r.locate(ctx, bucketName, objectKey)
+	if err != nil {
+		return nil, err
+	}
+	return backend.GetObjectTags(ctx, bucketName, objectKey)
+}
+
+func (r *Router) EnableVersioning(ctx context.Context, bucketName string) error {
+	return r.eachBackend(bucketName, func(backend storage.Storage) error {
+		return backend.EnableVersioning(ctx, bucketName)
+	})
+}
+
+func (r *Router) SuspendVersioning(ctx context.Context, bucketName string) error {
+	return r.eachBackend(bucketName, func(backend storage.Storage) error {
+		return backend.SuspendVersioning(ctx, bucketName)
+	})
+}
+
+func (r *Router) ListObjectVersions(ctx context.Context, bucketName, objectKey string) ([]storage.ObjectVersion, error) {
+	_, versions, err := r.locateVersions(ctx, bucketName, objectKey)
+	return versions, err
+}
+
+func (r *Router) DeleteObjectVersion(ctx context.Context, bucketName, objectKey, versionID string) error {
+	backend, _, err := r.locateVersions(ctx, bucketName, objectKey)
+	if err != nil {
+		return err
+	}
+	return backend.DeleteObjectVersion(ctx, bucketName, objectKey, versionID)
+}
+
+func (r *Router) SetObjectRetention(ctx context.Context, bucketName, objectKey, versionID string, retention storage.ObjectRetention) error {
+	backend, _, err := r.locateVersions(ctx, bucketName, objectKey)
+	if err != nil {
+		return err
+	}
+	return backend.SetObjectRetention(ctx, bucketName, objectKey, versionID, retention)
+}
+
+func (r *Router) SetObjectLegalHold(ctx context.Context, bucketName, objectKey, versionID string, hold bool) error {
+	backend, _, err := r.locateVersions(ctx, bucketName, objectKey)
+	if err != nil {
+		return err
+	}
+	return backend.SetObjectLegalHold(ctx, bucketName, objectKey, versionID, hold)
+}
+
+func (r *Router) SetBucketLifecycle(ctx context.Context, bucketName, prefix string, expirationDays int) error {
+	return r.eachBackend(bucketName, func(backend storage.Storage) error {
+		return backend.SetBucketLifecycle(ctx, bucketName, prefix, expirationDays)
+	})
+}
+
+func (r *Router) SetBucketCORS(ctx context.Context, bucketName string, rules []storage.CORSRule) error {
+	return r.eachBackend(bucketName, func(backend storage.Storage) error {
+		return backend.SetBucketCORS(ctx, bucketName, rules)
+	})
+}
+
+// UpdateCredentials is not supported with routes, since each backend has its own keys
+func (r *Router) UpdateCredentials(ctx context.Context, accessKeyID, secretAccessKey string) error {
+	return fmt.Errorf("%w: credentials of routed backends cannot be updated at runtime", storage.ErrNotSupported)
+}
+
+// Capabilities reports the features supported by every backend, since any of them may serve a request
+func (r *Router) Capabilities() storage.Capabilities {
+	caps := r.defaultBackend.Capabilities()
+	for _, route := range r.routes {
+		c := route.Backend.Capabilities()
+		caps.BucketPolicy = caps.BucketPolicy && c.BucketPolicy
+		caps.ObjectTagging = caps.ObjectTagging && c.ObjectTagging
+		caps.Versioning = caps.Versioning && c.Versioning
+		caps.ObjectLock = caps.ObjectLock && c.ObjectLock
+		caps.Lifecycle = caps.Lifecycle && c.Lifecycle
+		caps.CORS = caps.CORS && c.CORS
+		caps.ServerSideEncryption = caps.ServerSideEncryption && c.ServerSideEncryption
+		caps.ObjectACL = caps.ObjectACL && c.ObjectACL
+		caps.ClientIPRestriction = caps.ClientIPRestriction && c.ClientIPRestriction
+	}
+	return caps
+}
+
+// matchesContentType checks a content type against a route's list, where "type/*" matches a whole type
+func matchesContentType(patterns []string, contentType string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if pattern == contentType {
+			return true
+		}
+		if typ, found := strings.CutSuffix(pattern, "/*"); found && strings.HasPrefix(contentType, typ+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// appendDistinct appends a backend unless it is already in the list
+func appendDistinct(backends []storage.Storage, backend storage.Storage) []storage.Storage {
+	for _, b := range backends {
+		if b == backend {
+			return backends
+		}
+	}
+	return append(backends, backend)
+}
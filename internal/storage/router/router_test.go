@@ -0,0 +1,354 @@
+package router
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofreego/mediabase/internal/storage"
+)
+
+// backend is an in-memory storage.Storage holding objects per bucket; the methods the router
+// tests do not reach panic through the embedded nil interface
+type backend struct {
+	storage.Storage
+	name    string
+	caps    storage.Capabilities
+	buckets map[string]map[string][]byte
+}
+
+func newBackend(name string, buckets ...string) *backend {
+	b := &backend{name: name, buckets: make(map[string]map[string][]byte)}
+	for _, bucketName := range buckets {
+		b.buckets[bucketName] = make(map[string][]byte)
+	}
+	return b
+}
+
+func (b *backend) objects(bucketName string) (map[string][]byte, error) {
+	objects, ok := b.buckets[bucketName]
+	if !ok {
+		return nil, storage.ErrBucketNotFound
+	}
+	return objects, nil
+}
+
+func (b *backend) GeneratePresignedUploadURL(ctx context.Context, bucketName, objectKey, contentType string, expiryDuration time.Duration, maxSize int64, opts storage.UploadOptions) (string, map[string]string, error) {
+	return "https://" + b.name + "/" + bucketName, nil, nil
+}
+
+func (b *backend) PutObject(ctx context.Context, bucketName, objectKey string, reader io.Reader, objectSize int64, contentType string, opts storage.UploadOptions) (string, error) {
+	objects, err := b.objects(bucketName)
+	if err != nil {
+		return "", err
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+	objects[objectKey] = data
+	return b.name, nil
+}
+
+func (b *backend) ObjectExists(ctx context.Context, bucketName, objectKey string) (bool, error) {
+	objects, err := b.objects(bucketName)
+	if err != nil {
+		return false, err
+	}
+	_, ok := objects[objectKey]
+	return ok, nil
+}
+
+func (b *backend) GetObject(ctx context.Context, bucketName, objectKey string) (io.ReadCloser, error) {
+	objects, err := b.objects(bucketName)
+	if err != nil {
+		return nil, err
+	}
+	data, ok := objects[objectKey]
+	if !ok {
+		return nil, storage.ErrObjectNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (b *backend) DeleteObject(ctx context.Context, bucketName, objectKey string) error {
+	objects, err := b.objects(bucketName)
+	if err != nil {
+		return err
+	}
+	delete(objects, objectKey)
+	return nil
+}
+
+func (b *backend) CopyObject(ctx context.Context, bucketName, srcKey, dstKey string, opts storage.CopyOptions) error {
+	objects, err := b.objects(bucketName)
+	if err != nil {
+		return err
+	}
+	dst, err := b.objects(cmp.Or(opts.DestinationBucket, bucketName))
+	if err != nil {
+		return err
+	}
+	dst[dstKey] = objects[srcKey]
+	return nil
+}
+
+func (b *backend) CreateBucket(ctx context.Context, bucketName string, opts storage.BucketOptions) error {
+	b.buckets[bucketName] = make(map[string][]byte)
+	return nil
+}
+
+func (b *backend) BucketUsage(ctx context.Context, bucketName string) (int64, error) {
+	objects, err := b.objects(bucketName)
+	if err != nil {
+		return 0, err
+	}
+	var usage int64
+	for _, data := range objects {
+		usage += int64(len(data))
+	}
+	return usage, nil
+}
+
+func (b *backend) ListObjects(ctx context.Context, bucketName, prefix string) iter.Seq2[storage.ObjectInfo, error] {
+	return func(yield func(storage.ObjectInfo, error) bool) {
+		objects, err := b.objects(bucketName)
+		if err != nil {
+			yield(storage.ObjectInfo{}, err)
+			return
+		}
+		for _, key := range slices.Sorted(func(yield func(string) bool) {
+			for key := range objects {
+				if strings.HasPrefix(key, prefix) && !yield(key) {
+					return
+				}
+			}
+		}) {
+			if !yield(storage.ObjectInfo{Key: key, Size: int64(len(objects[key]))}, nil) {
+				return
+			}
+		}
+	}
+}
+
+func (b *backend) Capabilities() storage.Capabilities {
+	return b.caps
+}
+
+// holder returns the name of the backend storing an object
+func holder(bucketName, objectKey string, backends ...*backend) string {
+	for _, b := range backends {
+		if _, ok := b.buckets[bucketName][objectKey]; ok {
+			return b.name
+		}
+	}
+	return ""
+}
+
+// newTestRouter sends images to the images backend and everything in video-* buckets to videos
+func newTestRouter() (r *Router, images, videos, fallback *backend) {
+	images = newBackend("images", "media", "video-archive")
+	videos = newBackend("videos", "video-archive")
+	fallback = newBackend("default", "media", "video-archive", "docs")
+	r = NewRouter(fallback,
+		Route{ContentTypes: []string{"image/*"}, Backend: images},
+		Route{BucketPrefix: "video-", Backend: videos},
+	)
+	return r, images, videos, fallback
+}
+
+func TestRouterDispatchesUploads(t *testing.T) {
+	ctx := context.Background()
+	r, images, videos, fallback := newTestRouter()
+
+	for i, tc := range []struct {
+		bucketName, contentType, want string
+	}{
+		{"media", "image/png", "images"},
+		{"media", "image/jpeg", "images"},
+		{"video-archive", "video/mp4", "videos"},
+		// Routes are matched in order, so images go to the image backend in every bucket
+		{"video-archive", "image/png", "images"},
+		{"media", "application/pdf", "default"},
+		{"docs", "text/plain", "default"},
+	} {
+		objectKey := fmt.Sprintf("obj%d", i)
+		if _, err := r.PutObject(ctx, tc.bucketName, objectKey, strings.NewReader("x"), 1, tc.contentType, storage.UploadOptions{}); err != nil {
+			t.Errorf("%s %s: %v", tc.bucketName, tc.contentType, err)
+			continue
+		}
+		if got := holder(tc.bucketName, objectKey, images, videos, fallback); got != tc.want {
+			t.Errorf("%s %s went to %s, want %s", tc.bucketName, tc.contentType, got, tc.want)
+		}
+
+		u, _, err := r.GeneratePresignedUploadURL(ctx, tc.bucketName, "obj", tc.contentType, time.Minute, 1, storage.UploadOptions{})
+		if err != nil || !strings.HasPrefix(u, "https://"+tc.want+"/") {
+			t.Errorf("presigned %s %s on %q, %v, want %s", tc.bucketName, tc.contentType, u, err, tc.want)
+		}
+	}
+
+	// A content type prefix is routed like its wildcard
+	u, _, _ := r.GeneratePresignedUploadURL(ctx, "media", "obj", "", time.Minute, 1, storage.UploadOptions{ContentTypePrefix: "image/"})
+	if !strings.HasPrefix(u, "https://images/") {
+		t.Errorf("image/ prefix presigned on %q, want images", u)
+	}
+}
+
+func TestRouterFindsObjectsOnTheirBackend(t *testing.T) {
+	ctx := context.Background()
+	r, images, _, fallback := newTestRouter()
+	images.buckets["media"]["a.png"] = []byte("png")
+	fallback.buckets["media"]["a.pdf"] = []byte("pdf")
+
+	for key, want := range map[string]string{"a.png": "png", "a.pdf": "pdf"} {
+		reader, err := r.GetObject(ctx, "media", key)
+		if err != nil {
+			t.Errorf("GetObject %s: %v", key, err)
+			continue
+		}
+		data, _ := io.ReadAll(reader)
+		if string(data) != want {
+			t.Errorf("GetObject %s = %q, want %q", key, data, want)
+		}
+	}
+
+	if _, err := r.GetObject(ctx, "media", "missing"); !errors.Is(err, storage.ErrObjectNotFound) {
+		t.Errorf("GetObject of a missing object: %v, want ErrObjectNotFound", err)
+	}
+
+	if err := r.DeleteObject(ctx, "media", "a.pdf"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := fallback.buckets["media"]["a.pdf"]; ok {
+		t.Error("delete did not reach the backend holding the object")
+	}
+}
+
+func TestRouterUnmatchedRequestsUseDefault(t *testing.T) {
+	ctx := context.Background()
+	fallback := newBackend("default", "docs")
+	r := NewRouter(fallback, Route{BucketPrefix: "video-", Backend: newBackend("videos")})
+
+	if _, err := r.PutObject(ctx, "docs", "a.txt", strings.NewReader("x"), 1, "text/plain", storage.UploadOptions{}); err != nil || holder("docs", "a.txt", fallback) != "default" {
+		t.Errorf("unmatched upload did not reach the default: %v", err)
+	}
+	// Only the default serves the bucket, so nothing is looked up
+	if exists, err := r.ObjectExists(ctx, "docs", "a.txt"); !exists || err != nil {
+		t.Errorf("ObjectExists = %v, %v", exists, err)
+	}
+}
+
+func TestRouterBucketOperationsSpanBackends(t *testing.T) {
+	ctx := context.Background()
+	r, images, videos, fallback := newTestRouter()
+	images.buckets["media"]["a.png"] = []byte("12345")
+	fallback.buckets["media"]["a.pdf"] = []byte("123")
+
+	if err := r.CreateBucket(ctx, "video-new", storage.BucketOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	for _, b := range []*backend{images, videos, fallback} {
+		if _, ok := b.buckets["video-new"]; !ok {
+			t.Errorf("bucket not created on %s", b.name)
+		}
+	}
+
+	usage, err := r.BucketUsage(ctx, "media")
+	if err != nil || usage != 8 {
+		t.Errorf("BucketUsage = %d, %v, want the sum 8", usage, err)
+	}
+
+	var keys []string
+	for info, err := range r.ListObjects(ctx, "media", "") {
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys = append(keys, info.Key)
+	}
+	if !slices.Equal(keys, []string{"a.png", "a.pdf"}) {
+		t.Errorf("listed %v, want the objects of both backends", keys)
+	}
+
+	for _, err := range r.ListObjects(ctx, "nowhere", "") {
+		if !errors.Is(err, storage.ErrBucketNotFound) {
+			t.Errorf("listing a missing bucket: %v, want ErrBucketNotFound", err)
+		}
+	}
+}
+
+func TestRouterCopyStaysOnSourceBackend(t *testing.T) {
+	ctx := context.Background()
+	r, images, _, _ := newTestRouter()
+	images.buckets["media"]["a.png"] = []byte("png")
+
+	err := r.CopyObject(ctx, "media", "a.png", "b.png", storage.CopyOptions{ContentType: "image/png"})
+	if err != nil || string(images.buckets["media"]["b.png"]) != "png" {
+		t.Errorf("copy within the bucket: %v", err)
+	}
+
+	// Images in video-* buckets still go to the image backend, so this copy stays there
+	if err := r.CopyObject(ctx, "media", "a.png", "c.png", storage.CopyOptions{DestinationBucket: "video-archive", ContentType: "image/png"}); err != nil {
+		t.Errorf("copy to a bucket on the same backend: %v", err)
+	}
+	// A PDF in video-archive would be stored on the video backend
+	err = r.CopyObject(ctx, "media", "a.png", "d.pdf", storage.CopyOptions{DestinationBucket: "video-archive", ContentType: "application/pdf"})
+	if !errors.Is(err, storage.ErrNotSupported) {
+		t.Errorf("copy to another backend: %v, want ErrNotSupported", err)
+	}
+}
+
+func TestRouterCapabilitiesAreShared(t *testing.T) {
+	full := storage.Capabilities{BucketPolicy: true, ObjectTagging: true, Versioning: true}
+	images := newBackend("images")
+	images.caps = full
+	fallback := newBackend("default")
+	fallback.caps = storage.Capabilities{ObjectTagging: true}
+	r := NewRouter(fallback, Route{Backend: images})
+
+	caps := r.Capabilities()
+	if caps.BucketPolicy || caps.Versioning || !caps.ObjectTagging {
+		t.Errorf("capabilities = %+v, want only those of every backend", caps)
+	}
+}
+
+func TestBuild(t *testing.T) {
+	var opened []string
+	open := func(cfg storage.Config) (storage.Storage, error) {
+		if cfg.Endpoint == "broken" {
+			return nil, errors.New("unreachable")
+		}
+		opened = append(opened, cfg.Endpoint)
+		return newBackend(cfg.Endpoint), nil
+	}
+
+	single, err := Build(storage.Config{Endpoint: "main"}, nil, open)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := single.(*backend); !ok {
+		t.Errorf("Build without routes returned %T, want the backend itself", single)
+	}
+
+	routed, err := Build(storage.Config{Endpoint: "main"}, []RouteConfig{{ContentTypes: []string{"video/*"}, Storage: storage.Config{Endpoint: "videos"}}}, open)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := routed.(*Router); !ok {
+		t.Errorf("Build with routes returned %T, want a router", routed)
+	}
+	if !slices.Equal(opened, []string{"main", "main", "videos"}) {
+		t.Errorf("opened %v", opened)
+	}
+
+	if _, err := Build(storage.Config{Endpoint: "main"}, []RouteConfig{{Storage: storage.Config{Endpoint: "broken"}}}, open); err == nil || !strings.Contains(err.Error(), "route 0") {
+		t.Errorf("Build with a broken route: %v, want an error naming the route", err)
+	}
+}
@@ -2,10 +2,34 @@ package storage
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io"
+	"iter"
 	"time"
 )
 
+// ErrChecksumMismatch is returned when uploaded content does not match its expected checksum
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+// ErrObjectNotFound is returned when the requested object, object version or multipart upload does not exist
+var ErrObjectNotFound = errors.New("object not found")
+
+// ErrBucketNotFound is returned when the requested bucket does not exist
+var ErrBucketNotFound = errors.New("bucket not found")
+
+// ErrBucketNotEmpty is returned when a bucket to delete still holds objects
+var ErrBucketNotEmpty = errors.New("bucket not empty")
+
+// ErrAccessDenied is returned when the storage credentials lack permission for an operation
+var ErrAccessDenied = errors.New("access denied")
+
+// ErrQuotaExceeded is returned when storage refuses a request because a quota, capacity or rate limit was reached
+var ErrQuotaExceeded = errors.New("storage quota exceeded")
+
+// ErrNotSupported is returned when the storage server rejects an operation it does not implement
+var ErrNotSupported = errors.New("operation not supported by storage backend")
+
 // Storage defines the interface for object storage operations
 // This abstraction allows easy migration between different storage providers (MinIO, S3, GCS, etc.)
 type Storage interface {
@@ -17,11 +41,28 @@ type Storage interface {
 	//   - contentType: MIME type of the file
 	//   - expiryDuration: how long the URL should remain valid
 	//   - maxSize: maximum allowed file size in bytes (enforced by storage)
+	//   - opts: optional attributes to persist with the uploaded object
 	// Returns:
 	//   - URL string
 	//   - Form data map (for POST uploads)
 	//   - error if operation fails
-	GeneratePresignedUploadURL(ctx context.Context, bucketName, objectKey, contentType string, expiryDuration time.Duration, maxSize int64) (string, map[string]string, error)
+	GeneratePresignedUploadURL(ctx context.Context, bucketName, objectKey, contentType string, expiryDuration time.Duration, maxSize int64, opts UploadOptions) (string, map[string]string, error)
+
+	// GeneratePresignedPutURL creates a presigned PUT URL for uploading a file. Unlike POST policies,
+	// PUT URLs cannot express a size range, so the exact size is signed as the Content-Length header.
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - bucketName: name of the bucket
+	//   - objectKey: the key/path where the object will be stored
+	//   - contentType: MIME type of the file
+	//   - expiryDuration: how long the URL should remain valid
+	//   - size: exact file size in bytes (enforced by storage)
+	//   - opts: optional attributes to persist with the uploaded object
+	// Returns:
+	//   - URL string
+	//   - Headers the client must send unchanged with the PUT request
+	//   - error if operation fails
+	GeneratePresignedPutURL(ctx context.Context, bucketName, objectKey, contentType string, expiryDuration time.Duration, size int64, opts UploadOptions) (string, map[string]string, error)
 
 	// GeneratePresignedDownloadURL creates a presigned URL for downloading a file
 	// Parameters:
@@ -29,10 +70,34 @@ type Storage interface {
 	//   - bucketName: name of the bucket
 	//   - objectKey: the key/path of the object to download
 	//   - expiryDuration: how long the URL should remain valid
+	//   - opts: optional parameters of the download
+	// Returns:
+	//   - presigned URL string
+	//   - error if operation fails
+	GeneratePresignedDownloadURL(ctx context.Context, bucketName, objectKey string, expiryDuration time.Duration, opts DownloadOptions) (string, error)
+
+	// GeneratePresignedHeadURL creates a presigned URL for reading the headers of a file with a HEAD request
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - bucketName: name of the bucket
+	//   - objectKey: the key/path of the object
+	//   - expiryDuration: how long the URL should remain valid
+	//   - versionID: the version to inspect, empty for the latest one
+	// Returns:
+	//   - presigned URL string
+	//   - error if operation fails
+	GeneratePresignedHeadURL(ctx context.Context, bucketName, objectKey string, expiryDuration time.Duration, versionID string) (string, error)
+
+	// GeneratePresignedDeleteURL creates a presigned URL for deleting a file with a DELETE request
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - bucketName: name of the bucket
+	//   - objectKey: the key/path of the object to delete
+	//   - expiryDuration: how long the URL should remain valid
 	// Returns:
 	//   - presigned URL string
 	//   - error if operation fails
-	GeneratePresignedDownloadURL(ctx context.Context, bucketName, objectKey string, expiryDuration time.Duration) (string, error)
+	GeneratePresignedDeleteURL(ctx context.Context, bucketName, objectKey string, expiryDuration time.Duration) (string, error)
 
 	// DeleteObject removes a file from storage
 	// Parameters:
@@ -51,9 +116,11 @@ type Storage interface {
 	//   - reader: data stream to upload
 	//   - objectSize: size of the object in bytes
 	//   - contentType: MIME type of the file
+	//   - opts: optional attributes to persist with the uploaded object
 	// Returns:
+	//   - the ETag of the stored object
 	//   - error if operation fails
-	PutObject(ctx context.Context, bucketName, objectKey string, reader io.Reader, objectSize int64, contentType string) error
+	PutObject(ctx context.Context, bucketName, objectKey string, reader io.Reader, objectSize int64, contentType string, opts UploadOptions) (string, error)
 
 	// GetObject downloads a file from storage
 	// Parameters:
@@ -65,6 +132,19 @@ type Storage interface {
 	//   - error if operation fails
 	GetObject(ctx context.Context, bucketName, objectKey string) (io.ReadCloser, error)
 
+	// GetObjectRange retrieves a byte range of an object
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - bucketName: name of the bucket
+	//   - objectKey: the key/path of the object to download
+	//   - offset: first byte to read
+	//   - length: number of bytes to read; a negative length reads to the end of the object
+	// Returns:
+	//   - reader for the requested bytes
+	//   - total size of the object, for building Content-Range headers
+	//   - error if operation fails
+	GetObjectRange(ctx context.Context, bucketName, objectKey string, offset, length int64) (io.ReadCloser, int64, error)
+
 	// ObjectExists checks if an object exists in storage
 	// Parameters:
 	//   - ctx: context for the operation
@@ -75,13 +155,83 @@ type Storage interface {
 	//   - error if operation fails
 	ObjectExists(ctx context.Context, bucketName, objectKey string) (bool, error)
 
+	// StatObject fetches the attributes and user metadata of an object
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - bucketName: name of the bucket
+	//   - objectKey: the key/path of the object
+	// Returns:
+	//   - object attributes
+	//   - error if operation fails
+	StatObject(ctx context.Context, bucketName, objectKey string) (*ObjectInfo, error)
+
+	// CopyObject copies an object to another key without transferring its content, within the bucket
+	// or into opts.DestinationBucket
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - bucketName: name of the source bucket
+	//   - srcKey: the key/path of the source object
+	//   - dstKey: the key/path of the copy; may equal srcKey when replacing metadata
+	//   - opts: attributes to replace instead of copying them from the source
+	// Returns:
+	//   - error if operation fails
+	CopyObject(ctx context.Context, bucketName, srcKey, dstKey string, opts CopyOptions) error
+
+	// ListUploadedParts lists the parts already uploaded to an in-progress multipart upload
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - bucketName: name of the bucket
+	//   - objectKey: the key/path the upload will be stored under
+	//   - uploadID: ID of the multipart upload
+	// Returns:
+	//   - uploaded parts, ordered by part number
+	//   - error if operation fails; ErrObjectNotFound for unknown upload IDs
+	ListUploadedParts(ctx context.Context, bucketName, objectKey, uploadID string) ([]UploadedPart, error)
+
+	// BucketUsage sums the sizes of the current objects in a bucket
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - bucketName: name of the bucket
+	// Returns:
+	//   - total size in bytes
+	//   - error if operation fails
+	BucketUsage(ctx context.Context, bucketName string) (int64, error)
+
+	// ListObjects iterates over the current objects in a bucket whose key starts with a prefix.
+	// Stopping the iteration early cancels the listing.
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - bucketName: name of the bucket
+	//   - prefix: key prefix of the objects to list, empty for all objects
+	// Returns:
+	//   - sequence of object attributes without user metadata, ending after the first error
+	ListObjects(ctx context.Context, bucketName, prefix string) iter.Seq2[ObjectInfo, error]
+
+	// BucketExists checks if a bucket exists
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - bucketName: name of the bucket
+	// Returns:
+	//   - true if bucket exists, false otherwise
+	//   - error if operation fails
+	BucketExists(ctx context.Context, bucketName string) (bool, error)
+
 	// CreateBucket creates a new bucket if it doesn't exist
 	// Parameters:
 	//   - ctx: context for the operation
 	//   - bucketName: name of the bucket to create
+	//   - opts: features that can only be enabled when the bucket is created
 	// Returns:
 	//   - error if operation fails
-	CreateBucket(ctx context.Context, bucketName string) error
+	CreateBucket(ctx context.Context, bucketName string, opts BucketOptions) error
+
+	// DeleteBucket removes an empty bucket
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - bucketName: name of the bucket to delete
+	// Returns:
+	//   - error if operation fails, wrapping ErrBucketNotEmpty if the bucket still holds objects
+	DeleteBucket(ctx context.Context, bucketName string) error
 
 	// SetBucketPolicy sets the access policy for a bucket
 	// Parameters:
@@ -91,13 +241,522 @@ type Storage interface {
 	// Returns:
 	//   - error if operation fails
 	SetBucketPolicy(ctx context.Context, bucketName string, policy string) error
+
+	// GetBucketPolicy fetches the access policy of a bucket
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - bucketName: name of the bucket
+	// Returns:
+	//   - JSON policy string, empty if the bucket has no policy
+	//   - error if operation fails
+	GetBucketPolicy(ctx context.Context, bucketName string) (string, error)
+
+	// PublicObjectURL builds the unsigned URL of an object, which only works if the bucket
+	// allows anonymous reads
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - bucketName: name of the bucket
+	//   - objectKey: the key/path of the object
+	// Returns:
+	//   - direct URL of the object
+	//   - error if operation fails
+	PublicObjectURL(ctx context.Context, bucketName, objectKey string) (string, error)
+
+	// SetObjectACL makes an object readable by anyone or only by signed requests
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - bucketName: name of the bucket
+	//   - objectKey: the key/path of the object
+	//   - acl: ACLPrivate or ACLPublicRead
+	// Returns:
+	//   - error if operation fails
+	SetObjectACL(ctx context.Context, bucketName, objectKey string, acl ObjectACL) error
+
+	// GetObjectACL reports whether an object is readable by anyone
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - bucketName: name of the bucket
+	//   - objectKey: the key/path of the object
+	// Returns:
+	//   - ACLPublicRead if anonymous reads are allowed, else ACLPrivate
+	//   - error if operation fails
+	GetObjectACL(ctx context.Context, bucketName, objectKey string) (ObjectACL, error)
+
+	// SetObjectTags replaces the tags of an object
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - bucketName: name of the bucket
+	//   - objectKey: the key/path of the object
+	//   - tags: key/value tags to set
+	// Returns:
+	//   - error if operation fails
+	SetObjectTags(ctx context.Context, bucketName, objectKey string, tags map[string]string) error
+
+	// GetObjectTags fetches the tags of an object
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - bucketName: name of the bucket
+	//   - objectKey: the key/path of the object
+	// Returns:
+	//   - key/value tags of the object
+	//   - error if operation fails
+	GetObjectTags(ctx context.Context, bucketName, objectKey string) (map[string]string, error)
+
+	// SetObjectRetention protects an object version from deletion and overwrites until a date.
+	// The bucket must have been created with object locking.
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - bucketName: name of the bucket
+	//   - objectKey: the key/path of the object
+	//   - versionID: the version to protect, empty for the latest
+	//   - retention: the retention mode and date
+	// Returns:
+	//   - error if operation fails
+	SetObjectRetention(ctx context.Context, bucketName, objectKey, versionID string, retention ObjectRetention) error
+
+	// SetObjectLegalHold places or removes a legal hold, which protects an object version from
+	// deletion and overwrites until it is removed, whatever its retention
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - bucketName: name of the bucket
+	//   - objectKey: the key/path of the object
+	//   - versionID: the version to hold, empty for the latest
+	//   - hold: true to place the hold, false to remove it
+	// Returns:
+	//   - error if operation fails
+	SetObjectLegalHold(ctx context.Context, bucketName, objectKey, versionID string, hold bool) error
+
+	// EnableVersioning turns on versioning for a bucket
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - bucketName: name of the bucket
+	// Returns:
+	//   - error if operation fails
+	EnableVersioning(ctx context.Context, bucketName string) error
+
+	// SuspendVersioning stops creating new versions in a bucket, keeping existing ones
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - bucketName: name of the bucket
+	// Returns:
+	//   - error if operation fails
+	SuspendVersioning(ctx context.Context, bucketName string) error
+
+	// ListObjectVersions lists all versions (including delete markers) of an object, newest first
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - bucketName: name of the bucket
+	//   - objectKey: the key/path of the object
+	// Returns:
+	//   - versions of the object
+	//   - error if operation fails
+	ListObjectVersions(ctx context.Context, bucketName, objectKey string) ([]ObjectVersion, error)
+
+	// DeleteObjectVersion permanently removes a specific version of an object
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - bucketName: name of the bucket
+	//   - objectKey: the key/path of the object
+	//   - versionID: the version to delete
+	// Returns:
+	//   - error if operation fails
+	DeleteObjectVersion(ctx context.Context, bucketName, objectKey, versionID string) error
+
+	// SetBucketLifecycle expires objects under a prefix after the given number of days.
+	// An existing expiration rule for the same prefix is replaced; other rules are kept.
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - bucketName: name of the bucket
+	//   - prefix: key prefix the rule applies to
+	//   - expirationDays: days after creation when objects expire
+	// Returns:
+	//   - error if operation fails
+	SetBucketLifecycle(ctx context.Context, bucketName, prefix string, expirationDays int) error
+
+	// SetBucketCORS replaces the CORS configuration of a bucket
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - bucketName: name of the bucket
+	//   - rules: CORS rules to apply
+	// Returns:
+	//   - error if operation fails, wrapping ErrNotSupported if the server lacks bucket CORS
+	SetBucketCORS(ctx context.Context, bucketName string, rules []CORSRule) error
+
+	// UpdateCredentials replaces the access keys used by later requests once the storage server
+	// has accepted them. If verification fails, the current keys stay in use.
+	// Parameters:
+	//   - ctx: context for the operation
+	//   - accessKeyID: the new access key ID
+	//   - secretAccessKey: the new secret access key
+	// Returns:
+	//   - error if operation fails, wrapping ErrAccessDenied if the server rejects the keys
+	UpdateCredentials(ctx context.Context, accessKeyID, secretAccessKey string) error
+
+	// Capabilities reports which optional features the storage backend supports
+	// Returns:
+	//   - the set of supported features
+	Capabilities() Capabilities
+}
+
+// Capabilities describes optional features that not every storage backend supports.
+// Callers should check them before using the corresponding operations so unsupported
+// requests can be rejected with a clear message instead of an obscure provider error.
+type Capabilities struct {
+	// BucketPolicy indicates support for bucket access policies
+	BucketPolicy bool
+	// ObjectTagging indicates support for key/value tags on objects
+	ObjectTagging bool
+	// Versioning indicates support for bucket versioning
+	Versioning bool
+	// ObjectLock indicates support for object lock (retention and legal hold)
+	ObjectLock bool
+	// Lifecycle indicates support for bucket lifecycle (expiration) rules
+	Lifecycle bool
+	// CORS indicates support for bucket CORS configuration
+	CORS bool
+	// ServerSideEncryption indicates support for SSE-S3 and SSE-KMS encryption of new objects
+	ServerSideEncryption bool
+	// ObjectACL indicates support for making single objects public in an otherwise private bucket
+	ObjectACL bool
+	// ClientIPRestriction indicates support for presigned downloads restricted to a client IP address
+	ClientIPRestriction bool
+}
+
+// BucketOptions holds the features of a bucket that can only be enabled when it is created
+type BucketOptions struct {
+	// ObjectLocking allows retention and legal holds on the objects of the bucket. It also turns
+	// on versioning, which can then no longer be suspended.
+	ObjectLocking bool
+}
+
+// RetentionMode decides who may shorten or remove the retention of an object
+type RetentionMode string
+
+const (
+	// RetentionGovernance lets users with special permission shorten or remove the retention
+	RetentionGovernance RetentionMode = "GOVERNANCE"
+	// RetentionCompliance lets no one shorten or remove the retention
+	RetentionCompliance RetentionMode = "COMPLIANCE"
+)
+
+// ObjectRetention protects an object version until a date
+type ObjectRetention struct {
+	Mode        RetentionMode
+	RetainUntil time.Time
+	// BypassGovernance allows shortening or replacing a retention in governance mode
+	BypassGovernance bool
+}
+
+// ObjectACL is the access level of a single object
+type ObjectACL string
+
+const (
+	// ACLPrivate only allows signed requests to read the object
+	ACLPrivate ObjectACL = "private"
+	// ACLPublicRead allows anyone to read the object
+	ACLPublicRead ObjectACL = "public-read"
+)
+
+// CORSRule allows cross-origin browser requests to a bucket
+type CORSRule struct {
+	AllowedOrigins []string
+	AllowedMethods []string
+	AllowedHeaders []string
+	ExposeHeaders  []string
+	MaxAgeSeconds  int
+}
+
+// UploadOptions holds optional attributes that are persisted with an uploaded object
+type UploadOptions struct {
+	// CacheControl is the Cache-Control value stored with the object.
+	// For presigned POST uploads it is locked into the policy as the
+	// CacheControlMetadataKey user metadata, since POST policies cannot
+	// constrain the Cache-Control header itself.
+	CacheControl string
+
+	// ChecksumSHA256 is the expected hex-encoded SHA-256 of the content.
+	// Direct uploads send it to storage, which rejects mismatching content with ErrChecksumMismatch;
+	// presigned uploads record it as ChecksumSHA256MetadataKey user metadata for later verification.
+	ChecksumSHA256 string
+
+	// ContentMD5 is the expected hex-encoded MD5 of the content (direct uploads only)
+	ContentMD5 string
+
+	// Tags are applied to the object. Direct uploads tag the object as it is stored;
+	// presigned uploads record them URL-encoded as TagsMetadataKey user metadata
+	// so they can be applied once the upload is confirmed.
+	Tags map[string]string
+
+	// Metadata is application-defined user metadata stored with the object as x-amz-meta-* headers.
+	// Keys must not collide with the metadata keys reserved below.
+	Metadata map[string]string
+
+	// MetadataStartsWith is application-defined user metadata whose value is only constrained to
+	// start with the given prefix, leaving the rest to the uploader (presigned POST only)
+	MetadataStartsWith map[string]string
+
+	// IfNoneMatch makes storage refuse the upload with 412 Precondition Failed if the key is
+	// already taken, atomically with the write (presigned PUT only)
+	IfNoneMatch bool
+
+	// KMSKeyID overrides the configured SSE-KMS key the object is encrypted with
+	KMSKeyID string
+
+	// MinSize is the smallest content length storage accepts, in bytes (presigned POST only;
+	// PUT uploads sign an exact size)
+	MinSize int64
+
+	// KeyPrefixOnly treats the object key as a prefix that the uploader completes in the key
+	// form field; the policy rejects keys that do not start with it (presigned POST only)
+	KeyPrefixOnly bool
+
+	// ContentTypePrefix replaces the exact content type with a prefix the uploader's content type
+	// must start with; the content type argument is then empty (presigned POST only)
+	ContentTypePrefix string
+
+	// SuccessActionRedirect is the URL storage redirects the browser to after a successful
+	// presigned POST upload (POST only)
+	SuccessActionRedirect string
+
+	// SuccessActionStatus is the HTTP status storage answers a successful presigned POST upload
+	// with: 200, 201 or 204 (POST only; 0 keeps the storage default of 204)
+	SuccessActionStatus int
+}
+
+// CopyOptions holds the attributes of a copied object
+type CopyOptions struct {
+	// DestinationBucket is the bucket of the copy; empty copies within the source bucket
+	DestinationBucket string
+
+	// ReplaceMetadata gives the copy the attributes below instead of those of the source.
+	// All of them are replaced, so unchanged values must be carried over by the caller.
+	ReplaceMetadata bool
+
+	// ContentType is the Content-Type of the copy
+	ContentType string
+
+	// CacheControl is the Cache-Control value of the copy
+	CacheControl string
+
+	// Metadata is the complete user metadata of the copy, keyed without the x-amz-meta- prefix
+	Metadata map[string]string
+}
+
+// DownloadOptions holds optional parameters of a presigned download
+type DownloadOptions struct {
+	// VersionID selects a specific object version instead of the latest one
+	VersionID string
+
+	// CacheControl overrides the Cache-Control header of the download response
+	CacheControl string
+
+	// ContentType overrides the Content-Type header of the download response
+	ContentType string
+
+	// AllowedClientIP restricts the URL to requests from a single client IP address. Backends
+	// that cannot enforce the restriction return ErrNotSupported rather than ignoring it.
+	AllowedClientIP string
+}
+
+// ObjectVersion describes one version of an object in a versioned bucket
+type ObjectVersion struct {
+	VersionID      string
+	IsLatest       bool
+	IsDeleteMarker bool
+	Size           int64
+	ETag           string
+	LastModified   time.Time
+}
+
+// UploadedPart describes a part of an in-progress multipart upload
+type UploadedPart struct {
+	PartNumber   int
+	Size         int64
+	ETag         string
+	LastModified time.Time
 }
 
+// ObjectInfo holds the attributes of a stored object
+type ObjectInfo struct {
+	Key          string
+	Size         int64
+	ContentType  string
+	ETag         string
+	LastModified time.Time
+	// UserMetadata holds user-defined metadata keyed by lower-case name, without the x-amz-meta- prefix
+	UserMetadata map[string]string
+	// Expiration is when a lifecycle rule expires the object; zero when no rule applies
+	// or the backend does not report it (StatObject only)
+	Expiration time.Time
+	// KMSKeyID is the SSE-KMS key the object is encrypted with, or the encryption scope on
+	// azure, so rewrites can keep it. It is only reported when the backend is configured for
+	// SSE-KMS, since only then can it be passed as UploadOptions.KMSKeyID (StatObject only).
+	KMSKeyID string
+}
+
+// User metadata keys under which the service records attributes for later use
+const (
+	CacheControlMetadataKey   = "cache-control"
+	ChecksumSHA256MetadataKey = "checksum-sha256"
+	TagsMetadataKey           = "tags"
+	// OriginalKeyMetadataKey records the key a soft-deleted object had before it was moved to the trash
+	OriginalKeyMetadataKey = "original-key"
+)
+
 // Config holds common configuration for storage providers
 type Config struct {
-	Endpoint        string `yaml:"Endpoint"`
+	// Provider selects the storage backend: minio (default, any S3-compatible store), s3, gcs or azure
+	Provider string `yaml:"Provider"`
+	// Endpoint is the storage host. It defaults to the public endpoint for s3 and gcs; for azure
+	// it is the Blob service URL and defaults to https://<account>.blob.core.windows.net.
+	Endpoint string `yaml:"Endpoint"`
+	// AccessKeyID and SecretAccessKey are the account name and key for azure
 	AccessKeyID     string `yaml:"AccessKeyID"`
 	SecretAccessKey string `yaml:"SecretAccessKey"`
-	Region          string `yaml:"Region"`
-	UseSSL          bool   `yaml:"UseSSL"`
+	// ConnectionString is an Azure Storage connection string, used instead of the endpoint,
+	// account name and key (azure only)
+	ConnectionString string `yaml:"ConnectionString"`
+	Region           string `yaml:"Region"`
+	UseSSL           bool   `yaml:"UseSSL"`
+	// PathStyle addresses buckets as endpoint/bucket, which endpoints such as Ceph RGW require.
+	// It is shorthand for BucketLookup path.
+	PathStyle bool `yaml:"PathStyle"`
+	// BucketLookup selects how buckets are addressed: auto (default), path or dns
+	BucketLookup string `yaml:"BucketLookup"`
+	// SignatureVersion selects how requests and presigned URLs are signed: v4 (default) or v2, for
+	// older S3-compatible stores without SigV4 support (minio and gcs only)
+	SignatureVersion string `yaml:"SignatureVersion"`
+	// Encryption encrypts objects written by the service and its presigned uploads at rest
+	Encryption EncryptionConfig `yaml:"Encryption"`
+}
+
+// Storage backends selectable through Config.Provider
+const (
+	// ProviderMinIO talks to MinIO or any other S3-compatible store
+	ProviderMinIO = "minio"
+	// ProviderS3 talks to Amazon S3
+	ProviderS3 = "s3"
+	// ProviderGCS talks to Google Cloud Storage through its S3-compatible XML API with HMAC keys
+	ProviderGCS = "gcs"
+	// ProviderAzure talks to Azure Blob Storage
+	ProviderAzure = "azure"
+)
+
+// ResolvedProvider returns the configured provider, defaulting to minio
+func (c *Config) ResolvedProvider() string {
+	if c.Provider == "" {
+		return ProviderMinIO
+	}
+	return c.Provider
+}
+
+// Server-side encryption modes selectable through EncryptionConfig.Type
+const (
+	// EncryptionSSES3 encrypts objects with keys managed by the storage server
+	EncryptionSSES3 = "SSE-S3"
+	// EncryptionSSEKMS encrypts objects with a key held by the server's KMS
+	EncryptionSSEKMS = "SSE-KMS"
+)
+
+// EncryptionConfig selects the server-side encryption of new objects
+type EncryptionConfig struct {
+	// Type is SSE-S3 or SSE-KMS; empty leaves encryption to the bucket default
+	Type string `yaml:"Type"`
+	// KMSKeyID is the SSE-KMS key; empty uses the server's default key
+	KMSKeyID string `yaml:"KMSKeyID"`
+}
+
+// Bucket addressing styles selectable through Config.BucketLookup
+const (
+	// BucketLookupAuto lets the client pick the style the endpoint supports
+	BucketLookupAuto = "auto"
+	// BucketLookupPath addresses buckets as endpoint/bucket
+	BucketLookupPath = "path"
+	// BucketLookupDNS addresses buckets as bucket.endpoint
+	BucketLookupDNS = "dns"
+)
+
+// Signature versions selectable through Config.SignatureVersion
+const (
+	// SignatureV4 signs with AWS Signature Version 4
+	SignatureV4 = "v4"
+	// SignatureV2 signs with the legacy AWS Signature Version 2
+	SignatureV2 = "v2"
+)
+
+// ResolvedSignatureVersion returns the configured signature version, defaulting to v4
+func (c *Config) ResolvedSignatureVersion() string {
+	if c.SignatureVersion == "" {
+		return SignatureV4
+	}
+	return c.SignatureVersion
+}
+
+// ResolvedBucketLookup returns the configured bucket addressing style, applying PathStyle
+func (c *Config) ResolvedBucketLookup() string {
+	if c.BucketLookup != "" {
+		return c.BucketLookup
+	}
+	if c.PathStyle {
+		return BucketLookupPath
+	}
+	return BucketLookupAuto
+}
+
+// Validate checks that the fields every provider needs are set
+func (c *Config) Validate() error {
+	provider := c.ResolvedProvider()
+	if c.ConnectionString != "" && provider != ProviderAzure {
+		return fmt.Errorf("storage connection string requires provider %s", ProviderAzure)
+	}
+	switch provider {
+	case ProviderMinIO, ProviderS3, ProviderGCS:
+		// S3 and GCS have well-known endpoints
+		if c.Endpoint == "" && provider == ProviderMinIO {
+			return errors.New("storage endpoint is required")
+		}
+		if c.AccessKeyID == "" || c.SecretAccessKey == "" {
+			return errors.New("storage access key ID and secret access key are required")
+		}
+	case ProviderAzure:
+		if c.ConnectionString == "" && (c.AccessKeyID == "" || c.SecretAccessKey == "") {
+			return errors.New("azure storage requires a connection string or an account name and key")
+		}
+		// Encryption scopes are named, so there is no default key to fall back to
+		if c.Encryption.Type == EncryptionSSEKMS && c.Encryption.KMSKeyID == "" {
+			return errors.New("azure storage encryption type SSE-KMS requires KMSKeyID, the encryption scope")
+		}
+	default:
+		return fmt.Errorf("unknown storage provider: %s", c.Provider)
+	}
+	switch c.BucketLookup {
+	case "", BucketLookupAuto, BucketLookupPath, BucketLookupDNS:
+	default:
+		return fmt.Errorf("unknown storage bucket lookup: %s", c.BucketLookup)
+	}
+	switch c.Encryption.Type {
+	case "", EncryptionSSES3, EncryptionSSEKMS:
+	default:
+		return fmt.Errorf("unknown storage encryption type: %s", c.Encryption.Type)
+	}
+	if c.Encryption.KMSKeyID != "" && c.Encryption.Type != EncryptionSSEKMS {
+		return fmt.Errorf("storage encryption KMSKeyID requires type %s", EncryptionSSEKMS)
+	}
+	if c.PathStyle && c.BucketLookup != "" && c.BucketLookup != BucketLookupPath {
+		return fmt.Errorf("storage PathStyle conflicts with bucket lookup %s", c.BucketLookup)
+	}
+	switch c.ResolvedSignatureVersion() {
+	case SignatureV4:
+	case SignatureV2:
+		// Amazon S3 and Azure only accept newer signatures
+		if provider != ProviderMinIO && provider != ProviderGCS {
+			return fmt.Errorf("storage signature version %s is not supported by provider %s", SignatureV2, provider)
+		}
+		if c.Encryption.Type == EncryptionSSEKMS {
+			return fmt.Errorf("storage encryption type %s requires signature version %s", EncryptionSSEKMS, SignatureV4)
+		}
+	default:
+		return fmt.Errorf("unknown storage signature version: %s", c.SignatureVersion)
+	}
+	return nil
 }
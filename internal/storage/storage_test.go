@@ -0,0 +1,38 @@
+package storage
+
+import "testing"
+
+func TestConfigValidate(t *testing.T) {
+	minio := func() Config {
+		return Config{Endpoint: "localhost:9000", AccessKeyID: "key", SecretAccessKey: "secret"}
+	}
+
+	for _, tc := range []struct {
+		name   string
+		modify func(*Config)
+		ok     bool
+	}{
+		{"minio", func(*Config) {}, true},
+		{"s3 without endpoint", func(c *Config) { c.Provider, c.Endpoint = ProviderS3, "" }, true},
+		{"azure connection string", func(c *Config) { *c = Config{Provider: ProviderAzure, ConnectionString: "AccountName=a"} }, true},
+		{"missing endpoint", func(c *Config) { c.Endpoint = "" }, false},
+		{"missing access key", func(c *Config) { c.AccessKeyID = "" }, false},
+		{"missing secret", func(c *Config) { c.SecretAccessKey = "" }, false},
+		{"unknown provider", func(c *Config) { c.Provider = "local" }, false},
+		{"connection string without azure", func(c *Config) { c.ConnectionString = "AccountName=a" }, false},
+		{"azure without credentials", func(c *Config) { *c = Config{Provider: ProviderAzure} }, false},
+		{"unknown bucket lookup", func(c *Config) { c.BucketLookup = "virtual" }, false},
+		{"path style against dns lookup", func(c *Config) { c.PathStyle, c.BucketLookup = true, BucketLookupDNS }, false},
+		{"unknown encryption", func(c *Config) { c.Encryption.Type = "AES" }, false},
+		{"KMS key without SSE-KMS", func(c *Config) { c.Encryption = EncryptionConfig{Type: EncryptionSSES3, KMSKeyID: "k"} }, false},
+		{"v2 signature on s3", func(c *Config) { c.Provider, c.SignatureVersion = ProviderS3, SignatureV2 }, false},
+		{"v2 signature with KMS", func(c *Config) { c.SignatureVersion, c.Encryption.Type = SignatureV2, EncryptionSSEKMS }, false},
+		{"unknown signature", func(c *Config) { c.SignatureVersion = "v3" }, false},
+	} {
+		cfg := minio()
+		tc.modify(&cfg)
+		if err := cfg.Validate(); (err == nil) != tc.ok {
+			t.Errorf("%s: Validate() = %v, want ok %v", tc.name, err, tc.ok)
+		}
+	}
+}
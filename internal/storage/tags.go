@@ -0,0 +1,25 @@
+package storage
+
+import "net/url"
+
+// EncodeTags serializes tags as a URL-encoded query string, the format S3 uses for tagging headers
+func EncodeTags(tags map[string]string) string {
+	values := url.Values{}
+	for k, v := range tags {
+		values.Set(k, v)
+	}
+	return values.Encode()
+}
+
+// DecodeTags parses tags serialized by EncodeTags
+func DecodeTags(encoded string) (map[string]string, error) {
+	values, err := url.ParseQuery(encoded)
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[string]string, len(values))
+	for k := range values {
+		tags[k] = values.Get(k)
+	}
+	return tags, nil
+}
@@ -8,6 +8,12 @@ import (
 	"github.com/gofreego/mediabase/cmd/http_server"
 	"github.com/gofreego/mediabase/internal/configs"
 	"github.com/gofreego/mediabase/internal/constants"
+	"github.com/gofreego/mediabase/internal/service"
+	"github.com/gofreego/mediabase/internal/storage"
+	// Backends register themselves with storage.NewStorage
+	_ "github.com/gofreego/mediabase/internal/storage/azure"
+	_ "github.com/gofreego/mediabase/internal/storage/minio"
+	"github.com/gofreego/mediabase/internal/storage/router"
 
 	"github.com/gofreego/goutils/apputils"
 	"github.com/gofreego/goutils/logger"
@@ -27,16 +33,29 @@ func main() {
 	conf := configs.LoadConfig(ctx, path, env)
 
 	conf.Logger.InitiateLogger()
-	logger.AddMiddleLayers(logger.RequestMiddleLayer)
+	logger.AddMiddleLayers(logger.RequestMiddleLayer, service.LogFieldsMiddleLayer)
+
+	// Both servers share one storage client and one service, so credential rotation,
+	// rate limits and in-memory state apply to the whole process
+	storageProvider, err := router.Build(conf.Storage, conf.StorageRoutes, storage.NewStorage)
+	if err != nil {
+		logger.Panic(ctx, "failed to initialize storage: %v", err)
+	}
+	svc := service.NewService(ctx, &conf.Service, storageProvider)
+
+	// Fail fast on inaccessible buckets instead of failing the first requests
+	if err := svc.SelfTest(ctx); err != nil {
+		logger.Panic(ctx, "storage self-test failed: %v", err)
+	}
 
 	// starting application
 	var apps []apputils.Application
 	for _, appName := range conf.AppNames {
 		switch appName {
 		case constants.HTTP_SERVER:
-			apps = append(apps, http_server.NewHTTPServer(conf))
+			apps = append(apps, http_server.NewHTTPServer(conf, svc))
 		case constants.GRPC_SERVER:
-			apps = append(apps, grpc_server.NewGRPCServer(conf))
+			apps = append(apps, grpc_server.NewGRPCServer(conf, svc))
 		default:
 			logger.Panic(ctx, "invalid application name provided `%s`", appName)
 		}
@@ -48,4 +67,6 @@ func main() {
 	}
 
 	apputils.GracefulShutdown(ctx, apps...)
+	// Closed once both servers have drained
+	svc.Close(ctx)
 }